@@ -0,0 +1,315 @@
+// Package textarea is a multi-line text editor with a preview mode.
+package textarea
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	textarea textarea.Model
+	mode     string
+	saved    bool
+	content  string
+}
+
+type keyMap struct {
+	Save        key.Binding
+	Preview     key.Binding
+	Reset       key.Binding
+	LineNumbers key.Binding
+	WordWrap    key.Binding
+	Quit        key.Binding
+	Help        key.Binding
+}
+
+// The editor takes text, so help is on F1 rather than "?", and the toggles
+// sit on F4 and F5 since F2 and F3 belong to engine.Run.
+var keys = keyMap{
+	Save:        keymap.New("Ctrl+S", "save", "ctrl+s"),
+	Preview:     keymap.New("Ctrl+P", "preview", "ctrl+p"),
+	Reset:       keymap.New("Ctrl+R", "reset", "ctrl+r"),
+	LineNumbers: keymap.New("F4", "line numbers", "f4"),
+	WordWrap:    keymap.New("F5", "word wrap", "f5"),
+	Quit:        keymap.New("Esc", "quit", "esc", "ctrl+c"),
+	Help:        keymap.New("F1", "help", "f1"),
+}
+
+func initialModel() model {
+	ta := textarea.New()
+	ta.Placeholder = "Start typing your message here..."
+	ta.Focus()
+	ta.CharLimit = 1000
+	ta.SetWidth(60)
+	ta.SetHeight(10)
+	ta.ShowLineNumbers = true
+	ta.KeyMap.InsertNewline.SetEnabled(true)
+
+	return model{
+		textarea: ta,
+		mode:     "edit",
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "02-textarea",
+		Heading:  "📄 Textarea",
+		Summary:  "Multi-line text editor with preview mode",
+		Section:  registry.Bubbles,
+		Colors:   16,
+		Keywords: []string{"editor", "text", "bubbles"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			if m.mode == "preview" {
+				m.mode = "edit"
+				return m, nil
+			}
+			return m, tea.Quit
+
+		case key.Matches(msg, keys.Save):
+			// Save content
+			m.saved = true
+			m.content = m.textarea.Value()
+			return m, nil
+
+		case key.Matches(msg, keys.Preview):
+			// Toggle preview mode
+			if m.mode == "edit" {
+				m.mode = "preview"
+				m.content = m.textarea.Value()
+			} else {
+				m.mode = "edit"
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.Reset):
+			// Reset/clear
+			m.textarea.Reset()
+			m.saved = false
+			m.content = ""
+			return m, nil
+
+		case key.Matches(msg, keys.LineNumbers):
+			// Toggle line numbers
+			m.textarea.ShowLineNumbers = !m.textarea.ShowLineNumbers
+			return m, nil
+
+		case key.Matches(msg, keys.WordWrap):
+			// Toggle word wrap
+			m.textarea.KeyMap.InsertNewline.SetEnabled(!m.textarea.KeyMap.InsertNewline.Enabled())
+			return m, nil
+		}
+
+	case tea.WindowSizeMsg:
+		m.textarea.SetWidth(msg.Width - 10)
+		m.textarea.SetHeight(msg.Height - 10)
+		return m, nil
+	}
+
+	// Only update textarea in edit mode
+	if m.mode == "edit" {
+		m.textarea, cmd = m.textarea.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// KeyMap implements engine.KeyMapper. Preview mode only lists the ways back
+// to the editor.
+func (m model) KeyMap() keymap.Map {
+	k := keys
+	if m.mode == "preview" {
+		k.Preview.SetHelp("Ctrl+P", "back to edit")
+		k.Quit.SetHelp("Esc", "back to edit")
+		k.Save.SetEnabled(false)
+		k.Reset.SetEnabled(false)
+		k.LineNumbers.SetEnabled(false)
+		k.WordWrap.SetEnabled(false)
+	}
+	return keymap.Of(k)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Green).
+		Padding(0, 1).
+		MarginBottom(1)
+
+	title := titleStyle.Render("📄 Textarea Component")
+
+	// Mode indicator
+	modeStyle := lipgloss.NewStyle().
+		Bold(true).
+		Padding(0, 1).
+		MarginLeft(2)
+
+	var modeIndicator string
+	if m.mode == "edit" {
+		modeIndicator = modeStyle.
+			Foreground(common.Title).
+			Background(common.Blue).
+			Render("✏️ EDIT MODE")
+	} else {
+		modeIndicator = modeStyle.
+			Foreground(common.Title).
+			Background(common.Purple).
+			Render("👁️ PREVIEW MODE")
+	}
+
+	header := lipgloss.JoinHorizontal(lipgloss.Center, title, modeIndicator)
+
+	// Stats
+	statsStyle := lipgloss.NewStyle().
+		Foreground(common.Cyan).
+		MarginTop(1).
+		MarginBottom(1)
+
+	lines := len(strings.Split(m.textarea.Value(), "\n"))
+	chars := len([]rune(m.textarea.Value()))
+	words := len(strings.Fields(m.textarea.Value()))
+
+	stats := statsStyle.Render(fmt.Sprintf(
+		"Lines: %d | Words: %d | Characters: %d/%d",
+		lines, words, chars, m.textarea.CharLimit,
+	))
+
+	// Save indicator
+	var saveIndicator string
+	if m.saved {
+		saveStyle := lipgloss.NewStyle().
+			Foreground(common.Green).
+			Bold(true)
+		saveIndicator = saveStyle.Render(" ✅ Saved")
+	}
+
+	// Main content area
+	var content string
+	if m.mode == "edit" {
+		// Edit mode - show textarea
+		textareaStyle := lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(common.Blue).
+			Padding(1)
+
+		content = textareaStyle.Render(m.textarea.View())
+	} else {
+		// Preview mode - show formatted content
+		previewStyle := lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(common.Purple).
+			Padding(1).
+			Width(m.textarea.Width() + 2).
+			Height(m.textarea.Height() + 2)
+
+		previewContent := m.content
+		if previewContent == "" {
+			previewContent = "Nothing to preview yet..."
+		}
+
+		// Simple markdown-like formatting
+		lines := strings.Split(previewContent, "\n")
+		formattedLines := make([]string, len(lines))
+		for i, line := range lines {
+			if strings.HasPrefix(line, "# ") {
+				// Header
+				headerStyle := lipgloss.NewStyle().
+					Bold(true).
+					Foreground(common.Yellow).
+					MarginBottom(1)
+				formattedLines[i] = headerStyle.Render(strings.TrimPrefix(line, "# "))
+			} else if strings.HasPrefix(line, "- ") {
+				// Bullet point
+				bulletStyle := lipgloss.NewStyle().
+					Foreground(common.Green)
+				formattedLines[i] = bulletStyle.Render("• " + strings.TrimPrefix(line, "- "))
+			} else if strings.HasPrefix(line, "*") && strings.HasSuffix(line, "*") {
+				// Italic
+				italicStyle := lipgloss.NewStyle().
+					Italic(true).
+					Foreground(common.Cyan)
+				formattedLines[i] = italicStyle.Render(strings.Trim(line, "*"))
+			} else {
+				formattedLines[i] = line
+			}
+		}
+
+		content = previewStyle.Render(strings.Join(formattedLines, "\n"))
+	}
+
+	// Help text
+	helpStyle := lipgloss.NewStyle().
+		Faint(true).
+		MarginTop(1)
+
+	help := m.KeyMap().String()
+	if m.mode == "preview" {
+		help += " • Preview supports: # headers, - bullets, *italic*"
+	}
+	help = helpStyle.Render(help)
+
+	// Feature indicators
+	featureStyle := lipgloss.NewStyle().
+		Foreground(common.Subtle).
+		Faint(true)
+
+	features := []string{}
+	if m.textarea.ShowLineNumbers {
+		features = append(features, "Line Numbers: ON")
+	} else {
+		features = append(features, "Line Numbers: OFF")
+	}
+
+	if m.textarea.KeyMap.InsertNewline.Enabled() {
+		features = append(features, "Word Wrap: ON")
+	} else {
+		features = append(features, "Word Wrap: OFF")
+	}
+
+	featureInfo := featureStyle.Render(strings.Join(features, " | "))
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		stats+saveIndicator,
+		featureInfo,
+		"",
+		content,
+		help,
+	)
+}
@@ -0,0 +1,12 @@
+package textarea
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/golden"
+)
+
+func TestFrames(t *testing.T) {
+	golden.Check(t, func() tea.Model { return initialModel() }, 1, 45)
+}
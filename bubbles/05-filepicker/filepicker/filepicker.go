@@ -0,0 +1,297 @@
+// Package filepicker is a file browser with filtering and navigation.
+package filepicker
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/filepicker"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	filepicker   filepicker.Model
+	selectedFile string
+	quitting     bool
+	err          error
+}
+
+type keyMap struct {
+	Navigate key.Binding
+	Select   key.Binding
+	Hidden   key.Binding
+	Refresh  key.Binding
+	Home     key.Binding
+	Parent   key.Binding
+	Quit     key.Binding
+	Help     key.Binding
+}
+
+var keys = keyMap{
+	// Moving and selecting are handled by the file picker itself
+	Navigate: keymap.New("↑↓", "navigate", "up", "down"),
+	Select:   keymap.New("Enter", "select", "enter"),
+	Hidden:   keymap.New("h", "toggle hidden"),
+	Refresh:  keymap.New("r", "refresh"),
+	Home:     keymap.New("~", "home"),
+	Parent:   keymap.New("Ctrl+H", "parent", "ctrl+h"),
+	Quit:     keymap.Quit(),
+	Help:     keymap.Help(),
+}
+
+func initialModel() model {
+	dir, _ := os.Getwd()
+	return newModel(dir)
+}
+
+// newModel returns the picker opened on dir.
+func newModel(dir string) model {
+	fp := filepicker.New()
+	fp.AllowedTypes = []string{".go", ".md", ".txt", ".json", ".yaml", ".yml", ".toml", ".csv"}
+	fp.CurrentDirectory = dir
+	fp.ShowHidden = false
+	fp.DirAllowed = true
+	fp.FileAllowed = true
+	// The height is set from the window, leaving room for the header
+	fp.AutoHeight = false
+
+	fp.Styles = styles(fp.Styles)
+
+	return model{
+		filepicker: fp,
+	}
+}
+
+// styles are the file picker's custom styles over s. View sets them again, so
+// the picker follows a theme applied after it was built.
+func styles(s filepicker.Styles) filepicker.Styles {
+	s.Cursor = lipgloss.NewStyle().Foreground(common.Purple)
+	s.Symlink = lipgloss.NewStyle().Foreground(common.Cyan)
+	s.Directory = lipgloss.NewStyle().Foreground(common.Blue).Bold(true)
+	s.File = lipgloss.NewStyle().Foreground(common.Text)
+	s.Permission = lipgloss.NewStyle().Foreground(common.Muted)
+	s.Selected = lipgloss.NewStyle().Foreground(common.Yellow).Bold(true)
+	s.DisabledCursor = lipgloss.NewStyle().Foreground(common.Faint)
+	s.DisabledFile = lipgloss.NewStyle().Foreground(common.Faint)
+	return s
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "05-filepicker",
+		Heading:  "📁 File Picker",
+		Summary:  "File browser with filtering and navigation",
+		Section:  registry.Bubbles,
+		Colors:   16,
+		Keywords: []string{"files", "browser", "directory", "bubbles"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.filepicker.Init()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			m.quitting = true
+			return m, tea.Quit
+
+		case key.Matches(msg, keys.Hidden):
+			// Toggle hidden files
+			m.filepicker.ShowHidden = !m.filepicker.ShowHidden
+			return m, m.filepicker.Init()
+
+		case key.Matches(msg, keys.Refresh):
+			// Refresh directory
+			return m, m.filepicker.Init()
+
+		case key.Matches(msg, keys.Home):
+			// Go to home directory
+			home, err := os.UserHomeDir()
+			if err == nil {
+				m.filepicker.CurrentDirectory = home
+				return m, m.filepicker.Init()
+			}
+
+		case key.Matches(msg, keys.Parent):
+			// Go up one directory level
+			parent := filepath.Dir(m.filepicker.CurrentDirectory)
+			if parent != m.filepicker.CurrentDirectory {
+				m.filepicker.CurrentDirectory = parent
+				return m, m.filepicker.Init()
+			}
+		}
+
+	case tea.WindowSizeMsg:
+		m.filepicker.Height = msg.Height - 8
+		// Passed on so the picker shows as many entries as now fit
+		m.filepicker, _ = m.filepicker.Update(msg)
+		return m, nil
+
+	}
+
+	// Check if user selected a file using the new API
+	if didSelect, path := m.filepicker.DidSelectFile(msg); didSelect {
+		m.selectedFile = path
+		return m, nil
+	}
+
+	// Check if user selected a disabled file
+	if didSelect, path := m.filepicker.DidSelectDisabledFile(msg); didSelect {
+		m.err = fmt.Errorf("file type not allowed: %s", filepath.Ext(path))
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filepicker, cmd = m.filepicker.Update(msg)
+	return m, cmd
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	// Title
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Green).
+		Padding(0, 1)
+
+	title := titleStyle.Render("📁 File Picker Component")
+
+	// Current directory info
+	dirStyle := lipgloss.NewStyle().
+		Foreground(common.Cyan).
+		Bold(true)
+
+	currentDir := dirStyle.Render(fmt.Sprintf("Current: %s", m.filepicker.CurrentDirectory))
+
+	// File type filter info
+	filterStyle := lipgloss.NewStyle().
+		Foreground(common.Yellow)
+
+	allowedTypes := strings.Join(m.filepicker.AllowedTypes, ", ")
+	if allowedTypes == "" {
+		allowedTypes = "All files"
+	}
+	filter := filterStyle.Render(fmt.Sprintf("Filter: %s", allowedTypes))
+
+	// Hidden files status
+	hiddenStyle := lipgloss.NewStyle().
+		Foreground(common.Muted)
+
+	hiddenStatus := "Hidden files: "
+	if m.filepicker.ShowHidden {
+		hiddenStatus += hiddenStyle.Foreground(common.Green).Render("ON")
+	} else {
+		hiddenStatus += hiddenStyle.Foreground(common.Red).Render("OFF")
+	}
+
+	// Header info
+	header := lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		currentDir,
+		filter,
+		hiddenStyle.Render(hiddenStatus),
+		"",
+	)
+
+	// File picker view
+	m.filepicker.Styles = styles(m.filepicker.Styles)
+	fpView := m.filepicker.View()
+
+	// Selected file or error display
+	var footer string
+	if m.err != nil {
+		errorStyle := lipgloss.NewStyle().
+			Foreground(common.Red).
+			Bold(true)
+		footer = errorStyle.Render(fmt.Sprintf("❌ Error: %s", m.err.Error()))
+		m.err = nil // Clear error after displaying
+	} else if m.selectedFile != "" {
+		selectedStyle := lipgloss.NewStyle().
+			Foreground(common.Green).
+			Bold(true)
+
+		// Get file info
+		info, err := os.Stat(m.selectedFile)
+		var fileInfo string
+		if err == nil {
+			if info.IsDir() {
+				fileInfo = fmt.Sprintf("📁 Directory selected: %s", m.selectedFile)
+			} else {
+				size := info.Size()
+				var sizeStr string
+				if size < 1024 {
+					sizeStr = fmt.Sprintf("%d B", size)
+				} else if size < 1024*1024 {
+					sizeStr = fmt.Sprintf("%.1f KB", float64(size)/1024)
+				} else {
+					sizeStr = fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+				}
+				fileInfo = fmt.Sprintf("📄 File selected: %s (%s)", filepath.Base(m.selectedFile), sizeStr)
+			}
+		} else {
+			fileInfo = fmt.Sprintf("📄 Selected: %s", m.selectedFile)
+		}
+
+		footer = selectedStyle.Render(fileInfo)
+		
+		// Show file path
+		pathStyle := lipgloss.NewStyle().
+			Foreground(common.Muted).
+			Faint(true)
+		footer += "\n" + pathStyle.Render(fmt.Sprintf("Path: %s", m.selectedFile))
+		
+		// Clear selection after a moment
+		m.selectedFile = ""
+	}
+
+	// Help text
+	helpStyle := lipgloss.NewStyle().
+		Faint(true).
+		MarginTop(1)
+
+	help := helpStyle.Render(m.KeyMap().String())
+
+	// Combine all elements
+	content := header + "\n" + fpView
+
+	if footer != "" {
+		content += "\n\n" + footer
+	}
+
+	content += "\n" + help
+
+	return content
+}
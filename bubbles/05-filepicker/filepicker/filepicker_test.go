@@ -0,0 +1,21 @@
+package filepicker
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/golden"
+)
+
+func TestFrames(t *testing.T) {
+	golden.Check(t, func() tea.Model {
+		m := newModel(filepath.Join("testdata", "tree"))
+		// Permissions and sizes depend on the checkout, so are left out
+		m.filepicker.ShowPermissions = false
+		m.filepicker.ShowSize = false
+		// The frames are taken without waiting, so read the directory first
+		m.filepicker, _ = m.filepicker.Update(m.filepicker.Init()())
+		return m
+	}, 1)
+}
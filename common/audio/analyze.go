@@ -0,0 +1,182 @@
+package audio
+
+import (
+	"math"
+	"math/cmplx"
+	"time"
+)
+
+// NumBands is the number of spectrum bands in an EnergyMsg.
+const NumBands = 16
+
+const (
+	windowSize = 1024 // samples in each FFT, a power of two
+	lowFreq    = 40.0 // bottom of the first band in Hz
+	highFreq   = 16000.0
+
+	// Beats are energy jumps beatThreshold times above the average over
+	// the last beatHistory, no closer together than beatGap.
+	beatThreshold = 1.4
+	beatHistory   = time.Second
+	beatGap       = 250 * time.Millisecond
+	// Quieter than this and nothing counts as a beat, so noise between
+	// songs doesn't flash.
+	beatFloor = 1e-4
+)
+
+// analyzer turns successive chunks of samples into EnergyMsg and BeatMsg
+// values.
+type analyzer struct {
+	rate  int
+	mono  []float64 // the last windowSize samples, mixed down
+	edges []int     // FFT bins bounding each band
+	hann  []float64
+
+	history  []float64 // energy of recent chunks
+	lastBeat time.Duration
+	beaten   bool
+}
+
+func newAnalyzer(rate int) *analyzer {
+	a := &analyzer{
+		rate: rate,
+		mono: make([]float64, windowSize),
+		hann: make([]float64, windowSize),
+	}
+	for i := range a.hann {
+		a.hann[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(windowSize-1))
+	}
+	top := min(highFreq, float64(rate)/2)
+	for i := 0; i <= NumBands; i++ {
+		freq := lowFreq * math.Pow(top/lowFreq, float64(i)/NumBands)
+		bin := int(freq * windowSize / float64(rate))
+		// Keep every band at least one bin wide
+		if i > 0 {
+			bin = max(bin, a.edges[i-1]+1)
+		}
+		a.edges = append(a.edges, min(bin, windowSize/2))
+	}
+	return a
+}
+
+// feed analyzes a chunk of interleaved samples ending at position at. It
+// returns a beat only when one lands in this chunk.
+func (a *analyzer) feed(samples []float32, channels int, at time.Duration) (EnergyMsg, *BeatMsg) {
+	frames := len(samples) / channels
+	energy := 0.0
+	for i := 0; i < frames; i++ {
+		v := 0.0
+		for c := 0; c < channels; c++ {
+			v += float64(samples[i*channels+c])
+		}
+		v /= float64(channels)
+		energy += v * v
+		a.mono = append(a.mono, v)
+	}
+	a.mono = a.mono[len(a.mono)-windowSize:]
+	if frames > 0 {
+		energy /= float64(frames)
+	}
+
+	msg := EnergyMsg{Time: at, Level: min(1, math.Sqrt(energy)*3), Bands: a.spectrum()}
+	var bass, mid, treble []float64
+	for i, v := range msg.Bands {
+		switch center := a.center(i); {
+		case center < 250:
+			bass = append(bass, v)
+		case center < 4000:
+			mid = append(mid, v)
+		default:
+			treble = append(treble, v)
+		}
+	}
+	msg.Bass, msg.Mid, msg.Treble = mean(bass), mean(mid), mean(treble)
+
+	var beat *BeatMsg
+	if avg := mean(a.history); len(a.history) > 0 && energy > beatFloor && energy > avg*beatThreshold {
+		if !a.beaten || at-a.lastBeat >= beatGap {
+			beat = &BeatMsg{Time: at, Strength: energy / max(avg, beatFloor/10)}
+			a.lastBeat, a.beaten = at, true
+		}
+	}
+	chunk := time.Duration(frames) * time.Second / time.Duration(a.rate)
+	keep := 1
+	if chunk > 0 {
+		keep = max(1, int(beatHistory/chunk))
+	}
+	a.history = append(a.history, energy)
+	if len(a.history) > keep {
+		a.history = a.history[len(a.history)-keep:]
+	}
+	return msg, beat
+}
+
+// spectrum returns the level of each band over the current window, mapping
+// -60 to 0 dB of a full-scale sine onto 0 to 1.
+func (a *analyzer) spectrum() []float64 {
+	buf := make([]complex128, windowSize)
+	for i, v := range a.mono {
+		buf[i] = complex(v*a.hann[i], 0)
+	}
+	fft(buf)
+
+	bands := make([]float64, NumBands)
+	for b := range bands {
+		lo, hi := a.edges[b], a.edges[b+1]
+		peak := 0.0
+		for k := lo; k < hi; k++ {
+			peak = max(peak, cmplx.Abs(buf[k]))
+		}
+		// A full-scale sine peaks at a quarter of the window size once
+		// the Hann window has halved it
+		if peak > 0 {
+			db := 20 * math.Log10(peak/(windowSize/4))
+			bands[b] = math.Max(0, math.Min(1, (db+60)/60))
+		}
+	}
+	return bands
+}
+
+// center returns the middle frequency of band b in Hz.
+func (a *analyzer) center(b int) float64 {
+	bin := float64(a.edges[b]+a.edges[b+1]) / 2
+	return bin * float64(a.rate) / windowSize
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// fft transforms buf in place. Its length must be a power of two.
+func fft(buf []complex128) {
+	n := len(buf)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+	}
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				even, odd := buf[start+k], buf[start+k+size/2]*w
+				buf[start+k] = even + odd
+				buf[start+k+size/2] = even - odd
+				w *= step
+			}
+		}
+	}
+}
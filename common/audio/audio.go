@@ -0,0 +1,223 @@
+// Package audio plays music alongside a demo and turns what is playing into
+// Bubble Tea messages: an EnergyMsg for every analysis window with the
+// loudness and a small spectrum, and a BeatMsg whenever the energy jumps
+// above its recent average.
+//
+//	track, err := audio.Load("song.ogg")
+//	player, err := audio.Play(track)
+//
+//	func (m model) Init() tea.Cmd { return m.player.Listen() }
+//
+//	case audio.EnergyMsg:
+//		m.glow = msg.Bass
+//		return m, m.player.Listen()
+//	case audio.BeatMsg:
+//		m.flash = 1
+//		return m, m.player.Listen()
+//
+// WAV and Ogg Vorbis files are decoded in Go, and ProTracker MOD and
+// FastTracker 2 XM modules are played by a built-in tracker whose Player also
+// sends a RowMsg for every pattern row, so effects can land on exact rows. Sound goes out through the
+// first of pw-play, paplay, aplay or sox's play found on the PATH; with none
+// of them, or with SHOWCASE_AUDIO=off, playback is silent but the messages
+// still arrive in time with the music.
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfreymuth/oggvorbis"
+)
+
+// Source produces interleaved samples between -1 and 1.
+type Source interface {
+	SampleRate() int
+	Channels() int
+	// Read fills buf with whole frames and returns how many samples it
+	// wrote, or io.EOF once the source is exhausted.
+	Read(buf []float32) (int, error)
+}
+
+// Track is a decoded sound file held in memory. It is a Source that plays
+// from the start each time it is rewound.
+type Track struct {
+	Rate    int
+	Chans   int
+	Samples []float32 // interleaved
+	// Loop starts the track again instead of ending.
+	Loop bool
+
+	pos int
+}
+
+// SampleRate implements Source.
+func (t *Track) SampleRate() int {
+	return t.Rate
+}
+
+// Channels implements Source.
+func (t *Track) Channels() int {
+	return t.Chans
+}
+
+// Read implements Source.
+func (t *Track) Read(buf []float32) (int, error) {
+	if t.pos >= len(t.Samples) {
+		if !t.Loop || len(t.Samples) == 0 {
+			return 0, io.EOF
+		}
+		t.pos = 0
+	}
+	n := copy(buf[:len(buf)/t.Chans*t.Chans], t.Samples[t.pos:])
+	t.pos += n
+	return n, nil
+}
+
+// Rewind moves playback back to the start.
+func (t *Track) Rewind() {
+	t.pos = 0
+}
+
+// Duration returns the length of the track.
+func (t *Track) Duration() time.Duration {
+	frames := len(t.Samples) / t.Chans
+	return time.Duration(frames) * time.Second / time.Duration(t.Rate)
+}
+
+// Load decodes a WAV or Ogg Vorbis file, telling them apart by content.
+func Load(path string) (*Track, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t *Track
+	switch {
+	case bytes.HasPrefix(data, []byte("RIFF")):
+		t, err = DecodeWAV(bytes.NewReader(data))
+	case bytes.HasPrefix(data, []byte("OggS")):
+		t, err = DecodeOgg(bytes.NewReader(data))
+	default:
+		err = errors.New("not a WAV or Ogg Vorbis file")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+// LoadModule reads a MOD or XM tracker module.
+func LoadModule(path string) (*Module, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m *Module
+	switch {
+	case bytes.HasPrefix(data, []byte(xmMagic)):
+		m, err = ParseXM(data)
+	case modSignature(data) > 0, strings.EqualFold(filepath.Ext(path), ".mod"):
+		m, err = ParseMOD(data)
+	default:
+		err = errors.New("not a MOD or XM module")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Open loads whatever path holds: a tracker module with LoadModule, or a
+// sound file with Load.
+func Open(path string) (Source, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	head := make([]byte, 1084)
+	n, _ := io.ReadFull(f, head)
+	f.Close()
+	head = head[:n]
+	if bytes.HasPrefix(head, []byte(xmMagic)) || modSignature(head) > 0 ||
+		strings.EqualFold(filepath.Ext(path), ".mod") {
+		return LoadModule(path)
+	}
+	return Load(path)
+}
+
+// PlayFile opens path and starts playing it, over and over if loop is set.
+func PlayFile(path string, loop bool) (*Player, error) {
+	src, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	switch src := src.(type) {
+	case *Track:
+		src.Loop = loop
+	case *Module:
+		src.Loop = loop
+	}
+	return Play(src)
+}
+
+// DecodeOgg decodes an Ogg Vorbis stream.
+func DecodeOgg(r io.Reader) (*Track, error) {
+	samples, format, err := oggvorbis.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return newTrack(format.SampleRate, format.Channels, samples)
+}
+
+// newTrack checks a decoded stream and keeps at most two channels, since
+// the analysis and the players only deal in mono and stereo.
+func newTrack(rate, channels int, samples []float32) (*Track, error) {
+	if rate <= 0 || channels <= 0 {
+		return nil, fmt.Errorf("bad format: %d Hz, %d channels", rate, channels)
+	}
+	if channels > 2 {
+		frames := len(samples) / channels
+		stereo := make([]float32, frames*2)
+		for i := 0; i < frames; i++ {
+			stereo[i*2] = samples[i*channels]
+			stereo[i*2+1] = samples[i*channels+1]
+		}
+		samples, channels = stereo, 2
+	}
+	return &Track{Rate: rate, Chans: channels, Samples: samples}, nil
+}
+
+// EnergyMsg describes the music over the last analysis window.
+type EnergyMsg struct {
+	// Time is the playback position at the end of the window.
+	Time time.Duration
+	// Level is the loudness, roughly 0 for silence to 1 for a loud mix.
+	Level float64
+	// Bass, Mid and Treble are the energy below 250 Hz, up to 4 kHz and
+	// above, scaled like Level.
+	Bass, Mid, Treble float64
+	// Bands is a spectrum of NumBands log-spaced bands from 40 Hz up, each
+	// between 0 and 1.
+	Bands []float64
+}
+
+// BeatMsg is sent when the energy jumps well above its average over the
+// last second.
+type BeatMsg struct {
+	Time time.Duration
+	// Strength is how far the energy rose above the average: 1.3 is a
+	// soft beat, 2 or more a strong one.
+	Strength float64
+}
+
+// DoneMsg is sent when the source runs out or playback fails. It is the
+// last message a Player sends.
+type DoneMsg struct {
+	Err error
+}
@@ -0,0 +1,168 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// modChannels maps the signatures at offset 1080 of a MOD file to their
+// channel counts. "xCHN" and "xxCH" signatures are worked out from the
+// digits.
+var modChannels = map[string]int{
+	"M.K.": 4, "M!K!": 4, "M&K!": 4, "N.T.": 4, "FLT4": 4,
+	"FLT8": 8, "CD81": 8, "OKTA": 8, "OCTA": 8,
+}
+
+// modSignature returns the channel count a MOD file's signature gives, or
+// 0 if it has none, as 15-sample Soundtracker modules do.
+func modSignature(data []byte) int {
+	if len(data) < 1084 {
+		return 0
+	}
+	sig := string(data[1080:1084])
+	if n, ok := modChannels[sig]; ok {
+		return n
+	}
+	var digits string
+	switch {
+	case strings.HasSuffix(sig, "CHN"):
+		digits = sig[:1]
+	case strings.HasSuffix(sig, "CH"), strings.HasSuffix(sig, "CN"):
+		digits = sig[:2]
+	}
+	if n, err := strconv.Atoi(digits); err == nil && n > 0 && n <= 32 {
+		return n
+	}
+	return 0
+}
+
+// ParseMOD reads a ProTracker module, or a 15-sample Soundtracker one when
+// it has no signature.
+func ParseMOD(data []byte) (*Module, error) {
+	channels := modSignature(data)
+	samples, header := 31, 1084
+	if channels == 0 {
+		channels, samples, header = 4, 15, 600
+	}
+	if len(data) < header {
+		return nil, errors.New("not a MOD file")
+	}
+
+	m := newModule("MOD", channels)
+	m.Title = cstring(data[:20])
+	m.clock = 3546895 // PAL Amiga
+	m.minPeriod, m.maxPeriod = 113, 856
+	if channels != 4 {
+		// Multichannel trackers lifted ProTracker's three-octave limit
+		m.minPeriod, m.maxPeriod = 28, 6848
+	}
+	// Amiga channels are hard left or right, LRRL; soften that so it
+	// works on headphones
+	for i := range m.pans {
+		if i%4 == 0 || i%4 == 3 {
+			m.pans[i] = 64
+		} else {
+			m.pans[i] = 192
+		}
+	}
+
+	m.instruments = make([]*instrument, samples+1)
+	off := 20
+	for i := 1; i <= samples; i++ {
+		h := data[off : off+30]
+		off += 30
+		s := &sample{
+			data:      make([]float32, int(binary.BigEndian.Uint16(h[22:]))*2),
+			finetune:  int(int8(h[24]<<4)>>4) * 16,
+			volume:    min(int(h[25]), 64),
+			loopStart: int(binary.BigEndian.Uint16(h[26:])) * 2,
+			loopLen:   int(binary.BigEndian.Uint16(h[28:])) * 2,
+			pan:       128,
+		}
+		m.instruments[i] = &instrument{name: cstring(h[:22]), samples: []*sample{s}}
+	}
+
+	songLen, restart := int(data[off]), int(data[off+1])
+	if songLen < 1 || songLen > 128 {
+		return nil, errors.New("bad song length")
+	}
+	if restart < songLen {
+		m.restart = restart
+	}
+	// ProTracker saves every pattern the whole order table names, played
+	// or not
+	patterns := 0
+	for i, p := range data[off+2 : off+130] {
+		if i < songLen {
+			m.orders = append(m.orders, int(p))
+		}
+		patterns = max(patterns, int(p)+1)
+	}
+
+	off = header
+	size := 64 * channels * 4
+	if len(data) < off+patterns*size {
+		return nil, errors.New("patterns cut short")
+	}
+	for p := 0; p < patterns; p++ {
+		pat := pattern{rows: 64, cells: make([]cell, 64*channels)}
+		for i := range pat.cells {
+			b := data[off+i*4 : off+i*4+4]
+			pat.cells[i] = cell{
+				note:  periodNote(int(b[0]&0x0F)<<8 | int(b[1])),
+				inst:  b[0]&0xF0 | b[2]>>4,
+				fx:    b[2] & 0x0F,
+				param: b[3],
+			}
+		}
+		m.patterns = append(m.patterns, pat)
+		off += size
+	}
+
+	// Signed 8-bit sample data follows; the last sample is often cut short
+	for _, in := range m.instruments[1:] {
+		s := in.samples[0]
+		n := min(len(s.data), len(data)-off)
+		s.data = s.data[:max(n, 0)]
+		for i := range s.data {
+			s.data[i] = float32(int8(data[off+i])) / 128
+		}
+		off += max(n, 0)
+		fixLoop(s)
+	}
+	m.Rewind()
+	return m, nil
+}
+
+// periodNote converts a ProTracker period to a note, 1 being C-0 as in the
+// XM format. Period 428 is C-4, which plays a sample at its own rate.
+func periodNote(period int) uint8 {
+	if period == 0 {
+		return 0
+	}
+	n := 48 + int(math.Round(12*math.Log2(428/float64(period))))
+	return uint8(max(0, min(95, n)) + 1)
+}
+
+// fixLoop trims a sample's loop to its data, dropping loops too short to
+// be meant.
+func fixLoop(s *sample) {
+	if s.loopStart >= len(s.data) {
+		s.loopStart, s.loopLen = 0, 0
+	}
+	s.loopLen = min(s.loopLen, len(s.data)-s.loopStart)
+	if s.loopLen <= 2 {
+		s.loopStart, s.loopLen, s.pingpong = 0, 0, false
+	}
+}
+
+// cstring returns a fixed-size, NUL-padded text field.
+func cstring(b []byte) string {
+	if i := strings.IndexByte(string(b), 0); i >= 0 {
+		b = b[:i]
+	}
+	return strings.TrimSpace(string(b))
+}
@@ -0,0 +1,200 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// chunksPerSecond is how often the music is analyzed, and so the rate of
+// EnergyMsg values.
+const chunksPerSecond = 30
+
+// Player plays a Source in the background and queues the messages its
+// analysis produces for Listen.
+type Player struct {
+	src    Source
+	events chan tea.Msg
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+	pos    atomic.Int64 // nanoseconds played
+
+	cmd *exec.Cmd
+	out io.WriteCloser
+}
+
+// Play starts playing src. Without a usable audio player on the system it
+// plays silently, still sending messages at the pace of the music.
+func Play(src Source) (*Player, error) {
+	if src.SampleRate() <= 0 || src.Channels() <= 0 {
+		return nil, errors.New("source has no sample rate or channels")
+	}
+	p := &Player{
+		src:    src,
+		events: make(chan tea.Msg, 64),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if cmd := output(src.SampleRate(), src.Channels()); cmd != nil {
+		out, err := cmd.StdinPipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Start(); err == nil {
+			p.cmd, p.out = cmd, out
+		}
+	}
+	go p.run()
+	return p, nil
+}
+
+// Silent reports whether the music is only being analyzed, not heard.
+func (p *Player) Silent() bool {
+	return p.cmd == nil
+}
+
+// Listen returns a command that waits for the next message. Re-issue it
+// from Update after each audio message to keep them coming; after DoneMsg
+// it returns nothing.
+func (p *Player) Listen() tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-p.events
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// Position returns how much of the source has been played.
+func (p *Player) Position() time.Duration {
+	return time.Duration(p.pos.Load())
+}
+
+// Stop ends playback. It is safe to call more than once.
+func (p *Player) Stop() {
+	p.once.Do(func() {
+		close(p.stop)
+		if p.cmd != nil {
+			p.cmd.Process.Kill()
+		}
+		<-p.done
+		if p.cmd != nil {
+			p.out.Close()
+			p.cmd.Wait()
+		}
+	})
+}
+
+// run feeds the source to the output a chunk at a time, keeping to the
+// wall clock so messages arrive as the music they describe is heard.
+func (p *Player) run() {
+	defer close(p.done)
+	defer close(p.events)
+
+	rate, channels := p.src.SampleRate(), p.src.Channels()
+	frames := rate / chunksPerSecond
+	buf := make([]float32, frames*channels)
+	pcm := make([]byte, len(buf)*2)
+	an := newAnalyzer(rate)
+	start := time.Now()
+	played := 0 // frames
+
+	for {
+		n, err := p.src.Read(buf)
+		if n > 0 {
+			at := time.Duration(played) * time.Second / time.Duration(rate)
+			select {
+			case <-time.After(time.Until(start.Add(at))):
+			case <-p.stop:
+				return
+			}
+			if p.out != nil {
+				for i, v := range buf[:n] {
+					s := int16(math.Max(-1, math.Min(1, float64(v))) * 32767)
+					binary.LittleEndian.PutUint16(pcm[i*2:], uint16(s))
+				}
+				if _, werr := p.out.Write(pcm[:n*2]); werr != nil {
+					// The player went away; carry on silently
+					p.out = nil
+				}
+			}
+			played += n / channels
+			end := time.Duration(played) * time.Second / time.Duration(rate)
+			p.pos.Store(int64(end))
+
+			if rs, ok := p.src.(rowSource); ok {
+				for _, row := range rs.rows() {
+					if !p.send(row) {
+						return
+					}
+				}
+			}
+			energy, beat := an.feed(buf[:n], channels, end)
+			if beat != nil && !p.send(*beat) {
+				return
+			}
+			// Energy is sent continuously, so a slow reader just misses
+			// some rather than falling behind the music
+			select {
+			case p.events <- energy:
+			default:
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			p.send(DoneMsg{Err: err})
+			return
+		}
+	}
+}
+
+// rowSource is a Source that reports the pattern rows it plays, such as a
+// Module.
+type rowSource interface {
+	rows() []RowMsg
+}
+
+// send queues a message that must not be dropped, giving up on Stop.
+func (p *Player) send(msg tea.Msg) bool {
+	select {
+	case p.events <- msg:
+		return true
+	case <-p.stop:
+		return false
+	}
+}
+
+// output returns a command that plays signed 16-bit little-endian samples
+// from its standard input, or nil if none is available.
+func output(rate, channels int) *exec.Cmd {
+	if strings.ToLower(os.Getenv("SHOWCASE_AUDIO")) == "off" {
+		return nil
+	}
+	r, c := strconv.Itoa(rate), strconv.Itoa(channels)
+	players := [][]string{
+		{"pw-play", "--rate=" + r, "--channels=" + c, "--format=s16", "-"},
+		{"paplay", "--raw", "--rate=" + r, "--channels=" + c, "--format=s16le"},
+		{"aplay", "-q", "-t", "raw", "-f", "S16_LE", "-r", r, "-c", c, "-"},
+		{"play", "-q", "-t", "raw", "-r", r, "-e", "signed", "-b", "16", "-c", c, "-"},
+	}
+	for _, args := range players {
+		if path, err := exec.LookPath(args[0]); err == nil {
+			return exec.Command(path, args[1:]...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,939 @@
+package audio
+
+import (
+	"io"
+	"math"
+	"time"
+)
+
+// Module is a tracker song, a ProTracker MOD or FastTracker 2 XM, played by
+// running its patterns the way the tracker would. It is a Source, and a
+// Player playing one also sends a RowMsg as each pattern row starts.
+type Module struct {
+	Title  string
+	Format string // "MOD" or "XM"
+	// Loop plays the song again from its restart position instead of
+	// ending when it gets back to an order it has already played.
+	Loop bool
+
+	channels    int
+	orders      []int
+	restart     int
+	patterns    []pattern
+	instruments []*instrument // indexed by instrument number; [0] is nil
+	pans        []int         // initial channel panning
+	speed       int           // initial ticks per row
+	tempo       int           // initial BPM
+	xm          bool
+	linear      bool    // XM linear frequency table rather than Amiga periods
+	clock       float64 // Amiga clock dividing periods into frequencies
+	minPeriod   float64
+	maxPeriod   float64
+
+	// Playback state
+	order, row  int
+	tick        int
+	curSpeed    int
+	curTempo    int
+	global      int // global volume, 0 to 64
+	patDelay    int
+	jumpSet     bool
+	breakSet    bool
+	nextOrder   int
+	nextRow     int
+	loopTo      int
+	visited     map[int]bool
+	ended       bool
+	frames      int64   // frames rendered
+	tickLeft    float64 // frames left in the current tick
+	chans       []channel
+	pendingRows []RowMsg
+}
+
+// RowMsg is sent as a Module starts a pattern row. Demos match on the row,
+// the pattern or the notes to time effects to the music.
+type RowMsg struct {
+	Time    time.Duration
+	Order   int // position in the song's order list
+	Pattern int
+	Row     int
+	Notes   []Note // notes starting on this row
+}
+
+// Note is a note struck on a row.
+type Note struct {
+	Channel    int
+	Instrument int // 1-based, as the tracker shows it
+	Key        int // semitones above C-0, so 48 is C-4
+}
+
+// Rates the tracker renders at.
+const (
+	moduleRate     = 44100
+	moduleChannels = 2
+)
+
+// Cell values with special meaning.
+const (
+	keyOff = 97 // XM note that releases the channel
+)
+
+type pattern struct {
+	rows  int
+	cells []cell // rows × channels
+}
+
+type cell struct {
+	note  uint8 // 1 is C-0, keyOff releases, 0 is no note
+	inst  uint8
+	vol   uint8 // XM volume column
+	fx    uint8
+	param uint8
+}
+
+// emptyPattern stands in for patterns the order list names but the file
+// does not contain.
+var emptyPattern = pattern{rows: 64}
+
+type sample struct {
+	data      []float32
+	loopStart int
+	loopLen   int
+	pingpong  bool
+	volume    int // 0 to 64
+	finetune  int // 1/128 semitones
+	relNote   int
+	pan       int // 0 to 255
+}
+
+type instrument struct {
+	name    string
+	samples []*sample
+	keymap  [96]uint8
+	volEnv  envelope
+	panEnv  envelope
+	fadeout int
+}
+
+// sample returns the sample an instrument plays for note, or nil.
+func (in *instrument) sample(note int) *sample {
+	if note < 0 || note >= len(in.keymap) {
+		return nil
+	}
+	if i := int(in.keymap[note]); i < len(in.samples) {
+		return in.samples[i]
+	}
+	return nil
+}
+
+type envPoint struct {
+	tick, value int
+}
+
+// envelope is an XM volume or panning envelope, values 0 to 64 over ticks.
+type envelope struct {
+	points    []envPoint
+	sustain   int
+	loopStart int
+	loopEnd   int
+	on        bool
+	sus       bool
+	loop      bool
+}
+
+// value returns the envelope at tick, interpolating between points.
+func (e *envelope) value(tick int) float64 {
+	if len(e.points) == 0 {
+		return 64
+	}
+	for i := 1; i < len(e.points); i++ {
+		a, b := e.points[i-1], e.points[i]
+		if tick < b.tick {
+			if b.tick == a.tick || tick <= a.tick {
+				return float64(a.value)
+			}
+			f := float64(tick-a.tick) / float64(b.tick-a.tick)
+			return float64(a.value) + f*float64(b.value-a.value)
+		}
+	}
+	return float64(e.points[len(e.points)-1].value)
+}
+
+// next returns the tick after pos, holding at the sustain point until the
+// note is released and wrapping around the loop.
+func (e *envelope) next(pos int, released bool) int {
+	n := len(e.points)
+	if e.sus && !released && e.sustain < n && pos == e.points[e.sustain].tick {
+		return pos
+	}
+	pos++
+	if e.loop && e.loopStart < n && e.loopEnd < n && pos >= e.points[e.loopEnd].tick {
+		pos = e.points[e.loopStart].tick
+	}
+	return pos
+}
+
+type channel struct {
+	inst    *instrument
+	instNum int
+	smp     *sample
+
+	pos       float64 // position in the sample
+	backwards bool    // playing a ping-pong loop in reverse
+	active    bool
+
+	period   float64
+	target   float64 // tone portamento target
+	finetune int
+	vol      int // 0 to 64
+	pan      int // 0 to 255
+	cell     cell
+
+	released bool
+	fade     int // fadeout volume, 65536 down to 0
+	volEnv   int
+	panEnv   int
+
+	// Per-tick adjustments
+	arp     int     // arpeggio semitones
+	vibrato float64 // period offset
+	tremolo int     // volume offset
+	muted   bool    // tremor off phase
+
+	// Effect memories
+	portaUp, portaDown, portaSpeed int
+	fineUp, fineDown               int
+	xfineUp, xfineDown             int
+	vibSpeed, vibDepth, vibPos     int
+	vibWave                        int
+	tremSpeed, tremDepth, tremPos  int
+	tremWave                       int
+	volSlide, fineVol              int
+	globalSlide, panSlide          int
+	offset                         int
+	retrig, retrigCount            int
+	tremor, tremorCount            int
+	loopRow, loopCount             int
+
+	// Output for the current tick
+	step        float64
+	left, right float32
+}
+
+// newModule returns an empty module ready for a parser to fill in.
+func newModule(format string, channels int) *Module {
+	m := &Module{
+		Format:   format,
+		channels: channels,
+		pans:     make([]int, channels),
+		speed:    6,
+		tempo:    125,
+	}
+	for i := range m.pans {
+		m.pans[i] = 128
+	}
+	return m
+}
+
+// SampleRate implements Source.
+func (m *Module) SampleRate() int {
+	return moduleRate
+}
+
+// Channels implements Source.
+func (m *Module) Channels() int {
+	return moduleChannels
+}
+
+// Rewind moves playback back to the start of the song.
+func (m *Module) Rewind() {
+	m.order, m.row, m.tick = 0, 0, 0
+	m.curSpeed, m.curTempo = m.speed, m.tempo
+	m.global = 64
+	m.patDelay = 0
+	m.jumpSet, m.breakSet, m.loopTo = false, false, -1
+	m.visited = map[int]bool{0: true}
+	m.ended = len(m.orders) == 0
+	m.frames, m.tickLeft = 0, 0
+	m.pendingRows = nil
+	m.chans = make([]channel, m.channels)
+	for i := range m.chans {
+		m.chans[i].pan = m.pans[i]
+		m.chans[i].fade = 65536
+	}
+}
+
+// Read implements Source.
+func (m *Module) Read(buf []float32) (int, error) {
+	// The tick that ends the song still plays out, across reads if need be
+	if m.ended && m.tickLeft < 1 {
+		return 0, io.EOF
+	}
+	frames := len(buf) / moduleChannels
+	clear(buf[:frames*moduleChannels])
+	done := 0
+	for done < frames {
+		if m.tickLeft < 1 {
+			if m.ended {
+				break
+			}
+			m.process()
+			m.tickLeft += moduleRate * 2.5 / float64(m.curTempo)
+		}
+		n := min(frames-done, int(m.tickLeft))
+		out := buf[done*moduleChannels : (done+n)*moduleChannels]
+		for i := range m.chans {
+			m.chans[i].mix(out)
+		}
+		done += n
+		m.tickLeft -= float64(n)
+		m.frames += int64(n)
+	}
+	gain := float32(1 / math.Sqrt(float64(m.channels)))
+	for i, v := range buf[:done*moduleChannels] {
+		buf[i] = max(-1, min(1, v*gain))
+	}
+	return done * moduleChannels, nil
+}
+
+// rows returns the rows started since the last call. The Player sends them
+// on as RowMsg values.
+func (m *Module) rows() []RowMsg {
+	rows := m.pendingRows
+	m.pendingRows = nil
+	return rows
+}
+
+func (m *Module) pattern(order int) *pattern {
+	if n := m.orders[order]; n < len(m.patterns) {
+		return &m.patterns[n]
+	}
+	return &emptyPattern
+}
+
+// process runs one tick: the row's notes and effects on the first tick of a
+// row, the sliding effects on the rest.
+func (m *Module) process() {
+	t := m.tick % m.curSpeed
+	if m.tick == 0 {
+		m.startRow()
+	} else if t != 0 {
+		for i := range m.chans {
+			m.effectTick(&m.chans[i], t)
+		}
+	}
+	for i := range m.chans {
+		m.output(&m.chans[i])
+	}
+	m.tick++
+	if m.tick >= m.curSpeed*(1+m.patDelay) {
+		m.tick, m.patDelay = 0, 0
+		m.advance()
+	}
+}
+
+func (m *Module) startRow() {
+	pat := m.pattern(m.order)
+	msg := RowMsg{
+		Time:    time.Duration(m.frames) * time.Second / moduleRate,
+		Order:   m.order,
+		Pattern: m.orders[m.order],
+		Row:     m.row,
+	}
+	for i := range m.chans {
+		c := &m.chans[i]
+		var cl cell
+		if len(pat.cells) > 0 {
+			cl = pat.cells[m.row*m.channels+i]
+		}
+		c.cell = cl
+		c.arp, c.vibrato, c.tremolo, c.muted = 0, 0, 0, false
+		if cl.fx == 0xE && cl.param>>4 == 0xD && cl.param&0xF > 0 {
+			continue // the note delay effect triggers it later
+		}
+		if m.trigger(c, cl) {
+			msg.Notes = append(msg.Notes, Note{Channel: i, Instrument: c.instNum, Key: int(cl.note) - 1})
+		}
+		m.volumeColumn(c, 0)
+		m.rowEffect(c, cl)
+	}
+	m.pendingRows = append(m.pendingRows, msg)
+}
+
+// advance moves to the next row, following any jump, break or loop.
+func (m *Module) advance() {
+	switch {
+	case m.jumpSet || m.breakSet:
+		order, row := m.order+1, 0
+		if m.jumpSet {
+			order = m.nextOrder
+		}
+		if m.breakSet {
+			row = m.nextRow
+		}
+		m.enter(order, row)
+	case m.loopTo >= 0:
+		m.row = m.loopTo
+	default:
+		m.row++
+		if m.row >= m.pattern(m.order).rows {
+			m.enter(m.order+1, 0)
+		}
+	}
+	m.jumpSet, m.breakSet, m.loopTo = false, false, -1
+}
+
+// enter starts playing an order. Arriving at one already played means the
+// song has come round again, so it ends unless Loop is set.
+func (m *Module) enter(order, row int) {
+	if order >= len(m.orders) {
+		order = m.restart
+		if order >= len(m.orders) {
+			order = 0
+		}
+	}
+	if m.visited[order] {
+		if !m.Loop {
+			m.ended = true
+			return
+		}
+		clear(m.visited)
+	}
+	m.visited[order] = true
+	m.order, m.row = order, row
+	if m.row >= m.pattern(order).rows {
+		m.row = 0
+	}
+}
+
+// trigger handles a cell's note and instrument and reports whether a note
+// was struck.
+func (m *Module) trigger(c *channel, cl cell) bool {
+	porta := cl.fx == 0x3 || cl.fx == 0x5 || cl.vol>>4 == 0xF
+	if cl.inst > 0 {
+		c.instNum = int(cl.inst)
+		c.inst = nil
+		if c.instNum < len(m.instruments) {
+			c.inst = m.instruments[c.instNum]
+		}
+	}
+	struck := false
+	switch {
+	case cl.note == keyOff:
+		m.release(c)
+	case cl.note > 0 && c.inst != nil:
+		note := int(cl.note) - 1
+		s := c.inst.sample(note)
+		if porta && c.active {
+			if s == nil {
+				s = c.smp
+			}
+			if s != nil {
+				c.target = m.period(note+s.relNote, c.finetune)
+			}
+			break
+		}
+		if s == nil || len(s.data) == 0 {
+			c.active = false
+			break
+		}
+		c.smp = s
+		c.finetune = s.finetune
+		if cl.fx == 0xE && cl.param>>4 == 0x5 {
+			c.finetune = int(int8(cl.param<<4)>>4) * 16
+		}
+		c.period = m.period(note+s.relNote, c.finetune)
+		c.target = c.period
+		c.pos, c.backwards, c.active = 0, false, true
+		struck = true
+		if c.vibWave < 4 {
+			c.vibPos = 0
+		}
+		if c.tremWave < 4 {
+			c.tremPos = 0
+		}
+	}
+	if cl.inst > 0 && c.smp != nil {
+		c.vol = c.smp.volume
+		if m.xm {
+			c.pan = c.smp.pan
+		}
+		c.released, c.fade = false, 65536
+		c.volEnv, c.panEnv = 0, 0
+		c.tremorCount = 0
+	}
+	return struck
+}
+
+// release lets go of a note: envelopes leave their sustain and fade out,
+// and notes without a volume envelope stop.
+func (m *Module) release(c *channel) {
+	c.released = true
+	if c.inst == nil || !c.inst.volEnv.on {
+		c.vol = 0
+	}
+}
+
+// period returns the period of note, counted in semitones from C-0, with a
+// finetune in 1/128 semitones.
+func (m *Module) period(note, finetune int) float64 {
+	if m.linear {
+		return 7680 - float64(note)*64 - float64(finetune)/2
+	}
+	return 428 * math.Pow(2, (48-float64(note)-float64(finetune)/128)/12)
+}
+
+// unit is how far one step of a pitch effect moves the period: linear
+// periods are four times finer than Amiga ones.
+func (m *Module) unit() float64 {
+	if m.linear {
+		return 4
+	}
+	return 1
+}
+
+func (m *Module) clampPeriod(c *channel) {
+	c.period = max(m.minPeriod, min(m.maxPeriod, c.period))
+}
+
+// frequency returns the playback rate of a sample at period.
+func (m *Module) frequency(period float64) float64 {
+	if m.linear {
+		return 8363 * math.Pow(2, (4608-period)/768)
+	}
+	if period <= 0 {
+		return 0
+	}
+	return m.clock / period
+}
+
+// volumeColumn runs an XM volume column command on tick t.
+func (m *Module) volumeColumn(c *channel, t int) {
+	v := c.cell.vol
+	x := int(v & 0xF)
+	switch v >> 4 {
+	case 0x1, 0x2, 0x3, 0x4, 0x5:
+		if t == 0 {
+			c.vol = min(int(v)-0x10, 64)
+		}
+	case 0x6:
+		if t > 0 {
+			c.vol = max(c.vol-x, 0)
+		}
+	case 0x7:
+		if t > 0 {
+			c.vol = min(c.vol+x, 64)
+		}
+	case 0x8:
+		if t == 0 {
+			c.vol = max(c.vol-x, 0)
+		}
+	case 0x9:
+		if t == 0 {
+			c.vol = min(c.vol+x, 64)
+		}
+	case 0xA:
+		if t == 0 && x > 0 {
+			c.vibSpeed = x
+		}
+	case 0xB:
+		if t == 0 && x > 0 {
+			c.vibDepth = x
+		}
+		if t > 0 {
+			m.vibrato(c)
+		}
+	case 0xC:
+		if t == 0 {
+			c.pan = x << 4
+		}
+	case 0xD:
+		if t > 0 {
+			c.pan = max(c.pan-x, 0)
+		}
+	case 0xE:
+		if t > 0 {
+			c.pan = min(c.pan+x, 255)
+		}
+	case 0xF:
+		if t == 0 && x > 0 {
+			c.portaSpeed = x << 4
+		}
+		if t > 0 {
+			m.tonePorta(c)
+		}
+	}
+}
+
+// rowEffect runs the part of an effect that happens once, on the first
+// tick of the row.
+func (m *Module) rowEffect(c *channel, cl cell) {
+	p := int(cl.param)
+	x, y := p>>4, p&0xF
+	switch cl.fx {
+	case 0x1:
+		m.remember(&c.portaUp, p)
+	case 0x2:
+		m.remember(&c.portaDown, p)
+	case 0x3:
+		if p > 0 {
+			c.portaSpeed = p
+		}
+	case 0x4:
+		if x > 0 {
+			c.vibSpeed = x
+		}
+		if y > 0 {
+			c.vibDepth = y
+		}
+	case 0x5, 0x6, 0xA:
+		m.remember(&c.volSlide, p)
+	case 0x7:
+		if x > 0 {
+			c.tremSpeed = x
+		}
+		if y > 0 {
+			c.tremDepth = y
+		}
+	case 0x8:
+		c.pan = p
+	case 0x9:
+		if p > 0 {
+			c.offset = p
+		}
+		if cl.note > 0 && cl.note < keyOff && c.smp != nil {
+			c.pos = float64(c.offset * 256)
+			if int(c.pos) >= len(c.smp.data) {
+				c.active = false
+			}
+		}
+	case 0xB:
+		m.nextOrder, m.jumpSet = p, true
+	case 0xC:
+		c.vol = min(p, 64)
+	case 0xD:
+		m.nextRow, m.breakSet = x*10+y, true
+	case 0xE:
+		switch x {
+		case 0x1:
+			m.remember(&c.fineUp, y)
+			c.period -= float64(c.fineUp) * m.unit()
+			m.clampPeriod(c)
+		case 0x2:
+			m.remember(&c.fineDown, y)
+			c.period += float64(c.fineDown) * m.unit()
+			m.clampPeriod(c)
+		case 0x4:
+			c.vibWave = y
+		case 0x6:
+			switch {
+			case y == 0:
+				c.loopRow = m.row
+			case c.loopCount == 0:
+				c.loopCount = y
+				m.loopTo = c.loopRow
+			default:
+				c.loopCount--
+				if c.loopCount > 0 {
+					m.loopTo = c.loopRow
+				}
+			}
+		case 0x7:
+			c.tremWave = y
+		case 0x8:
+			c.pan = y * 17
+		case 0xA:
+			m.remember(&c.fineVol, y)
+			c.vol = min(c.vol+c.fineVol, 64)
+		case 0xB:
+			m.remember(&c.fineVol, y)
+			c.vol = max(c.vol-c.fineVol, 0)
+		case 0xC:
+			if y == 0 {
+				c.vol = 0
+			}
+		case 0xE:
+			if m.patDelay == 0 {
+				m.patDelay = y
+			}
+		}
+	case 0xF:
+		switch {
+		case p == 0:
+		case p < 32:
+			m.curSpeed = p
+		default:
+			m.curTempo = p
+		}
+	case 0x10: // G: global volume
+		m.global = min(p, 64)
+	case 0x11: // H: global volume slide
+		m.remember(&c.globalSlide, p)
+	case 0x14: // K: key off
+		if p == 0 {
+			m.release(c)
+		}
+	case 0x15: // L: envelope position
+		c.volEnv, c.panEnv = p, p
+	case 0x19: // P: panning slide
+		m.remember(&c.panSlide, p)
+	case 0x1B: // R: multi retrigger
+		if p > 0 {
+			c.retrig = p
+		}
+		c.retrigCount = 0
+	case 0x1D: // T: tremor
+		if p > 0 {
+			c.tremor = p
+		}
+	case 0x21: // X: extra fine portamento
+		switch x {
+		case 0x1:
+			m.remember(&c.xfineUp, y)
+			c.period -= float64(c.xfineUp) * m.unit() / 4
+		case 0x2:
+			m.remember(&c.xfineDown, y)
+			c.period += float64(c.xfineDown) * m.unit() / 4
+		}
+		m.clampPeriod(c)
+	}
+}
+
+// remember stores an effect parameter. FastTracker 2 reuses the last
+// non-zero one when a parameter is zero; ProTracker takes it as given.
+func (m *Module) remember(memory *int, p int) {
+	if p != 0 || !m.xm {
+		*memory = p
+	}
+}
+
+// effectTick runs the sliding part of the row's effects on tick t, after
+// the first.
+func (m *Module) effectTick(c *channel, t int) {
+	cl := c.cell
+	p := int(cl.param)
+	x, y := p>>4, p&0xF
+	c.arp, c.vibrato, c.tremolo = 0, 0, 0
+	m.volumeColumn(c, t)
+	switch cl.fx {
+	case 0x0:
+		if p != 0 {
+			c.arp = [3]int{0, x, y}[t%3]
+		}
+	case 0x1:
+		c.period -= float64(c.portaUp) * m.unit()
+		m.clampPeriod(c)
+	case 0x2:
+		c.period += float64(c.portaDown) * m.unit()
+		m.clampPeriod(c)
+	case 0x3:
+		m.tonePorta(c)
+	case 0x4:
+		m.vibrato(c)
+	case 0x5:
+		m.tonePorta(c)
+		m.volumeSlide(c)
+	case 0x6:
+		m.vibrato(c)
+		m.volumeSlide(c)
+	case 0x7:
+		c.tremolo = wave(c.tremWave, c.tremPos) * c.tremDepth / 64
+		c.tremPos = (c.tremPos + c.tremSpeed) & 63
+	case 0xA:
+		m.volumeSlide(c)
+	case 0xE:
+		switch x {
+		case 0x9:
+			if y > 0 && t%y == 0 {
+				c.pos, c.backwards = 0, false
+			}
+		case 0xC:
+			if t == y {
+				c.vol = 0
+			}
+		case 0xD:
+			if t == y {
+				m.trigger(c, cl)
+				m.volumeColumn(c, 0)
+			}
+		}
+	case 0x11:
+		gx, gy := c.globalSlide>>4, c.globalSlide&0xF
+		if gx > 0 {
+			m.global = min(m.global+gx, 64)
+		} else {
+			m.global = max(m.global-gy, 0)
+		}
+	case 0x14:
+		if t == p {
+			m.release(c)
+		}
+	case 0x19:
+		px, py := c.panSlide>>4, c.panSlide&0xF
+		if px > 0 {
+			c.pan = min(c.pan+px, 255)
+		} else {
+			c.pan = max(c.pan-py, 0)
+		}
+	case 0x1B:
+		m.multiRetrig(c)
+	case 0x1D:
+		on, off := c.tremor>>4+1, c.tremor&0xF+1
+		c.muted = c.tremorCount%(on+off) >= on
+		c.tremorCount++
+	}
+}
+
+func (m *Module) tonePorta(c *channel) {
+	step := float64(c.portaSpeed) * m.unit()
+	if c.period < c.target {
+		c.period = min(c.period+step, c.target)
+	} else {
+		c.period = max(c.period-step, c.target)
+	}
+}
+
+func (m *Module) vibrato(c *channel) {
+	c.vibrato = float64(wave(c.vibWave, c.vibPos)*c.vibDepth) / 128 * m.unit()
+	c.vibPos = (c.vibPos + c.vibSpeed) & 63
+}
+
+func (m *Module) volumeSlide(c *channel) {
+	x, y := c.volSlide>>4, c.volSlide&0xF
+	if x > 0 {
+		c.vol = min(c.vol+x, 64)
+	} else {
+		c.vol = max(c.vol-y, 0)
+	}
+}
+
+// multiRetrig restarts the sample every few ticks, changing the volume
+// each time as FastTracker 2's Rxy does.
+func (m *Module) multiRetrig(c *channel) {
+	change, interval := c.retrig>>4, c.retrig&0xF
+	if interval == 0 {
+		return
+	}
+	c.retrigCount++
+	if c.retrigCount < interval {
+		return
+	}
+	c.retrigCount = 0
+	c.pos, c.backwards = 0, false
+	switch {
+	case change >= 1 && change <= 5:
+		c.vol -= 1 << (change - 1)
+	case change == 6:
+		c.vol = c.vol * 2 / 3
+	case change == 7:
+		c.vol /= 2
+	case change >= 9 && change <= 13:
+		c.vol += 1 << (change - 9)
+	case change == 14:
+		c.vol = c.vol * 3 / 2
+	case change == 15:
+		c.vol *= 2
+	}
+	c.vol = max(0, min(c.vol, 64))
+}
+
+// wave returns a vibrato or tremolo waveform at pos (0 to 63), from -255
+// to 255.
+func wave(kind, pos int) int {
+	switch kind & 3 {
+	case 1:
+		return 255 - pos*8
+	case 2:
+		if pos < 32 {
+			return 255
+		}
+		return -255
+	default:
+		return int(255 * math.Sin(2*math.Pi*float64(pos)/64))
+	}
+}
+
+// output works out a channel's pitch and volume for the tick and moves its
+// envelopes on.
+func (m *Module) output(c *channel) {
+	if !c.active || c.smp == nil {
+		return
+	}
+	period := c.period + c.vibrato
+	if m.linear {
+		period -= float64(c.arp) * 64
+	} else {
+		period /= math.Pow(2, float64(c.arp)/12)
+	}
+	c.step = m.frequency(max(m.minPeriod, min(m.maxPeriod, period))) / moduleRate
+
+	vol := float64(max(0, min(64, c.vol+c.tremolo))) / 64
+	pan := float64(c.pan)
+	if in := c.inst; in != nil && m.xm {
+		if in.volEnv.on {
+			vol *= in.volEnv.value(c.volEnv) / 64
+			c.volEnv = in.volEnv.next(c.volEnv, c.released)
+			if c.released {
+				c.fade = max(0, c.fade-in.fadeout*2)
+			}
+		}
+		if in.panEnv.on {
+			env := in.panEnv.value(c.panEnv) - 32
+			pan += env * (128 - math.Abs(pan-128)) / 32
+			c.panEnv = in.panEnv.next(c.panEnv, c.released)
+		}
+	}
+	vol *= float64(c.fade) / 65536 * float64(m.global) / 64
+	if c.muted {
+		vol = 0
+	}
+	pan = max(0, min(255, pan))
+	c.left = float32(vol * math.Sqrt((255-pan)/255))
+	c.right = float32(vol * math.Sqrt(pan/255))
+}
+
+// mix adds the channel's sound for len(out)/2 frames to out.
+func (c *channel) mix(out []float32) {
+	s := c.smp
+	if !c.active || s == nil || (c.left == 0 && c.right == 0) && s.loopLen > 0 {
+		return
+	}
+	end := len(s.data)
+	loopEnd := s.loopStart + s.loopLen
+	for i := 0; i+1 < len(out); i += 2 {
+		idx := int(c.pos)
+		if idx < 0 || idx >= end {
+			c.active = false
+			return
+		}
+		next := idx + 1
+		if s.loopLen > 0 && next >= loopEnd {
+			next = s.loopStart
+		}
+		v := s.data[idx]
+		if next < end {
+			v += (s.data[next] - v) * float32(c.pos-float64(idx))
+		}
+		out[i] += v * c.left
+		out[i+1] += v * c.right
+
+		if c.backwards {
+			c.pos -= c.step
+		} else {
+			c.pos += c.step
+		}
+		if s.loopLen == 0 {
+			continue
+		}
+		switch {
+		case s.pingpong && !c.backwards && c.pos >= float64(loopEnd):
+			c.pos = float64(loopEnd) - math.Mod(c.pos-float64(loopEnd), float64(s.loopLen)) - 1
+			c.backwards = true
+		case s.pingpong && c.backwards && c.pos < float64(s.loopStart):
+			c.pos = float64(s.loopStart) + math.Mod(float64(s.loopStart)-c.pos, float64(s.loopLen))
+			c.backwards = false
+		case !s.pingpong && c.pos >= float64(loopEnd):
+			c.pos = float64(s.loopStart) + math.Mod(c.pos-float64(loopEnd), float64(s.loopLen))
+		}
+	}
+}
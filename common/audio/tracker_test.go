@@ -0,0 +1,261 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// square is a 32-point square wave at half volume.
+func square() []int8 {
+	wave := make([]int8, 32)
+	for i := range wave {
+		wave[i] = 64
+		if i%16 >= 8 {
+			wave[i] = -64
+		}
+	}
+	return wave
+}
+
+// testMOD builds a four-channel ProTracker module with one looping square
+// wave sample and one pattern: C-4 on channel 0 at row 0, C-5 on channel 1
+// at row 16, and a pattern break at row 32 that ends the song.
+func testMOD() []byte {
+	data := make([]byte, 1084+64*4*4)
+	copy(data, "test song")
+	s := data[20:50]
+	copy(s, "square")
+	binary.BigEndian.PutUint16(s[22:], 16) // length in words
+	s[25] = 64                             // volume
+	binary.BigEndian.PutUint16(s[26:], 0)  // loop start
+	binary.BigEndian.PutUint16(s[28:], 16) // loop length
+	data[950], data[951] = 1, 127          // song length, restart
+	copy(data[1080:], "M.K.")
+
+	put := func(row, ch, period, inst, fx, param int) {
+		c := data[1084+(row*4+ch)*4:]
+		c[0] = byte(inst&0xF0 | period>>8)
+		c[1] = byte(period)
+		c[2] = byte(inst<<4 | fx)
+		c[3] = byte(param)
+	}
+	put(0, 0, 428, 1, 0, 0)
+	put(16, 1, 214, 1, 0, 0)
+	put(32, 2, 0, 0, 0xD, 0)
+	for _, v := range square() {
+		data = append(data, byte(v))
+	}
+	return data
+}
+
+// testXM builds a two-channel FastTracker 2 module with one looping square
+// wave sample and one pattern of four rows: C-4 on channel 0 at row 0, C-5
+// on channel 1 at row 2 and a change to speed 3 at row 3.
+func testXM() []byte {
+	le16 := func(b []byte, v int) []byte { return binary.LittleEndian.AppendUint16(b, uint16(v)) }
+	le32 := func(b []byte, v int) []byte { return binary.LittleEndian.AppendUint32(b, uint32(v)) }
+
+	data := []byte(xmMagic)
+	data = append(data, pad("test song", 20)...)
+	data = append(data, 0x1A)
+	data = append(data, pad("tracker", 20)...)
+	data = le16(data, 0x104)
+	data = le32(data, 276)
+	for _, v := range []int{1, 0, 2, 1, 1, 1, 6, 125} {
+		// song length, restart, channels, patterns, instruments, linear
+		// frequencies, speed and tempo
+		data = le16(data, v)
+	}
+	data = append(data, make([]byte, 256)...) // the order table: pattern 0
+
+	packed := []byte{
+		49, 1, 0, 0, 0, 0x80, // C-4, instrument 1
+		0x80, 0x80,
+		0x80, 0x83, 61, 1, // C-5, instrument 1
+		0x98, 0xF, 3, 0x80, // speed 3
+	}
+	data = le32(data, 9)
+	data = append(data, 0)
+	data = le16(data, 4)
+	data = le16(data, len(packed))
+	data = append(data, packed...)
+
+	in := le32(nil, 263)
+	in = append(in, pad("square", 22)...)
+	in = append(in, 0)
+	in = le16(in, 1)
+	in = le32(in, 40)
+	in = append(in, make([]byte, 263-len(in))...) // keymap, envelopes off
+
+	wave := square()
+	smp := le32(nil, len(wave))
+	smp = le32(smp, 0)
+	smp = le32(smp, len(wave))
+	smp = append(smp, 64, 0, 1, 128, 0, 0) // volume, finetune, forward loop, pan, relative note
+	smp = append(smp, pad("square", 22)...)
+	var old int8
+	for _, v := range wave {
+		smp = append(smp, byte(v-old))
+		old = v
+	}
+	return append(append(data, in...), smp...)
+}
+
+func pad(s string, n int) []byte {
+	b := make([]byte, n)
+	copy(b, s)
+	return b
+}
+
+// play reads a module to the end, returning its rows and how loud it got.
+func play(t *testing.T, m *Module) ([]RowMsg, float64) {
+	t.Helper()
+	buf := make([]float32, 4096)
+	var rows []RowMsg
+	peak := 0.0
+	for range 1000 {
+		n, err := m.Read(buf)
+		for _, v := range buf[:n] {
+			if v < -1 || v > 1 {
+				t.Fatalf("sample %g out of range", v)
+			}
+			peak = math.Max(peak, math.Abs(float64(v)))
+		}
+		rows = append(rows, m.rows()...)
+		if errors.Is(err, io.EOF) {
+			return rows, peak
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Fatal("the song never ended")
+	return nil, 0
+}
+
+func TestParseMOD(t *testing.T) {
+	m, err := ParseMOD(testMOD())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Title != "test song" || m.Format != "MOD" || m.channels != 4 {
+		t.Errorf("parsed %q, %s with %d channels", m.Title, m.Format, m.channels)
+	}
+	if name := m.instruments[1].name; name != "square" {
+		t.Errorf("sample 1 is %q, want square", name)
+	}
+
+	rows, peak := play(t, m)
+	// The break on row 32 ends the pattern, and with it the song
+	if len(rows) != 33 {
+		t.Fatalf("played %d rows, want 33", len(rows))
+	}
+	// At speed 6 and 125 BPM a row takes 0.12 seconds
+	want := map[int]RowMsg{
+		0:  {Time: 0, Notes: []Note{{Channel: 0, Instrument: 1, Key: 48}}},
+		16: {Time: 1920 * time.Millisecond, Row: 16, Notes: []Note{{Channel: 1, Instrument: 1, Key: 60}}},
+		32: {Time: 3840 * time.Millisecond, Row: 32},
+	}
+	for i, row := range rows {
+		w, ok := want[i]
+		if !ok {
+			w = RowMsg{Time: time.Duration(i) * 120 * time.Millisecond, Row: i}
+		}
+		if !reflect.DeepEqual(row, w) {
+			t.Errorf("row %d is %+v, want %+v", i, row, w)
+		}
+	}
+	if peak < 0.1 {
+		t.Errorf("played no louder than %g", peak)
+	}
+}
+
+func TestParseXM(t *testing.T) {
+	m, err := ParseXM(testXM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Title != "test song" || m.Format != "XM" || m.channels != 2 || !m.linear {
+		t.Errorf("parsed %q, %s with %d channels, linear %v", m.Title, m.Format, m.channels, m.linear)
+	}
+	if s := m.instruments[1].samples[0]; len(s.data) != 32 || s.loopLen != 32 || s.data[8] != -0.5 {
+		t.Errorf("sample has %d points looping over %d, the ninth %g", len(s.data), s.loopLen, s.data[8])
+	}
+
+	rows, peak := play(t, m)
+	want := []RowMsg{
+		{Time: 0, Notes: []Note{{Channel: 0, Instrument: 1, Key: 48}}},
+		{Time: 120 * time.Millisecond, Row: 1},
+		{Time: 240 * time.Millisecond, Row: 2, Notes: []Note{{Channel: 1, Instrument: 1, Key: 60}}},
+		{Time: 360 * time.Millisecond, Row: 3},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows are\n%+v\nwant\n%+v", rows, want)
+	}
+	// Speed 3 halves the last row
+	if got := time.Duration(m.frames) * time.Second / moduleRate; got != 420*time.Millisecond {
+		t.Errorf("played for %v, want 420ms", got)
+	}
+	if peak < 0.1 {
+		t.Errorf("played no louder than %g", peak)
+	}
+}
+
+func TestParseModuleErrors(t *testing.T) {
+	mod, xm := testMOD(), testXM()
+	noSongMOD := append([]byte(nil), mod...)
+	noSongMOD[950] = 0
+	noChannelsXM := append([]byte(nil), xm...)
+	noChannelsXM[68] = 0
+	// The instrument starts at 361 and its sample header size at 29
+	hugeSampleXM := append([]byte(nil), xm...)
+	binary.LittleEndian.PutUint32(hugeSampleXM[361+29:], 1<<24)
+	tests := []struct {
+		name  string
+		parse func([]byte) (*Module, error)
+		data  []byte
+		want  string
+	}{
+		{"MOD too short", ParseMOD, mod[:500], "not a MOD file"},
+		{"MOD without a song", ParseMOD, noSongMOD, "bad song length"},
+		{"MOD patterns cut short", ParseMOD, mod[:1200], "patterns cut short"},
+		{"XM too short", ParseXM, xm[:70], "not an XM file"},
+		{"XM without its magic", ParseXM, append([]byte("Extended Modulo: "), xm[17:]...), "not an XM file"},
+		{"XM without channels", ParseXM, noChannelsXM, "bad channel count 0"},
+		{"XM order table cut short", ParseXM, xm[:80], "order table cut short"},
+		{"XM pattern header cut short", ParseXM, xm[:340], "patterns cut short"},
+		{"XM pattern data cut short", ParseXM, xm[:350], "patterns cut short"},
+		{"XM instrument cut short", ParseXM, xm[:370], "instrument 1: cut short"},
+		{"XM instrument header cut short", ParseXM, xm[:500], "instrument 1: short instrument header"},
+		{"XM sample headers cut short", ParseXM, xm[:630], "instrument 1: sample headers cut short"},
+		{"XM sample header past the end", ParseXM, hugeSampleXM, "instrument 1: sample headers cut short"},
+	}
+	for _, tt := range tests {
+		_, err := tt.parse(tt.data)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestParseModuleTruncated(t *testing.T) {
+	// However short the file, parsing fails or gives a module that plays
+	for name, data := range map[string][]byte{"MOD": testMOD(), "XM": testXM()} {
+		parse := ParseMOD
+		if name == "XM" {
+			parse = ParseXM
+		}
+		for n := range data {
+			m, err := parse(data[:n])
+			if err == nil {
+				play(t, m)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// WAV format tags.
+const (
+	wavPCM        = 1
+	wavFloat      = 3
+	wavExtensible = 0xFFFE
+)
+
+// DecodeWAV decodes a RIFF WAVE stream of 8, 16, 24 or 32-bit integer or
+// 32-bit float samples.
+func DecodeWAV(r io.Reader) (*Track, error) {
+	var header [12]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	if string(header[0:4]) != "RIFF" || string(header[8:12]) != "WAVE" {
+		return nil, errors.New("not a WAV file")
+	}
+
+	var format, channels, bits int
+	var rate int
+	haveFormat := false
+	for {
+		var chunk [8]byte
+		if _, err := io.ReadFull(r, chunk[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, errors.New("no data chunk")
+			}
+			return nil, err
+		}
+		id := string(chunk[0:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:8]))
+
+		switch id {
+		case "fmt ":
+			data := make([]byte, size)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return nil, err
+			}
+			if len(data) < 16 {
+				return nil, errors.New("short fmt chunk")
+			}
+			format = int(binary.LittleEndian.Uint16(data[0:2]))
+			channels = int(binary.LittleEndian.Uint16(data[2:4]))
+			rate = int(binary.LittleEndian.Uint32(data[4:8]))
+			bits = int(binary.LittleEndian.Uint16(data[14:16]))
+			if format == wavExtensible && len(data) >= 26 {
+				// The real format is the start of the sub-format GUID
+				format = int(binary.LittleEndian.Uint16(data[24:26]))
+			}
+			haveFormat = true
+		case "data":
+			if !haveFormat {
+				return nil, errors.New("data before fmt chunk")
+			}
+			data := make([]byte, size)
+			n, err := io.ReadFull(r, data)
+			if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, err
+			}
+			// A truncated file still plays up to where it stops
+			samples, err := pcm(data[:n], format, bits)
+			if err != nil {
+				return nil, err
+			}
+			return newTrack(rate, channels, samples)
+		default:
+			if _, err := io.CopyN(io.Discard, r, size+size%2); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if size%2 == 1 {
+			io.CopyN(io.Discard, r, 1) // chunks are padded to even sizes
+		}
+	}
+}
+
+// pcm converts raw sample data to floats.
+func pcm(data []byte, format, bits int) ([]float32, error) {
+	width := bits / 8
+	if width == 0 {
+		return nil, fmt.Errorf("%d-bit samples", bits)
+	}
+	out := make([]float32, len(data)/width)
+	switch {
+	case format == wavFloat && bits == 32:
+		for i := range out {
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+	case format == wavPCM && bits == 8:
+		for i := range out {
+			out[i] = float32(int(data[i])-128) / 128
+		}
+	case format == wavPCM && bits == 16:
+		for i := range out {
+			out[i] = float32(int16(binary.LittleEndian.Uint16(data[i*2:]))) / 32768
+		}
+	case format == wavPCM && bits == 24:
+		for i := range out {
+			b := data[i*3:]
+			v := int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24) >> 8
+			out[i] = float32(v) / (1 << 23)
+		}
+	case format == wavPCM && bits == 32:
+		for i := range out {
+			out[i] = float32(int32(binary.LittleEndian.Uint32(data[i*4:]))) / (1 << 31)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported WAV format %d with %d-bit samples", format, bits)
+	}
+	return out, nil
+}
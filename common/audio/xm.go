@@ -0,0 +1,234 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// xmMagic starts every FastTracker 2 module.
+const xmMagic = "Extended Module: "
+
+// ParseXM reads a FastTracker 2 module.
+func ParseXM(data []byte) (*Module, error) {
+	if len(data) < 80 || string(data[:17]) != xmMagic {
+		return nil, errors.New("not an XM file")
+	}
+	u16 := func(off int) int { return int(binary.LittleEndian.Uint16(data[off:])) }
+	u32 := func(off int) int { return int(binary.LittleEndian.Uint32(data[off:])) }
+
+	channels := u16(68)
+	if channels < 1 || channels > 64 {
+		return nil, fmt.Errorf("bad channel count %d", channels)
+	}
+	m := newModule("XM", channels)
+	m.Title = cstring(data[17:37])
+	m.xm = true
+	m.linear = u16(74)&1 != 0
+	m.clock = 3579364 // what makes C-4 play at 8363 Hz
+	m.minPeriod, m.maxPeriod = 1, 32000
+	if m.linear {
+		m.minPeriod, m.maxPeriod = 0, 7680
+	}
+	if speed := u16(76); speed > 0 && speed < 32 {
+		m.speed = speed
+	}
+	if tempo := u16(78); tempo >= 32 {
+		m.tempo = tempo
+	}
+	songLen, restart := min(u16(64), 256), u16(66)
+	if restart < songLen {
+		m.restart = restart
+	}
+	if len(data) < 80+songLen {
+		return nil, errors.New("order table cut short")
+	}
+	for _, p := range data[80 : 80+songLen] {
+		m.orders = append(m.orders, int(p))
+	}
+
+	off := 60 + u32(60)
+	for p, n := 0, u16(70); p < n; p++ {
+		if off+9 > len(data) {
+			return nil, errors.New("patterns cut short")
+		}
+		rows, packed := u16(off+5), u16(off+7)
+		off += u32(off)
+		if off+packed > len(data) {
+			return nil, errors.New("patterns cut short")
+		}
+		pat := pattern{rows: rows, cells: make([]cell, rows*channels)}
+		if packed == 0 {
+			// Empty patterns are stored with no data at all
+			pat.cells = nil
+		} else {
+			unpackXM(data[off:off+packed], pat.cells)
+		}
+		m.patterns = append(m.patterns, pat)
+		off += packed
+	}
+
+	m.instruments = make([]*instrument, u16(72)+1)
+	for i := 1; i < len(m.instruments); i++ {
+		in, next, err := parseXMInstrument(data, off)
+		if err != nil {
+			return nil, fmt.Errorf("instrument %d: %w", i, err)
+		}
+		m.instruments[i] = in
+		off = next
+	}
+	m.Rewind()
+	return m, nil
+}
+
+// unpackXM decodes pattern data. A byte with the top bit set says which of
+// note, instrument, volume, effect and parameter follow; any other byte is
+// a note followed by all four.
+func unpackXM(data []byte, cells []cell) {
+	i := 0
+	next := func() uint8 {
+		if i >= len(data) {
+			return 0
+		}
+		b := data[i]
+		i++
+		return b
+	}
+	for c := range cells {
+		if i >= len(data) {
+			return
+		}
+		flags := next()
+		if flags&0x80 == 0 {
+			cells[c] = cell{note: flags, inst: next(), vol: next(), fx: next(), param: next()}
+			continue
+		}
+		var cl cell
+		if flags&1 != 0 {
+			cl.note = next()
+		}
+		if flags&2 != 0 {
+			cl.inst = next()
+		}
+		if flags&4 != 0 {
+			cl.vol = next()
+		}
+		if flags&8 != 0 {
+			cl.fx = next()
+		}
+		if flags&16 != 0 {
+			cl.param = next()
+		}
+		cells[c] = cl
+	}
+}
+
+// parseXMInstrument reads the instrument at off with its samples, and
+// returns the offset after them.
+func parseXMInstrument(data []byte, off int) (*instrument, int, error) {
+	if off+29 > len(data) {
+		return nil, 0, errors.New("cut short")
+	}
+	u16 := func(o int) int { return int(binary.LittleEndian.Uint16(data[o:])) }
+	u32 := func(o int) int { return int(binary.LittleEndian.Uint32(data[o:])) }
+
+	size := max(u32(off), 29)
+	in := &instrument{name: cstring(data[off+4 : off+26])}
+	count := u16(off + 27)
+	if count == 0 {
+		return in, off + size, nil
+	}
+	if size < 241 || off+size > len(data) {
+		return nil, 0, errors.New("short instrument header")
+	}
+	h := off
+	headerSize := u32(h + 29)
+	if headerSize == 0 {
+		headerSize = 40
+	}
+	copy(in.keymap[:], data[h+33:h+129])
+	in.volEnv = xmEnvelope(data[h+129:h+177], data[h+225], data[h+227:h+230], data[h+233])
+	in.panEnv = xmEnvelope(data[h+177:h+225], data[h+226], data[h+230:h+233], data[h+234])
+	in.fadeout = u16(h + 239)
+	off += size
+
+	lengths := make([]int, count)
+	sixteen := make([]bool, count)
+	for i := 0; i < count; i++ {
+		if off+18 > len(data) {
+			return nil, 0, errors.New("sample headers cut short")
+		}
+		t := data[off+14]
+		s := &sample{
+			loopStart: u32(off + 4),
+			loopLen:   u32(off + 8),
+			volume:    min(int(data[off+12]), 64),
+			finetune:  int(int8(data[off+13])),
+			pingpong:  t&3 == 2,
+			pan:       int(data[off+15]),
+			relNote:   int(int8(data[off+16])),
+		}
+		if t&3 == 0 {
+			s.loopLen = 0
+		}
+		lengths[i] = u32(off)
+		sixteen[i] = t&0x10 != 0
+		if sixteen[i] {
+			s.loopStart /= 2
+			s.loopLen /= 2
+		}
+		in.samples = append(in.samples, s)
+		off += headerSize
+	}
+	if off > len(data) {
+		return nil, 0, errors.New("sample headers cut short")
+	}
+
+	// Sample data is stored as deltas from the previous sample point
+	for i, s := range in.samples {
+		n := min(lengths[i], max(len(data)-off, 0))
+		raw := data[off : off+n]
+		off += n
+		if sixteen[i] {
+			s.data = make([]float32, n/2)
+			var old int16
+			for j := range s.data {
+				old += int16(binary.LittleEndian.Uint16(raw[j*2:]))
+				s.data[j] = float32(old) / 32768
+			}
+		} else {
+			s.data = make([]float32, n)
+			var old int8
+			for j, b := range raw {
+				old += int8(b)
+				s.data[j] = float32(old) / 128
+			}
+		}
+		fixLoop(s)
+	}
+	return in, off, nil
+}
+
+// xmEnvelope decodes an envelope: twelve tick and value pairs, the number
+// in use, the sustain and loop points, and flags turning the envelope,
+// sustain and loop on.
+func xmEnvelope(points []byte, count byte, marks []byte, flags byte) envelope {
+	e := envelope{
+		sustain:   int(marks[0]),
+		loopStart: int(marks[1]),
+		loopEnd:   int(marks[2]),
+		on:        flags&1 != 0,
+		sus:       flags&2 != 0,
+		loop:      flags&4 != 0,
+	}
+	for i := 0; i < int(min(count, 12)); i++ {
+		e.points = append(e.points, envPoint{
+			tick:  int(binary.LittleEndian.Uint16(points[i*4:])),
+			value: int(binary.LittleEndian.Uint16(points[i*4+2:])),
+		})
+	}
+	if len(e.points) == 0 {
+		e.on = false
+	}
+	return e
+}
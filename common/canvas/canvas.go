@@ -0,0 +1,233 @@
+// Package canvas provides the cell buffer used by the grid-based demos.
+//
+// Wide characters such as CJK ideographs and most emoji take two cells: the
+// character itself and a Continued cell after it. Overwriting either half
+// turns the other into a space, and a wide character that does not fit at
+// the right edge is drawn as a space, so rows always keep their width.
+package canvas
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// Cell is one character position on the canvas.
+type Cell struct {
+	Rune  rune
+	Style Style
+}
+
+// Continued is the Rune of the cell covered by the right half of a wide
+// character.
+const Continued rune = -1
+
+var blank = Cell{Rune: ' '}
+
+// widths measures runes the same way in every locale. East Asian locales
+// would otherwise make box drawing and block characters two cells wide.
+var widths = func() *runewidth.Condition {
+	c := runewidth.NewCondition()
+	c.EastAsianWidth = false
+	return c
+}()
+
+// RuneWidth returns the number of cells r takes on the terminal: 2 for wide
+// characters, 0 for combining marks and control characters, otherwise 1.
+func RuneWidth(r rune) int {
+	if r >= 0x20 && r < 0x7F {
+		return 1
+	}
+	return widths.RuneWidth(r)
+}
+
+// StringWidth returns the number of cells text takes.
+func StringWidth(text string) int {
+	n := 0
+	for _, r := range text {
+		n += RuneWidth(r)
+	}
+	return n
+}
+
+// Canvas is a fixed-size grid of styled cells. It remembers what it rendered
+// last frame so rows that have not changed are not styled again.
+type Canvas struct {
+	width  int
+	height int
+	cells  []Cell
+
+	front []Cell   // cells as of the last Render
+	rows  []string // rendered rows as of the last Render, nil when invalid
+}
+
+// New returns a blank canvas of the given size.
+func New(width, height int) *Canvas {
+	c := &Canvas{}
+	c.Resize(width, height)
+	return c
+}
+
+// Width returns the canvas width in cells.
+func (c *Canvas) Width() int {
+	return c.width
+}
+
+// Height returns the canvas height in cells.
+func (c *Canvas) Height() int {
+	return c.height
+}
+
+// Resize changes the canvas dimensions and clears it. The next Render redraws
+// every row.
+func (c *Canvas) Resize(width, height int) {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	c.width = width
+	c.height = height
+	c.cells = make([]Cell, width*height)
+	c.front = make([]Cell, width*height)
+	c.Clear()
+	c.Invalidate()
+}
+
+// Invalidate forces the next Render to redraw every row, for example after the
+// color profile changes.
+func (c *Canvas) Invalidate() {
+	c.rows = nil
+}
+
+// Clear resets every cell to a blank space.
+func (c *Canvas) Clear() {
+	for i := range c.cells {
+		c.cells[i] = blank
+	}
+}
+
+// InBounds reports whether (x, y) lies on the canvas.
+func (c *Canvas) InBounds(x, y int) bool {
+	return x >= 0 && x < c.width && y >= 0 && y < c.height
+}
+
+// Set writes a rune at (x, y). Writes outside the canvas are ignored. A wide
+// rune also covers (x+1, y); one cut in half by an edge leaves a space in
+// the half that is on the canvas. Zero-width runes are not written.
+func (c *Canvas) Set(x, y int, r rune, style Style) {
+	switch RuneWidth(r) {
+	case 0:
+		return
+	case 2:
+		switch {
+		case x == -1:
+			c.set(0, y, Cell{Rune: ' ', Style: style})
+		case x == c.width-1:
+			c.set(x, y, Cell{Rune: ' ', Style: style})
+		default:
+			c.set(x, y, Cell{Rune: r, Style: style})
+			c.set(x+1, y, Cell{Rune: Continued, Style: style})
+		}
+	default:
+		c.set(x, y, Cell{Rune: r, Style: style})
+	}
+}
+
+// set stores one cell, first breaking up any wide character it overlaps.
+func (c *Canvas) set(x, y int, cell Cell) {
+	if !c.InBounds(x, y) {
+		return
+	}
+	i := y*c.width + x
+	if c.cells[i].Rune == Continued && x > 0 {
+		c.cells[i-1].Rune = ' '
+	}
+	if x+1 < c.width && c.cells[i+1].Rune == Continued {
+		c.cells[i+1].Rune = ' '
+	}
+	c.cells[i] = cell
+}
+
+// SetString writes text starting at (x, y), clipping at the canvas edges.
+// Wide runes take two cells and zero-width runes none.
+func (c *Canvas) SetString(x, y int, text string, style Style) {
+	for _, r := range text {
+		c.Set(x, y, r, style)
+		x += RuneWidth(r)
+	}
+}
+
+// Get returns the cell at (x, y), or a blank cell when out of bounds. The
+// right half of a wide character is a cell whose Rune is Continued.
+func (c *Canvas) Get(x, y int) Cell {
+	if !c.InBounds(x, y) {
+		return blank
+	}
+	return c.cells[y*c.width+x]
+}
+
+// IsBlank reports whether the cell at (x, y) is an unstyled space.
+func (c *Canvas) IsBlank(x, y int) bool {
+	return c.Get(x, y) == blank
+}
+
+// Render returns the canvas as newline separated rows. Rows identical to the
+// previous frame reuse their rendered text; runs of cells sharing a style are
+// rendered together to keep escape sequences to a minimum.
+func (c *Canvas) Render() string {
+	if c.rows == nil {
+		c.rows = make([]string, c.height)
+		for y := 0; y < c.height; y++ {
+			c.rows[y] = c.renderRow(y)
+		}
+		copy(c.front, c.cells)
+		return strings.Join(c.rows, "\n")
+	}
+	for y := 0; y < c.height; y++ {
+		start, end := y*c.width, (y+1)*c.width
+		if rowEqual(c.cells[start:end], c.front[start:end]) {
+			continue
+		}
+		c.rows[y] = c.renderRow(y)
+		copy(c.front[start:end], c.cells[start:end])
+	}
+	return strings.Join(c.rows, "\n")
+}
+
+func rowEqual(a, b []Cell) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Canvas) renderRow(y int) string {
+	row := c.cells[y*c.width : (y+1)*c.width]
+	if colorProfile < termcolor.TrueColor && dither {
+		// Dither each cell against its position before grouping into runs
+		dithered := make([]Cell, len(row))
+		for x, cell := range row {
+			dithered[x] = Cell{Rune: cell.Rune, Style: cell.Style.quantize(x, y, true)}
+		}
+		row = dithered
+	}
+	var line, run strings.Builder
+	for x, cell := range row {
+		if x > 0 && cell.Style != row[x-1].Style {
+			line.WriteString(row[x-1].Style.Render(run.String()))
+			run.Reset()
+		}
+		if cell.Rune != Continued {
+			run.WriteRune(cell.Rune)
+		}
+	}
+	if len(row) > 0 {
+		line.WriteString(row[len(row)-1].Style.Render(run.String()))
+	}
+	return line.String()
+}
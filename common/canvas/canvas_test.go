@@ -0,0 +1,248 @@
+package canvas
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// rows returns the canvas as plain text, one string a row, with the right
+// halves of wide characters shown as "»".
+func rows(c *Canvas) []string {
+	var out []string
+	for y := 0; y < c.Height(); y++ {
+		var b strings.Builder
+		for x := 0; x < c.Width(); x++ {
+			if r := c.Get(x, y).Rune; r == Continued {
+				b.WriteRune('»')
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		out = append(out, b.String())
+	}
+	return out
+}
+
+func checkRows(t *testing.T, c *Canvas, want ...string) {
+	t.Helper()
+	got := rows(c)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("canvas is\n%q\nwant\n%q", got, want)
+	}
+}
+
+// trueColor renders without dithering for the length of the test.
+func trueColor(t *testing.T) {
+	old := ColorProfile()
+	SetColorProfile(termcolor.TrueColor)
+	t.Cleanup(func() { SetColorProfile(old) })
+}
+
+func TestSetAndClear(t *testing.T) {
+	c := New(4, 2)
+	checkRows(t, c, "    ", "    ")
+	if !c.IsBlank(0, 0) {
+		t.Error("new canvas is not blank")
+	}
+
+	bold := Style{Bold: true}
+	c.Set(0, 0, 'a', bold)
+	c.Set(3, 1, 'b', Style{})
+	c.SetString(1, 0, "xyz", Style{})
+	checkRows(t, c, "axyz", "   b")
+	if got := c.Get(0, 0); got != (Cell{Rune: 'a', Style: bold}) {
+		t.Errorf("Get(0, 0) = %+v", got)
+	}
+	if c.IsBlank(0, 0) {
+		t.Error("IsBlank is true for a styled cell")
+	}
+
+	// Writes off the canvas go nowhere, and reading there gives a blank
+	for _, p := range [][2]int{{-1, 0}, {4, 0}, {0, -1}, {0, 2}, {100, 100}} {
+		c.Set(p[0], p[1], '#', bold)
+		if !c.IsBlank(p[0], p[1]) || c.InBounds(p[0], p[1]) {
+			t.Errorf("(%d, %d) is on the canvas", p[0], p[1])
+		}
+	}
+	c.SetString(2, 1, "long text", Style{})
+	c.SetString(-2, 0, "..", Style{})
+	checkRows(t, c, "axyz", "  lo")
+
+	// Zero-width runes are not written
+	c.Set(0, 0, '\u0301', Style{})
+	checkRows(t, c, "axyz", "  lo")
+
+	c.Clear()
+	checkRows(t, c, "    ", "    ")
+	if !c.IsBlank(0, 0) {
+		t.Error("Clear left a styled cell")
+	}
+}
+
+func TestResize(t *testing.T) {
+	c := New(3, 2)
+	c.SetString(0, 0, "abc", Style{})
+	c.Resize(2, 3)
+	if c.Width() != 2 || c.Height() != 3 {
+		t.Fatalf("size after Resize is %dx%d, want 2x3", c.Width(), c.Height())
+	}
+	checkRows(t, c, "  ", "  ", "  ")
+	c.Set(1, 2, 'z', Style{})
+	if got := c.Render(); got != "  \n  \n z" {
+		t.Errorf("Render after Resize = %q", got)
+	}
+
+	c.Resize(-1, -5)
+	if c.Width() != 0 || c.Height() != 0 {
+		t.Errorf("negative Resize gave %dx%d", c.Width(), c.Height())
+	}
+	c.Set(0, 0, 'a', Style{})
+	if got := c.Render(); got != "" {
+		t.Errorf("empty canvas renders %q", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	trueColor(t)
+	red := Style{Fg: "#ff0000"}
+	blue := Style{Fg: "#0000ff"}
+	c := New(5, 2)
+	c.SetString(0, 0, "ab", red)
+	c.SetString(2, 0, "cd", blue)
+	c.Set(4, 0, '界', blue) // cut off at the edge
+	c.Set(0, 1, '界', red)
+	want := red.Render("ab") + blue.Render("cd ") + "\n" + red.Render("界") + "   "
+	if got := c.Render(); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+// TestRenderReusesRows checks that Render only redraws rows that changed
+// since the last frame, and every row after Resize or Invalidate.
+func TestRenderReusesRows(t *testing.T) {
+	c := New(3, 3)
+	c.SetString(0, 0, "abc", Style{})
+	if got := c.Render(); got != "abc\n   \n   " {
+		t.Fatalf("first Render() = %q", got)
+	}
+
+	// Mark every rendered row, so the ones drawn again show
+	stale := func() {
+		for y := range c.rows {
+			c.rows[y] = "old"
+		}
+	}
+	stale()
+	c.Set(1, 1, 'x', Style{})
+	if got := c.Render(); got != "old\n x \nold" {
+		t.Errorf("Render() after a change = %q", got)
+	}
+
+	// A row changed and changed back is the same as last frame
+	stale()
+	c.Set(0, 2, 'y', Style{})
+	c.Set(0, 2, ' ', Style{})
+	if got := c.Render(); got != "old\nold\nold" {
+		t.Errorf("Render() of an unchanged canvas = %q", got)
+	}
+
+	// Clear changes every row with anything on it
+	stale()
+	c.Clear()
+	if got := c.Render(); got != "   \n   \nold" {
+		t.Errorf("Render() after Clear = %q", got)
+	}
+
+	stale()
+	c.Invalidate()
+	if got := c.Render(); got != "   \n   \n   " {
+		t.Errorf("Render() after Invalidate = %q", got)
+	}
+
+	// Resizing starts over, even to the same size
+	stale()
+	c.Resize(3, 3)
+	c.Set(2, 0, 'z', Style{})
+	if got := c.Render(); got != "  z\n   \n   " {
+		t.Errorf("Render() after Resize = %q", got)
+	}
+	stale()
+	c.Set(2, 2, 'w', Style{})
+	if got := c.Render(); got != "old\nold\n  w" {
+		t.Errorf("Render() after Resize and a change = %q", got)
+	}
+}
+
+func TestWideRunes(t *testing.T) {
+	tests := []struct {
+		name string
+		draw func(c *Canvas)
+		want string
+	}{
+		{"whole", func(c *Canvas) { c.Set(1, 0, '界', Style{}) }, " 界» "},
+		{"string", func(c *Canvas) { c.SetString(0, 0, "a界b", Style{}) }, "a界»b"},
+		{"cut at right edge", func(c *Canvas) { c.Set(3, 0, '界', Style{}) }, "    "},
+		{"cut at left edge", func(c *Canvas) {
+			c.SetString(0, 0, "abcd", Style{})
+			c.Set(-1, 0, '界', Style{})
+		}, " bcd"},
+		{"clipped string", func(c *Canvas) { c.SetString(0, 0, "ab界", Style{}) }, "ab界»"},
+		{"clipped at edge", func(c *Canvas) { c.SetString(1, 0, "ab界", Style{}) }, " ab "},
+		{"overwrite left half", func(c *Canvas) {
+			c.Set(1, 0, '界', Style{})
+			c.Set(1, 0, 'x', Style{})
+		}, " x  "},
+		{"overwrite right half", func(c *Canvas) {
+			c.Set(1, 0, '界', Style{})
+			c.Set(2, 0, 'x', Style{})
+		}, "  x "},
+		{"overlap from the left", func(c *Canvas) {
+			c.Set(1, 0, '界', Style{})
+			c.Set(0, 0, '世', Style{})
+		}, "世»  "},
+		{"overlap from the right", func(c *Canvas) {
+			c.Set(0, 0, '界', Style{})
+			c.Set(1, 0, '世', Style{})
+		}, " 世» "},
+		{"same place", func(c *Canvas) {
+			c.Set(1, 0, '界', Style{})
+			c.Set(1, 0, '世', Style{})
+		}, " 世» "},
+		{"zero width leaves it", func(c *Canvas) {
+			c.Set(1, 0, '界', Style{})
+			c.Set(2, 0, '\u0301', Style{})
+		}, " 界» "},
+	}
+	for _, tt := range tests {
+		c := New(4, 1)
+		tt.draw(c)
+		if got := rows(c)[0]; got != tt.want {
+			t.Errorf("%s: row is %q, want %q", tt.name, got, tt.want)
+		}
+		// Rows keep their width in cells however they are drawn
+		if w := StringWidth(c.Render()); w != 4 {
+			t.Errorf("%s: rendered %q is %d cells wide", tt.name, c.Render(), w)
+		}
+	}
+}
+
+func TestRuneWidth(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'a', 1}, {' ', 1}, {'█', 1}, {'─', 1}, {'⣿', 1},
+		{'界', 2}, {'😀', 2},
+		{'\u0301', 0}, {'\t', 0},
+	}
+	for _, tt := range tests {
+		if got := RuneWidth(tt.r); got != tt.want {
+			t.Errorf("RuneWidth(%q) = %d, want %d", tt.r, got, tt.want)
+		}
+	}
+	if got := StringWidth("a界\u0301b"); got != 4 {
+		t.Errorf("StringWidth = %d, want 4", got)
+	}
+}
@@ -0,0 +1,215 @@
+package canvas
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Resolution selects how many pixels a Pixels buffer packs into each cell.
+type Resolution int
+
+const (
+	// Normal is one pixel per cell, drawn as a full block.
+	Normal Resolution = iota
+	// HalfBlock is two pixels per cell stacked vertically, drawn with ▀ and ▄.
+	HalfBlock
+	// Braille is a 2x4 grid of dots per cell, drawn with the braille block.
+	Braille
+)
+
+var resolutionNames = []string{"Normal", "Half-block", "Braille"}
+
+// String returns a display name for the resolution.
+func (r Resolution) String() string {
+	if r < 0 || int(r) >= len(resolutionNames) {
+		return "Unknown"
+	}
+	return resolutionNames[r]
+}
+
+// MarshalText stores the resolution by name, so saved settings stay
+// readable.
+func (r Resolution) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText parses a name written by MarshalText.
+func (r *Resolution) UnmarshalText(text []byte) error {
+	for i, name := range resolutionNames {
+		if name == string(text) {
+			*r = Resolution(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown resolution %q", text)
+}
+
+// Next returns the following resolution, wrapping back to Normal.
+func (r Resolution) Next() Resolution {
+	return (r + 1) % Resolution(len(resolutionNames))
+}
+
+// Scale returns how many pixels fit across and down a single cell.
+func (r Resolution) Scale() (sx, sy int) {
+	switch r {
+	case HalfBlock:
+		return 1, 2
+	case Braille:
+		return 2, 4
+	default:
+		return 1, 1
+	}
+}
+
+// brailleBits maps a dot position within a cell to its bit in the braille
+// block, indexed by [y][x].
+var brailleBits = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// Pixels is an off-screen bitmap measured in sub-cell pixels. Each pixel is
+// either off or lit in a color; Draw packs the pixels into canvas cells.
+type Pixels struct {
+	res    Resolution
+	cols   int
+	rows   int
+	width  int
+	height int
+	pix    []lipgloss.Color // "" means off
+}
+
+// NewPixels returns a cleared bitmap covering cols x rows cells.
+func NewPixels(res Resolution, cols, rows int) *Pixels {
+	p := &Pixels{res: res}
+	p.Resize(cols, rows)
+	return p
+}
+
+// Resolution returns the current resolution.
+func (p *Pixels) Resolution() Resolution {
+	return p.res
+}
+
+// SetResolution switches resolution, keeping the cell size and clearing the
+// bitmap.
+func (p *Pixels) SetResolution(res Resolution) {
+	p.res = res
+	p.Resize(p.cols, p.rows)
+}
+
+// Resize changes the bitmap to cover cols x rows cells and clears it.
+func (p *Pixels) Resize(cols, rows int) {
+	if cols < 0 {
+		cols = 0
+	}
+	if rows < 0 {
+		rows = 0
+	}
+	sx, sy := p.res.Scale()
+	p.cols, p.rows = cols, rows
+	p.width, p.height = cols*sx, rows*sy
+	p.pix = make([]lipgloss.Color, p.width*p.height)
+}
+
+// Width returns the bitmap width in pixels.
+func (p *Pixels) Width() int {
+	return p.width
+}
+
+// Height returns the bitmap height in pixels.
+func (p *Pixels) Height() int {
+	return p.height
+}
+
+// Clear turns every pixel off.
+func (p *Pixels) Clear() {
+	for i := range p.pix {
+		p.pix[i] = ""
+	}
+}
+
+// Set lights the pixel at (x, y). Writes outside the bitmap are ignored.
+func (p *Pixels) Set(x, y int, color lipgloss.Color) {
+	if x < 0 || x >= p.width || y < 0 || y >= p.height {
+		return
+	}
+	p.pix[y*p.width+x] = color
+}
+
+func (p *Pixels) at(x, y int) lipgloss.Color {
+	return p.pix[y*p.width+x]
+}
+
+// Draw writes the bitmap into the top-left of c, one cell per group of pixels.
+// Cells with no lit pixels are left untouched.
+func (p *Pixels) Draw(c *Canvas) {
+	for cy := 0; cy < p.rows; cy++ {
+		for cx := 0; cx < p.cols; cx++ {
+			switch p.res {
+			case HalfBlock:
+				p.drawHalfBlock(c, cx, cy)
+			case Braille:
+				p.drawBraille(c, cx, cy)
+			default:
+				if color := p.at(cx, cy); color != "" {
+					c.Set(cx, cy, '█', Style{Fg: color})
+				}
+			}
+		}
+	}
+}
+
+func (p *Pixels) drawHalfBlock(c *Canvas, cx, cy int) {
+	top, bottom := p.at(cx, cy*2), p.at(cx, cy*2+1)
+	switch {
+	case top == "" && bottom == "":
+	case bottom == "":
+		c.Set(cx, cy, '▀', Style{Fg: top})
+	case top == "":
+		c.Set(cx, cy, '▄', Style{Fg: bottom})
+	case top == bottom:
+		c.Set(cx, cy, '█', Style{Fg: top})
+	default:
+		c.Set(cx, cy, '▀', Style{Fg: top, Bg: bottom})
+	}
+}
+
+func (p *Pixels) drawBraille(c *Canvas, cx, cy int) {
+	// A cell can only show one color, so use the one most dots asked for.
+	var colors [8]lipgloss.Color
+	var counts [8]int
+	n := 0
+	dots := rune(0)
+	for dy := 0; dy < 4; dy++ {
+		for dx := 0; dx < 2; dx++ {
+			color := p.at(cx*2+dx, cy*4+dy)
+			if color == "" {
+				continue
+			}
+			dots |= brailleBits[dy][dx]
+			i := 0
+			for i < n && colors[i] != color {
+				i++
+			}
+			if i == n {
+				colors[n] = color
+				n++
+			}
+			counts[i]++
+		}
+	}
+	if dots == 0 {
+		return
+	}
+	best := 0
+	for i := 1; i < n; i++ {
+		if counts[i] > counts[best] {
+			best = i
+		}
+	}
+	c.Set(cx, cy, 0x2800+dots, Style{Fg: colors[best]})
+}
@@ -0,0 +1,115 @@
+package canvas
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// Style holds the attributes of a single cell. It is a plain comparable value
+// so cells can be compared and grouped cheaply, and it doubles as the key of
+// the escape sequence cache.
+type Style struct {
+	Fg    lipgloss.Color
+	Bg    lipgloss.Color
+	Bold  bool
+	Faint bool
+}
+
+// sequence is the escape code pair that wraps text rendered in a style.
+type sequence struct {
+	prefix string
+	suffix string
+}
+
+var sequences sync.Map // Style -> sequence
+
+var (
+	colorProfile = termcolor.Detect()
+	dither       = termcolor.DitherEnabled()
+)
+
+// SetColorProfile overrides the detected terminal color support. Colors are
+// quantized to the profile when styles are rendered. Like SetDither, it is
+// meant to be called before the first frame.
+func SetColorProfile(p termcolor.Profile) {
+	colorProfile = p
+	ResetStyleCache()
+}
+
+// ColorProfile returns the color support styles are rendered for.
+func ColorProfile() termcolor.Profile {
+	return colorProfile
+}
+
+// SetDither turns ordered dithering of canvas cells on or off. It only has an
+// effect below true color, and rows already on screen keep their old colors
+// until they next change.
+func SetDither(on bool) {
+	dither = on
+}
+
+// quantize maps the style's colors to the current profile. Canvas rows pass
+// the cell position so neighbouring cells can be dithered.
+func (s Style) quantize(x, y int, dithered bool) Style {
+	s.Fg = termcolor.QuantizeColor(s.Fg, colorProfile, x, y, dithered)
+	s.Bg = termcolor.QuantizeColor(s.Bg, colorProfile, x, y, dithered)
+	return s
+}
+
+// Lipgloss returns the equivalent lipgloss style.
+func (s Style) Lipgloss() lipgloss.Style {
+	style := lipgloss.NewStyle()
+	if s.Fg != "" {
+		style = style.Foreground(s.Fg)
+	}
+	if s.Bg != "" {
+		style = style.Background(s.Bg)
+	}
+	if s.Bold {
+		style = style.Bold(true)
+	}
+	if s.Faint {
+		style = style.Faint(true)
+	}
+	return style
+}
+
+// Render applies the style to a single line of text. The escape sequences for
+// each style are worked out once through lipgloss and reused afterwards, so
+// styling a cell costs a string concatenation rather than a lipgloss render.
+func (s Style) Render(text string) string {
+	if s == (Style{}) {
+		return text
+	}
+	seq := s.sequence()
+	return seq.prefix + text + seq.suffix
+}
+
+func (s Style) sequence() sequence {
+	if v, ok := sequences.Load(s); ok {
+		return v.(sequence)
+	}
+	// Render a probe character and split the output around it. Escape codes
+	// never contain the probe, so the first match is the probe itself.
+	const probe = "x"
+	out := s.quantize(0, 0, false).Lipgloss().Render(probe)
+	seq := sequence{}
+	if i := strings.Index(out, probe); i >= 0 {
+		seq.prefix = out[:i]
+		seq.suffix = out[i+len(probe):]
+	}
+	sequences.Store(s, seq)
+	return seq
+}
+
+// ResetStyleCache forgets every cached escape sequence. Call it after changing
+// the lipgloss color profile.
+func ResetStyleCache() {
+	sequences.Range(func(key, _ any) bool {
+		sequences.Delete(key)
+		return true
+	})
+}
@@ -1,7 +1,17 @@
 package common
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
+	"github.com/charmbracelet/lipgloss"
+)
+
+// The named colors below are the Dark theme's. The theme package sets them
+// to another theme's, so read them while drawing rather than copying them
+// into package-level styles.
 var (
 	Blue    = lipgloss.Color("#3498db")
 	Green   = lipgloss.Color("#2ecc71")
@@ -11,6 +21,15 @@ var (
 	Cyan    = lipgloss.Color("#00CED1")
 	Orange  = lipgloss.Color("#FFA500")
 	Pink    = lipgloss.Color("#FF69B4")
+
+	Title     = lipgloss.Color("#FFFFFF") // text on the colored title bars
+	Strong    = lipgloss.Color("#FFFFFF") // headings
+	Text      = lipgloss.Color("252")     // body text
+	Muted     = lipgloss.Color("244")     // secondary text
+	Subtle    = lipgloss.Color("241")     // help lines and placeholders
+	Faint     = lipgloss.Color("240")     // borders and disabled items
+	Accent    = lipgloss.Color("#7D56F4") // the launcher's own color
+	Highlight = lipgloss.Color("#FFD700") // keys in help overlays
 	
 	GradientBlue = []string{
 		"#001f3f", "#003d7a", "#0059b3", "#0074d9", "#4192ff", "#7abfff", "#b3d9ff",
@@ -19,4 +38,163 @@ var (
 	GradientFire = []string{
 		"#ff0000", "#ff4500", "#ff6347", "#ff7f50", "#ffa500", "#ffb347", "#ffd700",
 	}
-)
\ No newline at end of file
+)
+
+// RGB is a color as 8-bit red, green and blue channels.
+type RGB struct {
+	R, G, B uint8
+}
+
+// HSL is a color as hue in degrees and saturation and lightness in [0, 1].
+type HSL struct {
+	H, S, L float64
+}
+
+// ParseHex reads a "#RRGGBB" or "#RGB" color. Anything else is black.
+func ParseHex(hex string) RGB {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return RGB{}
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return RGB{}
+	}
+	return RGB{uint8(v >> 16), uint8(v >> 8), uint8(v)}
+}
+
+// Hex formats the color as "#RRGGBB".
+func (c RGB) Hex() string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// Color converts to a lipgloss color.
+func (c RGB) Color() lipgloss.Color {
+	return lipgloss.Color(c.Hex())
+}
+
+// HSL converts to hue, saturation and lightness.
+func (c RGB) HSL() HSL {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	hi, lo := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l := (hi + lo) / 2
+	if hi == lo {
+		return HSL{0, 0, l}
+	}
+	d := hi - lo
+	s := d / (1 - math.Abs(2*l-1))
+	var h float64
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return HSL{h, s, l}
+}
+
+// RGB converts back to red, green and blue.
+func (c HSL) RGB() RGB {
+	h := math.Mod(c.H, 360)
+	if h < 0 {
+		h += 360
+	}
+	s, l := Clamp(c.S, 0, 1), Clamp(c.L, 0, 1)
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	m := l - chroma/2
+	return RGB{channel(r + m), channel(g + m), channel(b + m)}
+}
+
+// Hex formats the color as "#RRGGBB".
+func (c HSL) Hex() string {
+	return c.RGB().Hex()
+}
+
+// Color converts to a lipgloss color.
+func (c HSL) Color() lipgloss.Color {
+	return c.RGB().Color()
+}
+
+func channel(v float64) uint8 {
+	return uint8(math.Round(Clamp(v, 0, 1) * 255))
+}
+
+// LerpRGB blends two colors channel by channel.
+func LerpRGB(a, b RGB, t float64) RGB {
+	mix := func(x, y uint8) uint8 {
+		return uint8(math.Round(Lerp(float64(x), float64(y), Clamp(t, 0, 1))))
+	}
+	return RGB{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B)}
+}
+
+// LerpHSL blends two colors around the color wheel, taking the shorter way
+// round, which keeps saturated colors from going muddy in the middle.
+func LerpHSL(a, b RGB, t float64) RGB {
+	ha, hb := a.HSL(), b.HSL()
+	t = Clamp(t, 0, 1)
+	dh := math.Mod(hb.H-ha.H+540, 360) - 180
+	return HSL{
+		H: ha.H + dh*t,
+		S: Lerp(ha.S, hb.S, t),
+		L: Lerp(ha.L, hb.L, t),
+	}.RGB()
+}
+
+// GradientBetween returns steps hex colors running evenly from a to b
+// inclusive.
+func GradientBetween(a, b string, steps int) []string {
+	if steps <= 0 {
+		return nil
+	}
+	if steps == 1 {
+		return []string{ParseHex(a).Hex()}
+	}
+	from, to := ParseHex(a), ParseHex(b)
+	gradient := make([]string, steps)
+	for i := range gradient {
+		gradient[i] = LerpRGB(from, to, float64(i)/float64(steps-1)).Hex()
+	}
+	return gradient
+}
+
+// Sample returns the color at position t in [0, 1] along a gradient of hex
+// colors, blending between neighbouring stops instead of snapping to one.
+func Sample(gradient []string, t float64) lipgloss.Color {
+	switch len(gradient) {
+	case 0:
+		return lipgloss.Color("")
+	case 1:
+		return lipgloss.Color(gradient[0])
+	}
+	pos := Clamp(t, 0, 1) * float64(len(gradient)-1)
+	i := int(pos)
+	if i >= len(gradient)-1 {
+		return lipgloss.Color(gradient[len(gradient)-1])
+	}
+	return LerpRGB(ParseHex(gradient[i]), ParseHex(gradient[i+1]), pos-float64(i)).Color()
+}
@@ -0,0 +1,148 @@
+// Package compose stacks the layers of a scene onto one canvas. Each layer
+// is registered once with a name and a z-index; drawing runs the visible
+// layers from the lowest z up, so later layers cover earlier ones only where
+// they draw and every other cell stays transparent.
+//
+// Layers receive the scene they draw, usually the demo's model, so they see
+// its current state even though Bubble Tea models are copied on each update:
+//
+//	m.layers = compose.New[*model]()
+//	m.layers.Add("sky", 0, compose.Func[*model]((*model).renderSky))
+//	m.layers.Add("particles", 50, compose.Drawer[*model](m.particles))
+//	...
+//	scene := m.layers.Render(m.grid, &m)
+//
+// The stack is shared between copies of the model like a canvas, so
+// toggling a layer in Update is seen by View.
+package compose
+
+import (
+	"sort"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Layer draws part of a scene of type S.
+type Layer[S any] interface {
+	Draw(c *canvas.Canvas, scene S)
+}
+
+// Func adapts a function taking the scene and the canvas to Layer. That is
+// the shape of a method expression such as (*model).renderSky for
+//
+//	func (m *model) renderSky(c *canvas.Canvas)
+type Func[S any] func(scene S, c *canvas.Canvas)
+
+// Draw implements Layer.
+func (f Func[S]) Draw(c *canvas.Canvas, scene S) {
+	f(scene, c)
+}
+
+// Drawable is anything that draws itself onto a canvas without needing the
+// scene, such as a particles.System.
+type Drawable interface {
+	Draw(c *canvas.Canvas)
+}
+
+// Drawer adapts a Drawable to a layer of any scene, so shared effects can be
+// dropped into any demo's stack.
+func Drawer[S any](d Drawable) Layer[S] {
+	return Func[S](func(_ S, c *canvas.Canvas) {
+		d.Draw(c)
+	})
+}
+
+type entry[S any] struct {
+	name   string
+	z      int
+	layer  Layer[S]
+	hidden bool
+}
+
+// Stack is an ordered set of named layers.
+type Stack[S any] struct {
+	layers []*entry[S]
+}
+
+// New returns an empty stack.
+func New[S any]() *Stack[S] {
+	return &Stack[S]{}
+}
+
+// Add registers a visible layer. Layers with the same z are drawn in the
+// order they were added. Adding a name again replaces that layer but keeps
+// its visibility.
+func (s *Stack[S]) Add(name string, z int, layer Layer[S]) {
+	e := &entry[S]{name: name, z: z, layer: layer}
+	if old := s.find(name); old != nil {
+		e.hidden = old.hidden
+		s.Remove(name)
+	}
+	s.layers = append(s.layers, e)
+	sort.SliceStable(s.layers, func(i, j int) bool {
+		return s.layers[i].z < s.layers[j].z
+	})
+}
+
+// Remove drops a layer.
+func (s *Stack[S]) Remove(name string) {
+	for i, e := range s.layers {
+		if e.name == name {
+			s.layers = append(s.layers[:i], s.layers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Stack[S]) find(name string) *entry[S] {
+	for _, e := range s.layers {
+		if e.name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// Names returns the layers from bottom to top.
+func (s *Stack[S]) Names() []string {
+	names := make([]string, len(s.layers))
+	for i, e := range s.layers {
+		names[i] = e.name
+	}
+	return names
+}
+
+// Visible reports whether a layer exists and is drawn.
+func (s *Stack[S]) Visible(name string) bool {
+	e := s.find(name)
+	return e != nil && !e.hidden
+}
+
+// SetVisible shows or hides a layer.
+func (s *Stack[S]) SetVisible(name string, visible bool) {
+	if e := s.find(name); e != nil {
+		e.hidden = !visible
+	}
+}
+
+// Toggle flips a layer's visibility and returns the new state.
+func (s *Stack[S]) Toggle(name string) bool {
+	s.SetVisible(name, !s.Visible(name))
+	return s.Visible(name)
+}
+
+// Draw draws the visible layers onto c from the bottom up.
+func (s *Stack[S]) Draw(c *canvas.Canvas, scene S) {
+	for _, e := range s.layers {
+		if !e.hidden {
+			e.layer.Draw(c, scene)
+		}
+	}
+}
+
+// Render clears c, draws the stack and returns the rendered canvas.
+func (s *Stack[S]) Render(c *canvas.Canvas, scene S) string {
+	c.Clear()
+	s.Draw(c, scene)
+	return c.Render()
+}
@@ -0,0 +1,246 @@
+// Package draw rasterizes lines and shapes onto a canvas.
+//
+// Every shape has a Func variant that reports covered cells to a callback, so
+// the same rasterization works on height fields and other non-canvas grids.
+package draw
+
+import (
+	"sort"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Point is a cell position.
+type Point struct {
+	X, Y int
+}
+
+// Plot receives each cell covered by a shape. Cells may be reported more than
+// once and may lie outside the target grid.
+type Plot func(x, y int)
+
+func setter(c *canvas.Canvas, r rune, style canvas.Style) Plot {
+	return func(x, y int) {
+		c.Set(x, y, r, style)
+	}
+}
+
+// Line draws a straight line between two points, inclusive.
+func Line(c *canvas.Canvas, x0, y0, x1, y1 int, r rune, style canvas.Style) {
+	LineFunc(x0, y0, x1, y1, setter(c, r, style))
+}
+
+// LineFunc walks a straight line with Bresenham's algorithm.
+func LineFunc(x0, y0, x1, y1 int, plot Plot) {
+	dx := abs(x1 - x0)
+	dy := abs(y1 - y0)
+	sx := sign(x1 - x0)
+	sy := sign(y1 - y0)
+	err := dx - dy
+
+	x, y := x0, y0
+	for {
+		plot(x, y)
+		if x == x1 && y == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x += sx
+		}
+		if e2 < dx {
+			err += dx
+			y += sy
+		}
+	}
+}
+
+// Circle draws the outline of a circle.
+func Circle(c *canvas.Canvas, cx, cy, radius int, r rune, style canvas.Style) {
+	CircleFunc(cx, cy, radius, setter(c, r, style))
+}
+
+// CircleFunc walks a circle outline with the midpoint algorithm.
+func CircleFunc(cx, cy, radius int, plot Plot) {
+	if radius < 0 {
+		return
+	}
+	x, y := radius, 0
+	err := 1 - radius
+	for x >= y {
+		plot(cx+x, cy+y)
+		plot(cx+y, cy+x)
+		plot(cx-y, cy+x)
+		plot(cx-x, cy+y)
+		plot(cx-x, cy-y)
+		plot(cx-y, cy-x)
+		plot(cx+y, cy-x)
+		plot(cx+x, cy-y)
+		y++
+		if err < 0 {
+			err += 2*y + 1
+		} else {
+			x--
+			err += 2*(y-x) + 1
+		}
+	}
+}
+
+// FilledCircle draws a solid disc.
+func FilledCircle(c *canvas.Canvas, cx, cy, radius int, r rune, style canvas.Style) {
+	FilledCircleFunc(cx, cy, radius, setter(c, r, style))
+}
+
+// FilledCircleFunc visits every cell within radius of the centre.
+func FilledCircleFunc(cx, cy, radius int, plot Plot) {
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy <= radius*radius {
+				plot(cx+dx, cy+dy)
+			}
+		}
+	}
+}
+
+// Ellipse draws the outline of an axis-aligned ellipse. Terminal cells are
+// about twice as tall as they are wide, so rx = 2*ry looks round.
+func Ellipse(c *canvas.Canvas, cx, cy, rx, ry int, r rune, style canvas.Style) {
+	EllipseFunc(cx, cy, rx, ry, setter(c, r, style))
+}
+
+// EllipseFunc walks an ellipse outline with the midpoint algorithm.
+func EllipseFunc(cx, cy, rx, ry int, plot Plot) {
+	if rx < 0 || ry < 0 {
+		return
+	}
+	plot4 := func(x, y int) {
+		plot(cx+x, cy+y)
+		plot(cx-x, cy+y)
+		plot(cx+x, cy-y)
+		plot(cx-x, cy-y)
+	}
+
+	rx2, ry2 := rx*rx, ry*ry
+	x, y := 0, ry
+	px, py := 0, 2*rx2*y
+
+	// Region 1: slope shallower than -1
+	p := ry2 - rx2*ry + rx2/4
+	for px < py {
+		plot4(x, y)
+		x++
+		px += 2 * ry2
+		if p < 0 {
+			p += ry2 + px
+		} else {
+			y--
+			py -= 2 * rx2
+			p += ry2 + px - py
+		}
+	}
+
+	// Region 2: slope steeper than -1
+	p = ry2*(2*x+1)*(2*x+1)/4 + rx2*(y-1)*(y-1) - rx2*ry2
+	for y >= 0 {
+		plot4(x, y)
+		y--
+		py -= 2 * rx2
+		if p > 0 {
+			p += rx2 - py
+		} else {
+			x++
+			px += 2 * ry2
+			p += rx2 - py + px
+		}
+	}
+}
+
+// FilledPolygon fills the interior of a polygon using the even-odd rule.
+func FilledPolygon(c *canvas.Canvas, points []Point, r rune, style canvas.Style) {
+	FilledPolygonFunc(points, setter(c, r, style))
+}
+
+// FilledPolygonFunc scan-converts a polygon, sampling each row through the
+// middle of its cells.
+func FilledPolygonFunc(points []Point, plot Plot) {
+	if len(points) < 3 {
+		return
+	}
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points {
+		minY = min(minY, p.Y)
+		maxY = max(maxY, p.Y)
+	}
+
+	var xs []float64
+	for y := minY; y <= maxY; y++ {
+		sy := float64(y) + 0.5
+		xs = xs[:0]
+		for i, a := range points {
+			b := points[(i+1)%len(points)]
+			ay, by := float64(a.Y), float64(b.Y)
+			if (ay <= sy) == (by <= sy) {
+				continue
+			}
+			t := (sy - ay) / (by - ay)
+			xs = append(xs, float64(a.X)+t*float64(b.X-a.X))
+		}
+		sort.Float64s(xs)
+		for i := 0; i+1 < len(xs); i += 2 {
+			for x := ceil(xs[i] - 0.5); float64(x)+0.5 <= xs[i+1]; x++ {
+				plot(x, y)
+			}
+		}
+	}
+}
+
+// FloodFill replaces the 4-connected region of identical cells containing
+// (x, y) with the given rune and style.
+func FloodFill(c *canvas.Canvas, x, y int, r rune, style canvas.Style) {
+	if !c.InBounds(x, y) {
+		return
+	}
+	target := c.Get(x, y)
+	fill := canvas.Cell{Rune: r, Style: style}
+	if target == fill {
+		return
+	}
+
+	stack := []Point{{x, y}}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !c.InBounds(p.X, p.Y) || c.Get(p.X, p.Y) != target {
+			continue
+		}
+		c.Set(p.X, p.Y, r, style)
+		stack = append(stack,
+			Point{p.X + 1, p.Y}, Point{p.X - 1, p.Y},
+			Point{p.X, p.Y + 1}, Point{p.X, p.Y - 1})
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func sign(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+func ceil(f float64) int {
+	i := int(f)
+	if float64(i) < f {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"flag"
+	"fmt"
+	"runtime"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// Benchmark settings, fixed so runs can be compared with each other.
+const (
+	benchWidth  = 120
+	benchHeight = 40
+	benchSeed   = 1
+)
+
+var benchFrames = flag.Int("bench", 0, "render `N` frames off-screen at a fixed size and seed, print timings and exit")
+
+// bench simulates m for the given number of frames and prints the frame
+// rate, allocations per frame and bytes per frame the demo produced. Demos
+// without an Animator only redraw on input, so for them it times View
+// alone.
+func bench(m tea.Model, name string, frames int) (tea.Model, error) {
+	rng.Seed(benchSeed)
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	emitted := 0
+	m, err := Simulate(m, benchWidth, benchHeight, frames, func(_ int, view string) {
+		emitted += len(view)
+	})
+	if err != nil {
+		return m, fmt.Errorf("%s: %w", name, err)
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	n := float64(frames)
+	fmt.Printf("%s: %d frames at %dx%d in %v\n", name, frames, benchWidth, benchHeight, elapsed.Round(time.Millisecond))
+	fmt.Printf("  %.1f frames/s, %.2f ms/frame\n", n/elapsed.Seconds(), elapsed.Seconds()*1000/n)
+	fmt.Printf("  %.0f allocs/frame, %.1f KB allocated/frame\n",
+		float64(after.Mallocs-before.Mallocs)/n, float64(after.TotalAlloc-before.TotalAlloc)/n/1024)
+	fmt.Printf("  %.1f KB emitted/frame\n", float64(emitted)/n/1024)
+	return m, nil
+}
@@ -0,0 +1,230 @@
+// Package engine provides the frame loop shared by the animated demos.
+package engine
+
+import (
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultFPS is the frame rate the demos were originally tuned for.
+const DefaultFPS = 30
+
+// SharedFPS passed to New makes an Animator tick at the shared frame rate,
+// which users pick with --fps and change while running. Demos that scale
+// their steps by Delta use it; demos that advance a fixed step per tick pass
+// their own rate.
+const SharedFPS = 0
+
+// FrameRates are the shared frame rates stepped through at runtime.
+var FrameRates = []int{15, 30, 60, 120}
+
+var (
+	lastID    int64
+	frameRate int64 = DefaultFPS
+	maxRate   int64 // set by LimitFrameRate, 0 for no limit
+)
+
+// FrameRate returns the shared frame rate.
+func FrameRate() int {
+	return int(atomic.LoadInt64(&frameRate))
+}
+
+// SetFrameRate changes the shared frame rate and lifts any throttling.
+// Animators following it pick the new rate up from their next tick. With
+// reduced motion on, the rate is held to ReducedMotionFPS.
+func SetFrameRate(fps int) {
+	if fps > 0 {
+		fps = int(capRate(float64(fps)))
+		atomic.StoreInt64(&frameRate, int64(fps))
+		setRateLimit(0)
+	}
+}
+
+// LimitFrameRate holds every Animator to at most fps frames a second,
+// whether it follows the shared frame rate or asked for its own, or lifts the
+// limit for 0. Demos advancing a fixed step per tick slow down under it. The
+// showcase limits its live previews this way; RunNamed lifts the limit.
+func LimitFrameRate(fps int) {
+	atomic.StoreInt64(&maxRate, int64(max(fps, 0)))
+}
+
+// limitRate lowers fps to the limit set with LimitFrameRate, if any.
+func limitRate(fps float64) float64 {
+	if limit := float64(atomic.LoadInt64(&maxRate)); limit > 0 && fps > limit {
+		return limit
+	}
+	return fps
+}
+
+// StepFrameRate moves the shared frame rate to the next entry of FrameRates
+// above it, or below it when dir is negative, and returns the new rate.
+func StepFrameRate(dir int) int {
+	fps := FrameRate()
+	if dir < 0 {
+		for i := len(FrameRates) - 1; i >= 0; i-- {
+			if FrameRates[i] < fps {
+				fps = FrameRates[i]
+				break
+			}
+		}
+	} else {
+		for _, r := range FrameRates {
+			if r > fps {
+				fps = r
+				break
+			}
+		}
+	}
+	SetFrameRate(fps)
+	return FrameRate()
+}
+
+func nextID() int {
+	return int(atomic.AddInt64(&lastID, 1))
+}
+
+// TickMsg is delivered once per frame to the Animator that scheduled it.
+type TickMsg struct {
+	Time     time.Time
+	id       int
+	interval time.Duration
+	fps      float64
+	shared   bool
+}
+
+// Animator drives a demo's animation loop. It is embedded in a model by value,
+// the same way the Bubbles components are.
+type Animator struct {
+	id      int
+	fps     float64
+	speed   float64
+	paused  bool
+	frame   int
+	elapsed float64
+	delta   float64
+}
+
+// New returns an Animator ticking at the given frame rate, or at the shared
+// frame rate for SharedFPS.
+func New(fps float64) Animator {
+	if fps < 0 {
+		fps = SharedFPS
+	}
+	return Animator{
+		id:    nextID(),
+		fps:   fps,
+		speed: 1.0,
+	}
+}
+
+// Tick schedules the next frame.
+func (a Animator) Tick() tea.Cmd {
+	id, fps, interval, shared := a.id, a.FPS(), a.Interval(), a.fps == SharedFPS
+	if simulating.Load() {
+		simTicks.Add(1)
+		return func() tea.Msg {
+			return TickMsg{id: id, interval: interval, fps: fps, shared: shared}
+		}
+	}
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return TickMsg{Time: t, id: id, interval: interval, fps: fps, shared: shared}
+	})
+}
+
+// Update handles a tick. It returns the command for the next frame and whether
+// the demo should advance its simulation. Ticks scheduled by other animators
+// are ignored and yield a nil command.
+func (a *Animator) Update(msg TickMsg) (tea.Cmd, bool) {
+	if msg.id != a.id {
+		return nil, false
+	}
+	if a.paused {
+		a.delta = 0
+		return a.Tick(), false
+	}
+	// Step by the rate the tick was scheduled at, which stays right when the
+	// frame rate changes between ticks
+	a.frame++
+	a.delta = a.speed * DefaultFPS / msg.fps
+	a.elapsed += a.speed / msg.fps
+	return a.Tick(), true
+}
+
+// Interval returns the time between frames.
+func (a Animator) Interval() time.Duration {
+	return time.Duration(float64(time.Second) / a.FPS())
+}
+
+// FPS returns the target frame rate, capped while reduced motion is on and
+// by LimitFrameRate.
+func (a Animator) FPS() float64 {
+	if a.fps == SharedFPS {
+		return limitRate(float64(currentRate()))
+	}
+	return limitRate(capRate(a.fps))
+}
+
+// SetFPS changes the target frame rate, detaching the Animator from the
+// shared rate. It takes effect from the next tick.
+func (a *Animator) SetFPS(fps float64) {
+	if fps > 0 {
+		a.fps = fps
+	}
+}
+
+// Speed returns the current speed multiplier.
+func (a Animator) Speed() float64 {
+	return a.speed
+}
+
+// SetSpeed sets the speed multiplier applied to Delta and Elapsed.
+func (a *Animator) SetSpeed(speed float64) {
+	a.speed = speed
+}
+
+// Paused reports whether the animation is paused.
+func (a Animator) Paused() bool {
+	return a.paused
+}
+
+// Pause stops the simulation from advancing. Ticks keep flowing so the loop
+// can resume without being restarted.
+func (a *Animator) Pause() {
+	a.paused = true
+}
+
+// Resume continues a paused animation.
+func (a *Animator) Resume() {
+	a.paused = false
+}
+
+// Toggle flips between paused and running.
+func (a *Animator) Toggle() {
+	a.paused = !a.paused
+}
+
+// Frame returns the number of frames advanced since the last reset.
+func (a Animator) Frame() int {
+	return a.frame
+}
+
+// Elapsed returns the simulated time in seconds, scaled by speed.
+func (a Animator) Elapsed() float64 {
+	return a.elapsed
+}
+
+// Delta returns how far the last frame advanced, measured in frames at
+// DefaultFPS and normal speed. Demos multiply their per-frame steps by it so
+// they behave the same at any frame rate or speed.
+func (a Animator) Delta() float64 {
+	return a.delta
+}
+
+// Reset clears the frame counter and elapsed time.
+func (a *Animator) Reset() {
+	a.frame = 0
+	a.elapsed = 0
+	a.delta = 0
+}
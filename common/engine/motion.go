@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"flag"
+	"os"
+	"strings"
+)
+
+// ReducedMotionFPS caps every frame rate while reduced motion is on.
+const ReducedMotionFPS = 30
+
+var reducedMotion = flag.Bool("reduced-motion", false, "cap the frame rate and turn off strobing and flashing effects (also SHOWCASE_REDUCED_MOTION=1)")
+
+// ReducedMotion reports whether the user asked for less flicker, with
+// --reduced-motion or SHOWCASE_REDUCED_MOTION set to "1", "on", "true" or
+// "yes". Demos consult it to leave out strobing, flashes and scan lines and
+// to prefer smoothly blended colors over hard steps; the engine caps the
+// frame rate at ReducedMotionFPS.
+func ReducedMotion() bool {
+	if *reducedMotion {
+		return true
+	}
+	switch strings.ToLower(os.Getenv("SHOWCASE_REDUCED_MOTION")) {
+	case "1", "on", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// capRate lowers fps to ReducedMotionFPS while reduced motion is on.
+func capRate(fps float64) float64 {
+	if ReducedMotion() && fps > ReducedMotionFPS {
+		return ReducedMotionFPS
+	}
+	return fps
+}
@@ -0,0 +1,436 @@
+package engine
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/hud"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/manual"
+	"github.com/yourusername/bubbletea-showcase/common/record"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/screenshot"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+	"github.com/yourusername/bubbletea-showcase/common/transition"
+)
+
+// Keys handled by every demo started with Run.
+const (
+	// HUDKey toggles the performance overlay.
+	HUDKey = "f3"
+	// ScreenshotKey saves the current frame with the screenshot package.
+	ScreenshotKey = "f2"
+	// ManualKey opens the demo's page from the registry in a pager.
+	ManualKey = "f1"
+)
+
+// sharedKeys describes the keys above in the help overlay.
+var sharedKeys = []key.Binding{
+	manualKey,
+	keymap.New("F2", "save screenshot", ScreenshotKey),
+	keymap.New("F3", "performance overlay", HUDKey),
+}
+
+// SharedKeys returns the keys Run adds to every demo, as listed in its help
+// overlay. The frame rate keys are left out, since only some demos get them.
+func SharedKeys() []key.Binding {
+	return append([]key.Binding(nil), sharedKeys...)
+}
+
+var manualKey = keymap.New("F1", "about this demo", ManualKey)
+
+// Frame rate keys, handled for demos that follow the shared frame rate and
+// do not use the keys themselves.
+var (
+	fasterKey = keymap.New("+/-", "frame rate", "+", "=")
+	slowerKey = keymap.Hidden("-")
+)
+
+// KeyMapper is implemented by models that declare their keys with the
+// keymap package. Run opens a help overlay listing them when the map's Help
+// binding is pressed.
+type KeyMapper interface {
+	KeyMap() keymap.Map
+}
+
+// Flags shared by every demo. Run parses them if main has not already.
+var (
+	recordPath = flag.String("record", "", "record the session to a `file` ending in .cast (asciinema) or .gif")
+	startFPS   = flag.Int("fps", DefaultFPS, "animation frame `rate`, changed at runtime with +/-")
+	adaptive   = flag.Bool("throttle", true, "lower the frame rate while the terminal cannot keep up")
+	inline     = flag.Int("inline", 0, "run inline in the scrollback, `lines` tall, instead of in the alternate screen")
+)
+
+// AltScreen is the option demos pass to Run in place of tea.WithAltScreen.
+// With --inline it does nothing, and the demo is drawn below the prompt
+// instead, at the height --inline gives.
+func AltScreen() tea.ProgramOption {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	if *inline > 0 {
+		return func(*tea.Program) {}
+	}
+	return tea.WithAltScreen()
+}
+
+// noticeTime is how long messages such as "screenshot saved" stay up.
+const noticeTime = 2 * time.Second
+
+var noticeStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#000000")).
+	Background(lipgloss.Color("#00FF88")).
+	Padding(0, 1)
+
+// shell wraps a demo's model with the features every demo shares, so the
+// demos themselves do not have to handle them.
+type shell struct {
+	model tea.Model
+	name  string
+	hud   hud.HUD
+	rec   *record.Recorder
+	help  bool
+	// manual is the demo's page while it is open.
+	manual *manual.Pager
+	// rate is set once the model ticks at the shared frame rate.
+	rate     bool
+	throttle throttle
+	render   time.Duration // time the last View took
+
+	width, height int
+	// guest is set for demos run by Wrap, which may not write files.
+	guest bool
+	// fade plays the transition from the frame shown before the demo.
+	fade *transition.Player
+
+	frame    string
+	notice   string
+	noticeID int
+}
+
+type noticeDoneMsg struct{ id int }
+
+// Run starts a demo. It behaves like tea.NewProgram(m, opts...).Run(), and
+// adds the shared keys and flags: F1 opens the demo's page from the
+// registry, F2 saves a screenshot, F3 shows the frame rate overlay, "?"
+// lists the demo's keys if its model is a KeyMapper, +/- and --fps set the frame rate of demos animated at SharedFPS, which drops
+// while the terminal cannot keep up unless --throttle=false, --record
+// captures the session to a file, --reduced-motion caps the frame rate
+// and tells demos, through ReducedMotion, to drop strobing effects, and
+// --theme picks the colors of their text and chrome. With
+// --bench the demo is timed off-screen instead of run. Models
+// that implement settings.Saver have their settings saved when the program ends cleanly.
+func Run(m tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	return RunNamed(callerName(), m, opts...)
+}
+
+// RunNamed is Run for a demo started from outside its own main package, as
+// the showcase does. The name, such as "01-plasma", is used for screenshots
+// and benchmarks. It can be called again once the previous demo returns.
+func RunNamed(name string, m tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	if *startFPS <= 0 {
+		return m, fmt.Errorf("--fps must be positive, got %d", *startFPS)
+	}
+	if err := ApplyTheme(); err != nil {
+		return m, err
+	}
+	LimitFrameRate(0)
+	SetFrameRate(*startFPS)
+	if *benchFrames > 0 {
+		return bench(m, name, *benchFrames)
+	}
+
+	s := &shell{model: m, name: name, hud: hud.New(), fade: pending}
+	pending = nil
+	if *recordPath != "" {
+		rec, err := record.Create(*recordPath)
+		if err != nil {
+			return m, err
+		}
+		s.rec = rec
+	}
+
+	final, err := tea.NewProgram(s, opts...).Run()
+	if s, ok := final.(*shell); ok {
+		final = s.model
+	}
+	if saver, ok := final.(settings.Saver); ok && err == nil {
+		if serr := settings.Save(saver.Settings()); serr != nil {
+			fmt.Fprintf(os.Stderr, "Could not save settings: %v\n", serr)
+		}
+	}
+	if s.rec != nil {
+		if cerr := s.rec.Close(); cerr != nil && err == nil {
+			err = cerr
+		} else if cerr == nil {
+			fmt.Fprintf(os.Stderr, "Recording saved to %s\n", s.rec.Path())
+		}
+	}
+	return final, err
+}
+
+// pending is the transition the next RunNamed starts with.
+var pending *transition.Player
+
+// TransitionFrom makes the next RunNamed open with a transition from frame,
+// the last one on screen before it, into the demo's first frames.
+func TransitionFrom(frame string, effect transition.Effect) {
+	pending = transition.New(frame, effect)
+}
+
+// Wrap returns m inside the shell Run gives every demo, for hosts that run
+// demos in a program of their own, such as the showcase's SSH server. The
+// demo keeps the shared keys, but nothing is written to disk: F2 is ignored
+// and --record and settings.Saver are not honored.
+func Wrap(name string, m tea.Model) tea.Model {
+	return &shell{model: m, name: name, hud: hud.New(), guest: true}
+}
+
+// Unwrap returns the demo's model from a model returned by Wrap, or m itself
+// if it was not wrapped.
+func Unwrap(m tea.Model) tea.Model {
+	if s, ok := m.(*shell); ok {
+		return s.model
+	}
+	return m
+}
+
+// callerName names the demo after the directory of the main package that
+// called Run, such as "01-plasma".
+func callerName() string {
+	_, file, _, ok := runtime.Caller(2)
+	if !ok {
+		return "frame"
+	}
+	return filepath.Base(filepath.Dir(file))
+}
+
+func (s *shell) Init() tea.Cmd {
+	return tea.Batch(s.model.Init(), s.fade.Start())
+}
+
+func (s *shell) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if cmd, ok := s.fade.Update(msg); ok {
+		return s, cmd
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if s.manual != nil {
+			// The page takes every key but ctrl+c while it is open
+			switch msg.String() {
+			case "esc", "q", ManualKey:
+				s.manual = nil
+				return s, nil
+			case "ctrl+c":
+			default:
+				p, cmd := s.manual.Update(msg)
+				s.manual = &p
+				return s, cmd
+			}
+		}
+		km, hasKeys := s.model.(KeyMapper)
+		if s.help {
+			// The overlay takes every key but ctrl+c while it is open
+			if msg.String() == "esc" || (hasKeys && key.Matches(msg, km.KeyMap().Help)) {
+				s.help = false
+			}
+			if msg.String() != "ctrl+c" {
+				return s, nil
+			}
+		} else if hasKeys && key.Matches(msg, km.KeyMap().Help) {
+			s.help = true
+			return s, nil
+		} else if msg.String() == ManualKey && s.manualKeys() {
+			s.openManual()
+			return s, nil
+		}
+		if s.rateKeys() {
+			switch {
+			case key.Matches(msg, fasterKey):
+				s.throttle.reset()
+				return s, s.notify(fmt.Sprintf("%d FPS", StepFrameRate(1)))
+			case key.Matches(msg, slowerKey):
+				s.throttle.reset()
+				return s, s.notify(fmt.Sprintf("%d FPS", StepFrameRate(-1)))
+			}
+		}
+		switch msg.String() {
+		case HUDKey:
+			s.hud.Toggle()
+			return s, nil
+		case ScreenshotKey:
+			if s.guest {
+				return s, nil
+			}
+			return s, s.screenshot()
+		}
+	case tea.WindowSizeMsg:
+		if *inline > 0 {
+			// Inline, the demo gets a band of the terminal, not all of it
+			msg.Height = min(msg.Height, *inline)
+		}
+		s.width, s.height = msg.Width, msg.Height
+		if s.manual != nil {
+			s.manual.SetSize(msg.Width, msg.Height)
+		}
+		if s.rec != nil {
+			s.rec.Resize(msg.Width, msg.Height)
+		}
+	case tea.MouseMsg:
+		if s.manual != nil {
+			p, cmd := s.manual.Update(msg)
+			s.manual = &p
+			return s, cmd
+		}
+	case TickMsg:
+		s.hud.Tick(msg.Time, msg.interval)
+		if msg.shared {
+			s.rate = true
+		}
+	case noticeDoneMsg:
+		if msg.id == s.noticeID {
+			s.notice = ""
+		}
+		return s, nil
+	}
+
+	start := time.Now()
+	var cmd tea.Cmd
+	s.model, cmd = s.model.Update(msg)
+	if tick, ok := msg.(TickMsg); ok && tick.shared && *adaptive {
+		work := time.Since(start) + s.render
+		switch fps := s.throttle.observe(start.Sub(tick.Time), work, tick.interval); {
+		case fps == 0:
+		case fps < FrameRate():
+			cmd = tea.Batch(cmd, s.notify(fmt.Sprintf("Terminal is slow, dropped to %d FPS", fps)))
+		default:
+			cmd = tea.Batch(cmd, s.notify(fmt.Sprintf("Back to %d FPS", fps)))
+		}
+	}
+	return s, cmd
+}
+
+// rateKeys reports whether the frame rate keys are handled: the model must
+// follow the shared frame rate and leave "+" and "-" free.
+func (s *shell) rateKeys() bool {
+	if !s.rate {
+		return false
+	}
+	km, ok := s.model.(KeyMapper)
+	if !ok {
+		return true
+	}
+	m := km.KeyMap()
+	for _, r := range "+-" {
+		if m.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}) {
+			return false
+		}
+	}
+	return true
+}
+
+// manualKeys reports whether F1 opens the demo's page: it must have one,
+// and the model must leave F1 free, as the demos that take text, which use
+// it for their help, do not.
+func (s *shell) manualKeys() bool {
+	if d, ok := registry.Lookup(s.name); !ok || d.Doc() == "" {
+		return false
+	}
+	km, ok := s.model.(KeyMapper)
+	return !ok || !km.KeyMap().Matches(tea.KeyMsg{Type: tea.KeyF1})
+}
+
+// openManual opens the demo's page, its controls listed from the model's
+// key map.
+func (s *shell) openManual() {
+	d, _ := registry.Lookup(s.name)
+	var own []key.Binding
+	if km, ok := s.model.(KeyMapper); ok {
+		own = km.KeyMap().Listed()
+	}
+	p := manual.New(d.Title(), manual.Page(d.Doc(), own, s.shared()))
+	p.Help = "[esc] Back to the demo"
+	p.SetSize(s.width, s.height)
+	s.manual = &p
+}
+
+// shared returns the shared keys the demo has, as its help lists them.
+func (s *shell) shared() []key.Binding {
+	var shared []key.Binding
+	if s.rateKeys() {
+		shared = append(shared, fasterKey)
+	}
+	for _, b := range sharedKeys {
+		if b.Help().Key != manualKey.Help().Key || s.manualKeys() {
+			shared = append(shared, b)
+		}
+	}
+	return shared
+}
+
+func (s *shell) screenshot() tea.Cmd {
+	path, err := screenshot.Save(screenshot.Dir(), s.name, s.frame)
+	if err != nil {
+		return s.notify("Screenshot failed: " + err.Error())
+	}
+	return s.notify("Saved " + path + " and .txt")
+}
+
+// notify shows a one-line message in the corner for a couple of seconds.
+func (s *shell) notify(text string) tea.Cmd {
+	s.notice = text
+	s.noticeID++
+	id := s.noticeID
+	return tea.Tick(noticeTime, func(time.Time) tea.Msg {
+		return noticeDoneMsg{id: id}
+	})
+}
+
+func (s *shell) View() string {
+	start := time.Now()
+	var view string
+	if s.manual != nil {
+		view = s.manual.View()
+	} else {
+		view = s.model.View()
+	}
+	s.render = time.Since(start)
+	s.hud.Frame(start, s.render)
+	view = s.fade.View(view, s.width, s.height)
+	if *inline > 0 {
+		view = lipgloss.NewStyle().MaxHeight(s.height).Render(view)
+	}
+	s.frame = view
+	if s.rec != nil {
+		s.rec.Frame(view)
+	}
+
+	if km, ok := s.model.(KeyMapper); ok && s.help {
+		box := km.KeyMap().View(s.shared())
+		x := max(0, (s.width-lipgloss.Width(box))/2)
+		y := max(0, (s.height-lipgloss.Height(box))/2)
+		view = hud.Place(view, box, x, y)
+	}
+
+	var boxes []string
+	if s.notice != "" {
+		boxes = append(boxes, noticeStyle.Render(s.notice))
+	}
+	if s.hud.Visible() {
+		boxes = append(boxes, s.hud.View())
+	}
+	if len(boxes) == 0 {
+		return view
+	}
+	return hud.Overlay(view, lipgloss.JoinVertical(lipgloss.Right, boxes...), s.width)
+}
@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// simulating makes Tick deliver frames at once instead of waiting for them,
+// and simTicks counts the frames scheduled.
+var (
+	simulating atomic.Bool
+	simTicks   atomic.Int64
+)
+
+// Epoch is the time of the first simulated frame.
+var Epoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simStall is how long Simulate waits for a frame before deciding the demo
+// has stopped animating.
+const simStall = 5 * time.Second
+
+// ErrStalled is returned by Simulate when a demo stops asking for frames.
+var ErrStalled = errors.New("stopped animating")
+
+// Simulate drives m without a terminal, as fast as it handles frames. It
+// sizes m to width by height, starts it, and delivers ticks stamped with
+// the time each frame was due, counting from Epoch, so a run depends only
+// on the model and the random seed. After each frame visit is called with
+// the frame number, from 1, and the model's view. Models without an
+// Animator never tick; their view is taken each frame unchanged.
+func Simulate(m tea.Model, width, height, frames int, visit func(frame int, view string)) (tea.Model, error) {
+	simulating.Store(true)
+	defer simulating.Store(false)
+	simTicks.Store(0)
+
+	// Commands run on their own goroutines as they would under Bubble Tea;
+	// ones that wait on something other than a frame, such as music, simply
+	// never answer
+	msgs := make(chan tea.Msg, 64)
+	run := func(cmd tea.Cmd) {
+		if cmd != nil {
+			go func() { msgs <- cmd() }()
+		}
+	}
+
+	var cmd tea.Cmd
+	m, cmd = m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+	run(m.Init())
+	run(cmd)
+	animated := simTicks.Load() > 0
+
+	clock := Epoch
+	stall := time.NewTimer(simStall)
+	defer stall.Stop()
+	for done := 0; done < frames; {
+		if !animated {
+			done++
+			visit(done, m.View())
+			continue
+		}
+		var msg tea.Msg
+		select {
+		case msg = <-msgs:
+		case <-stall.C:
+			return m, fmt.Errorf("%w after %d frames", ErrStalled, done)
+		}
+		switch msg := msg.(type) {
+		case nil:
+			continue
+		case tea.QuitMsg:
+			return m, fmt.Errorf("quit after %d frames", done)
+		case tea.BatchMsg:
+			for _, c := range msg {
+				run(c)
+			}
+			continue
+		case TickMsg:
+			clock = clock.Add(msg.interval)
+			msg.Time = clock
+			m, cmd = m.Update(msg)
+			run(cmd)
+			done++
+			visit(done, m.View())
+			stall.Reset(simStall)
+			continue
+		}
+		m, cmd = m.Update(msg)
+		run(cmd)
+	}
+	return m, nil
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+	"github.com/yourusername/bubbletea-showcase/common/theme"
+)
+
+var themeName = flag.String("theme", "", "color `theme`: dark, light, high-contrast or a user theme's name (default the one last picked in the showcase)")
+
+// themeEntry is the theme kept in the settings file, under "theme".
+type themeEntry struct {
+	Name string `json:"name"`
+}
+
+// Models keep some of the colors they are built with, and main builds the
+// demo's model before Run parses the flags, so the theme is applied as the
+// program starts as well, with --theme picked out of the arguments by hand.
+func init() {
+	applyTheme(themeArg(os.Args[1:]))
+}
+
+// ApplyTheme applies the theme named by --theme, or else the one last saved
+// with SetTheme, or else leaves Dark. A saved theme that is gone, such as a
+// deleted user theme, is passed over; an unknown --theme is an error. Run
+// calls it, and the showcase before drawing its menu.
+func ApplyTheme() error {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	return applyTheme(*themeName)
+}
+
+func applyTheme(name string) error {
+	asked := name != ""
+	if !asked {
+		var saved themeEntry
+		settings.Load("theme", &saved)
+		name = saved.Name
+	}
+	if name == "" {
+		return nil
+	}
+	t, ok := theme.Lookup(name)
+	if !ok {
+		if asked {
+			return fmt.Errorf("unknown theme %q", name)
+		}
+		return nil
+	}
+	theme.Apply(t)
+	return nil
+}
+
+// themeArg returns the value given to --theme in args, or "".
+func themeArg(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name, value, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		switch {
+		case name != "theme":
+		case ok:
+			return value
+		case i+1 < len(args):
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// SetTheme applies the theme and saves it for later runs. It takes over
+// from --theme for the rest of this one.
+func SetTheme(t theme.Theme) error {
+	theme.Apply(t)
+	*themeName = ""
+	return settings.Save("theme", themeEntry{Name: t.Name})
+}
@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// rateLimit caps the shared frame rate while the terminal cannot keep up.
+// Zero means no cap.
+var rateLimit int64
+
+// currentRate returns the rate animators following the shared frame rate
+// tick at: the chosen rate, lowered while throttled.
+func currentRate() int {
+	fps := FrameRate()
+	if limit := int(atomic.LoadInt64(&rateLimit)); limit > 0 && limit < fps {
+		return limit
+	}
+	return fps
+}
+
+func setRateLimit(fps int) {
+	atomic.StoreInt64(&rateLimit, int64(fps))
+}
+
+// Throttle thresholds, as the fraction of a frame interval spent busy.
+const (
+	// overloaded drops the frame rate a step.
+	overloaded = 0.8
+	// relaxed counts towards raising it again.
+	relaxed = 0.3
+	// recoverTime is how long the load must stay relaxed before the frame
+	// rate goes back up, so it does not flap between two rates.
+	recoverTime = 3 * time.Second
+)
+
+// throttle watches how busy each frame is and lowers the shared frame rate
+// when the demo or the terminal cannot keep up. Lower rates advance the
+// animation further per tick through Delta, so the demo keeps its speed and
+// only loses smoothness while input stays responsive.
+type throttle struct {
+	load float64 // smoothed busy time as a fraction of the interval
+	calm time.Duration
+}
+
+// observe records a tick of the shared frame rate that waited lag before
+// being handled, after a frame whose update and render took work. It returns
+// the new frame rate when it changed, or 0.
+func (t *throttle) observe(lag, work, interval time.Duration) int {
+	if interval <= 0 {
+		return 0
+	}
+	busy := float64(lag+work) / float64(interval)
+	t.load = t.load*0.9 + busy*0.1
+
+	fps := currentRate()
+	switch {
+	case t.load > overloaded:
+		lower := fps
+		for i := len(FrameRates) - 1; i >= 0; i-- {
+			if FrameRates[i] < fps {
+				lower = FrameRates[i]
+				break
+			}
+		}
+		t.reset()
+		if lower == fps {
+			return 0
+		}
+		setRateLimit(lower)
+		return lower
+	case t.load < relaxed && fps < FrameRate():
+		t.calm += interval
+		if t.calm < recoverTime {
+			return 0
+		}
+		t.reset()
+		limit := 0 // back to the chosen rate
+		for _, r := range FrameRates {
+			if r > fps {
+				if r < FrameRate() {
+					limit = r
+				}
+				break
+			}
+		}
+		setRateLimit(limit)
+		return currentRate()
+	default:
+		t.calm = 0
+	}
+	return 0
+}
+
+// reset starts measuring afresh, as after the frame rate changes.
+func (t *throttle) reset() {
+	*t = throttle{}
+}
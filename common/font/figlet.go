@@ -0,0 +1,296 @@
+package font
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Horizontal layout bits of a FIGlet font. The low six select smushing
+// rules; with none of them set, smushing is universal.
+const (
+	smushEqual     = 1 << iota // equal characters merge
+	smushLowline               // "_" gives way to other strokes
+	smushHierarchy             // | / [] {} () <> outrank the classes before them
+	smushPair                  // opposite brackets become "|"
+	smushBigX                  // /\ becomes |, \/ becomes Y, >< becomes X
+	smushHardblank             // two hardblanks merge
+	layoutFitting              // letters move together until they touch
+	layoutSmushing             // letters overlap by one column
+)
+
+// deutsch are the extra characters every FIGlet font defines after ASCII.
+var deutsch = []rune{'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', 'ß'}
+
+// ParseFIGlet reads a FIGlet font in the flf2a format.
+func ParseFIGlet(name string, data []byte) (*Font, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, 1<<20)
+	if !sc.Scan() {
+		return nil, errors.New("empty font")
+	}
+	header := sc.Text()
+	if !strings.HasPrefix(header, "flf2a") || len(header) < 6 {
+		return nil, errors.New("not a FIGlet font")
+	}
+	blank, size := utf8.DecodeRuneInString(header[5:])
+	fields := strings.Fields(header[5+size:])
+	if len(fields) < 5 {
+		return nil, errors.New("short FIGlet header")
+	}
+	nums := make([]int, len(fields))
+	for i, s := range fields {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("FIGlet header: %w", err)
+		}
+		nums[i] = n
+	}
+	height, oldLayout, comments := nums[0], nums[3], nums[4]
+	if height < 1 {
+		return nil, fmt.Errorf("bad font height %d", height)
+	}
+
+	f := &Font{Name: name, Height: height, glyphs: map[rune]glyph{}}
+	switch {
+	case len(nums) > 6:
+		f.layout = nums[6] & 0xFF
+	case oldLayout < 0:
+		f.layout = 0
+	case oldLayout == 0:
+		f.layout = layoutFitting
+	default:
+		f.layout = layoutSmushing | oldLayout&0x3F
+	}
+
+	for i := 0; i < comments; i++ {
+		if !sc.Scan() {
+			return nil, errors.New("font ends in its comments")
+		}
+	}
+
+	// readGlyph reads the next height lines, stripping the end marks
+	readGlyph := func() (glyph, bool) {
+		g := glyph{rows: make([][]canvas.Cell, height)}
+		for i := 0; i < height; i++ {
+			if !sc.Scan() {
+				return g, false
+			}
+			line := strings.TrimRight(sc.Text(), " \t\r")
+			if line != "" {
+				end, _ := utf8.DecodeLastRuneInString(line)
+				line = strings.TrimRight(line, string(end))
+			}
+			for _, r := range line {
+				if r == blank {
+					r = hardblank
+				}
+				g.rows[i] = append(g.rows[i], canvas.Cell{Rune: r})
+			}
+			g.width = max(g.width, len(g.rows[i]))
+		}
+		for i, row := range g.rows {
+			for len(row) < g.width {
+				row = append(row, canvas.Cell{Rune: ' '})
+			}
+			g.rows[i] = row
+		}
+		return g, true
+	}
+
+	for r := rune(' '); r <= '~'; r++ {
+		g, ok := readGlyph()
+		if !ok {
+			return nil, fmt.Errorf("font ends at %q", r)
+		}
+		f.set(r, g)
+	}
+	for _, r := range deutsch {
+		g, ok := readGlyph()
+		if !ok {
+			return f, nil
+		}
+		f.set(r, g)
+	}
+
+	// Code-tagged characters: a line giving the code, then the glyph
+	for sc.Scan() {
+		tag := strings.Fields(sc.Text())
+		if len(tag) == 0 {
+			continue
+		}
+		code, err := strconv.ParseInt(tag[0], 0, 64)
+		g, ok := readGlyph()
+		if !ok {
+			break
+		}
+		if err == nil && code >= 0 {
+			f.set(rune(code), g)
+		}
+	}
+	return f, sc.Err()
+}
+
+// set adds a glyph. Empty glyphs are how FIGlet fonts leave a character out,
+// so they are dropped to let lowercase fall back to capitals.
+func (f *Font) set(r rune, g glyph) {
+	if g.width > 0 {
+		f.glyphs[r] = g
+	}
+}
+
+// smushAmount returns how many columns g can overlap the rows laid out so
+// far. It is the smallest gap over all rows, plus one where the touching
+// characters can merge.
+func (f *Font) smushAmount(rows [][]canvas.Cell, g glyph, prev int) int {
+	if f.layout&(layoutFitting|layoutSmushing) == 0 {
+		return 0
+	}
+	amount := g.width
+	for i, line := range rows {
+		var row []canvas.Cell
+		if i < len(g.rows) {
+			row = g.rows[i]
+		}
+
+		// Last printed character of the line so far
+		end := len(line)
+		var left rune
+		for {
+			left = runeAt(line, end)
+			if end == 0 || (left != 0 && left != ' ') {
+				break
+			}
+			end--
+		}
+
+		// First printed character of the glyph
+		start := 0
+		for runeAt(row, start) == ' ' {
+			start++
+		}
+		right := runeAt(row, start)
+
+		gap := start + len(line) - 1 - end
+		if left == 0 || left == ' ' {
+			gap++
+		} else if right != 0 && f.smushRunes(left, right, prev, g.width) != 0 {
+			gap++
+		}
+		amount = min(amount, gap)
+	}
+	return max(amount, 0)
+}
+
+func runeAt(row []canvas.Cell, i int) rune {
+	if i < 0 || i >= len(row) {
+		return 0
+	}
+	return row[i].Rune
+}
+
+// smush merges two overlapping cells, keeping the style of the one whose
+// character wins.
+func (f *Font) smush(left, right canvas.Cell, prev, width int) canvas.Cell {
+	switch merged := f.smushRunes(left.Rune, right.Rune, prev, width); merged {
+	case left.Rune:
+		return left
+	case right.Rune, 0:
+		return right
+	default:
+		return canvas.Cell{Rune: merged, Style: right.Style}
+	}
+}
+
+// hierarchy lists the classes of smushHierarchy, lowest first.
+var hierarchy = []string{"|", "/\\", "[]", "{}", "()", "<>"}
+
+// smushRunes returns the character two overlapping characters merge into,
+// or 0 if they cannot merge. prev and width are the widths of the glyphs
+// they come from; FIGlet never smushes glyphs narrower than two columns.
+func (f *Font) smushRunes(l, r rune, prev, width int) rune {
+	switch {
+	case l == ' ':
+		return r
+	case r == ' ':
+		return l
+	case prev < 2 || width < 2:
+		return 0
+	case f.layout&layoutSmushing == 0:
+		return 0
+	}
+
+	rules := f.layout & 0x3F
+	if rules == 0 {
+		// Universal smushing: the later character wins, except over a
+		// hardblank
+		if l == hardblank {
+			return r
+		}
+		if r == hardblank {
+			return l
+		}
+		return r
+	}
+
+	if l == hardblank || r == hardblank {
+		if rules&smushHardblank != 0 && l == r {
+			return l
+		}
+		return 0
+	}
+	if rules&smushEqual != 0 && l == r {
+		return l
+	}
+	if rules&smushLowline != 0 {
+		const strokes = "|/\\[]{}()<>"
+		if l == '_' && strings.ContainsRune(strokes, r) {
+			return r
+		}
+		if r == '_' && strings.ContainsRune(strokes, l) {
+			return l
+		}
+	}
+	if rules&smushHierarchy != 0 {
+		li, ri := class(l), class(r)
+		if li >= 0 && ri >= 0 && li != ri {
+			if li > ri {
+				return l
+			}
+			return r
+		}
+	}
+	if rules&smushPair != 0 {
+		switch string([]rune{l, r}) {
+		case "[]", "][", "{}", "}{", "()", ")(":
+			return '|'
+		}
+	}
+	if rules&smushBigX != 0 {
+		switch string([]rune{l, r}) {
+		case "/\\":
+			return '|'
+		case "\\/":
+			return 'Y'
+		case "><":
+			return 'X'
+		}
+	}
+	return 0
+}
+
+// class returns r's place in the smushing hierarchy, or -1.
+func class(r rune) int {
+	for i, c := range hierarchy {
+		if strings.ContainsRune(c, r) {
+			return i
+		}
+	}
+	return -1
+}
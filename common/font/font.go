@@ -0,0 +1,342 @@
+// Package font renders large text with FIGlet (.flf) and TheDraw (.tdf)
+// fonts. A few fonts are built in; any other FIGlet or TheDraw file can be
+// loaded from disk:
+//
+//	f, err := font.Load("fonts/standard.flf")
+//	title := f.Sprite("Hello", canvas.Style{Fg: common.Cyan})
+//	title.Draw(c, x, y)
+//
+// FIGlet fonts are laid out with the font's own kerning and smushing rules.
+// TheDraw color fonts keep their colors; block fonts take the style given
+// when rendering.
+package font
+
+import (
+	"archive/zip"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/sprite"
+)
+
+// hardblank stands in for FIGlet's hardblank character once a font is
+// parsed: it prints as a space but is never treated as empty when letters
+// are fitted together.
+const hardblank = '\uE000'
+
+// glyph is one character of a font, padded to a rectangle.
+type glyph struct {
+	width int
+	rows  [][]canvas.Cell
+}
+
+// Font is a parsed FIGlet or TheDraw font.
+type Font struct {
+	// Name is the font's name: the file name for FIGlet fonts and the name
+	// stored in the file for TheDraw fonts.
+	Name string
+	// Height is the number of rows every line of text takes.
+	Height int
+
+	layout  int // FIGlet layout bits, see figlet.go
+	spacing int // extra blank columns between TheDraw letters
+	glyphs  map[rune]glyph
+}
+
+// Has reports whether the font can draw r, directly or through its
+// uppercase form.
+func (f *Font) Has(r rune) bool {
+	_, ok := f.glyph(r)
+	return ok
+}
+
+// glyph returns the glyph for r. Lowercase letters fall back to uppercase,
+// since many fonts only draw capitals.
+func (f *Font) glyph(r rune) (glyph, bool) {
+	if g, ok := f.glyphs[r]; ok {
+		return g, true
+	}
+	g, ok := f.glyphs[unicode.ToUpper(r)]
+	return g, ok
+}
+
+// render lays text out into rows of cells. Characters the font lacks are
+// skipped, and each "\n" starts a new block of Height rows.
+func (f *Font) render(text string) [][]canvas.Cell {
+	var out [][]canvas.Cell
+	for _, line := range strings.Split(text, "\n") {
+		rows := make([][]canvas.Cell, f.Height)
+		prev := 0
+		for _, r := range line {
+			g, ok := f.glyph(r)
+			if !ok {
+				continue
+			}
+			f.add(rows, g, prev)
+			prev = g.width
+		}
+		out = append(out, rows...)
+	}
+
+	// Pad every row to the same width so the result is a rectangle
+	width := 0
+	for _, row := range out {
+		width = max(width, len(row))
+	}
+	for i, row := range out {
+		for len(row) < width {
+			row = append(row, canvas.Cell{Rune: ' '})
+		}
+		out[i] = row
+	}
+	return out
+}
+
+// add appends g to rows, overlapping it with the previous glyph, which was
+// prev columns wide, as far as the font's layout allows. It returns the
+// column g starts at.
+func (f *Font) add(rows [][]canvas.Cell, g glyph, prev int) int {
+	amount := f.smushAmount(rows, g, prev)
+	start := len(rows[0])
+	if prev > 0 {
+		start += f.spacing
+	}
+	start = max(start-amount, 0)
+	for i := range rows {
+		var row []canvas.Cell
+		if i < len(g.rows) {
+			row = g.rows[i]
+		}
+		if prev > 0 {
+			for k := 0; k < f.spacing; k++ {
+				rows[i] = append(rows[i], canvas.Cell{Rune: ' '})
+			}
+		}
+		for k := 0; k < amount && k < len(row); k++ {
+			col := max(len(rows[i])-amount+k, 0)
+			if col < len(rows[i]) {
+				rows[i][col] = f.smush(rows[i][col], row[k], prev, g.width)
+			}
+		}
+		if amount < len(row) {
+			rows[i] = append(rows[i], row[amount:]...)
+		}
+	}
+	return start
+}
+
+// Lines renders text as plain lines, dropping any colors.
+func (f *Font) Lines(text string) []string {
+	var lines []string
+	for _, row := range f.render(text) {
+		var b strings.Builder
+		for _, cell := range row {
+			if cell.Rune == hardblank {
+				b.WriteRune(' ')
+			} else {
+				b.WriteRune(cell.Rune)
+			}
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	return lines
+}
+
+// String renders text as plain multi-line text.
+func (f *Font) String(text string) string {
+	return strings.Join(f.Lines(text), "\n")
+}
+
+// Width returns how many columns text takes.
+func (f *Font) Width(text string) int {
+	rows := f.render(text)
+	if len(rows) == 0 {
+		return 0
+	}
+	return len(rows[0])
+}
+
+// Layout lays out one line of text as rows of cells, a hardblank as a
+// plain space, and returns with them the column each character of the text
+// starts at. A character the font lacks takes no columns, and starts where
+// the next one does.
+func (f *Font) Layout(text string) ([][]canvas.Cell, []int) {
+	rows := make([][]canvas.Cell, f.Height)
+	runes := []rune(text)
+	starts := make([]int, len(runes))
+	prev := 0
+	for i, r := range runes {
+		g, ok := f.glyph(r)
+		if !ok {
+			starts[i] = -1
+			continue
+		}
+		starts[i] = f.add(rows, g, prev)
+		prev = g.width
+	}
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	for i := range rows {
+		for len(rows[i]) < width {
+			rows[i] = append(rows[i], canvas.Cell{Rune: ' '})
+		}
+	}
+	// Skipped characters start where the one after them does
+	next := width
+	for i := len(starts) - 1; i >= 0; i-- {
+		if starts[i] < 0 {
+			starts[i] = next
+		}
+		next = starts[i]
+	}
+	for _, row := range rows {
+		for x := range row {
+			if row[x].Rune == hardblank {
+				row[x].Rune = ' '
+			}
+		}
+	}
+	return rows, starts
+}
+
+// Sprite renders text into a sprite. Blank cells are transparent, and cells
+// without their own foreground color take style.
+func (f *Font) Sprite(text string, style canvas.Style) *sprite.Sprite {
+	rows := f.render(text)
+	width := 0
+	if len(rows) > 0 {
+		width = len(rows[0])
+	}
+	s := sprite.New(width, len(rows))
+	for y, row := range rows {
+		for x, cell := range row {
+			switch {
+			case cell.Rune == ' ' && cell.Style.Bg == "":
+				continue
+			case cell.Rune == hardblank:
+				cell.Rune = ' '
+			}
+			if cell.Style.Fg == "" {
+				bg := cell.Style.Bg
+				cell.Style = style
+				if bg != "" {
+					cell.Style.Bg = bg
+				}
+			}
+			s.Set(x, y, cell)
+		}
+	}
+	return s
+}
+
+// Load reads the first font in a FIGlet or TheDraw file. FIGlet fonts may be
+// zipped, as some font collections ship them.
+func Load(path string) (*Font, error) {
+	fonts, err := LoadAll(path)
+	if err != nil {
+		return nil, err
+	}
+	return fonts[0], nil
+}
+
+// LoadAll reads every font in a file. TheDraw files often hold several.
+func LoadAll(file string) ([]*Font, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	fonts, err := Parse(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return fonts, nil
+}
+
+// Parse reads the fonts in data, telling the formats apart by their
+// signatures. name is used for fonts that do not carry one.
+func Parse(name string, data []byte) ([]*Font, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("flf2a")):
+		f, err := ParseFIGlet(name, data)
+		if err != nil {
+			return nil, err
+		}
+		return []*Font{f}, nil
+	case bytes.HasPrefix(data, []byte(theDrawMagic)):
+		return ParseTheDraw(data)
+	case bytes.HasPrefix(data, []byte("PK")):
+		return parseZip(name, data)
+	}
+	return nil, errors.New("not a FIGlet or TheDraw font")
+}
+
+// parseZip reads a FIGlet font compressed as the only file in a zip archive.
+func parseZip(name string, data []byte) ([]*Font, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if len(zr.File) == 0 {
+		return nil, errors.New("empty zip archive")
+	}
+	rc, err := zr.File[0].Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	inner, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(inner, []byte("PK")) {
+		return nil, errors.New("nested zip archive")
+	}
+	return Parse(name, inner)
+}
+
+//go:embed fonts
+var builtin embed.FS
+
+// Names lists the built-in fonts.
+func Names() []string {
+	entries, _ := builtin.ReadDir("fonts")
+	var names []string
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), path.Ext(e.Name())))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Builtin returns a built-in font by name, such as "block".
+func Builtin(name string) (*Font, error) {
+	entries, _ := builtin.ReadDir("fonts")
+	for _, e := range entries {
+		if strings.TrimSuffix(e.Name(), path.Ext(e.Name())) != name {
+			continue
+		}
+		data, err := builtin.ReadFile("fonts/" + e.Name())
+		if err != nil {
+			return nil, err
+		}
+		fonts, err := Parse(name, data)
+		if err != nil {
+			return nil, fmt.Errorf("font %s: %w", name, err)
+		}
+		return fonts[0], nil
+	}
+	return nil, fmt.Errorf("no built-in font %q", name)
+}
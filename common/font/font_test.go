@@ -0,0 +1,238 @@
+package font
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// figlet builds a two-row FIGlet font with "$" for a hardblank. Every
+// printable ASCII character is drawn as itself, except the space, which is
+// a hardblank, A, which has one after it on the top row, and B and a, which
+// are left out along with the Deutsch characters.
+func figlet(layout int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "flf2a$ 2 2 4 %d 1\nA comment\n", layout)
+	for r := ' '; r <= '~'; r++ {
+		switch r {
+		case ' ':
+			b.WriteString("$@\n$@@\n")
+		case 'A':
+			b.WriteString("A$@\nAA@@\n")
+		case 'B', 'a':
+			b.WriteString("@\n@@\n")
+		default:
+			fmt.Fprintf(&b, "%c@\n%c@@\n", r, r)
+		}
+	}
+	b.WriteString(strings.Repeat("@\n@@\n", len(deutsch)))
+	// A code-tagged snowman, with other end marks
+	b.WriteString("9731 SNOWMAN\n*#\n*##\n")
+	return b.String()
+}
+
+func TestParseFIGlet(t *testing.T) {
+	f, err := ParseFIGlet("test", []byte(figlet(-1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name != "test" || f.Height != 2 {
+		t.Errorf("font %q is %d high, want test, 2", f.Name, f.Height)
+	}
+	for _, r := range "AbC~☃" {
+		if !f.Has(r) {
+			t.Errorf("no glyph for %q", r)
+		}
+	}
+	for _, r := range "BÄ" {
+		if f.Has(r) {
+			t.Errorf("a glyph for %q, which the font leaves out", r)
+		}
+	}
+
+	tests := []struct {
+		text  string
+		lines []string
+	}{
+		{"A", []string{"A", "AA"}},
+		{"AC", []string{"A C", "AAC"}},
+		{"ABC", []string{"A C", "AAC"}},
+		{"a", []string{"A", "AA"}},
+		{"C\nD", []string{"C", "C", "D", "D"}},
+		{"☃", []string{"*", "*"}},
+	}
+	for _, tt := range tests {
+		if got := f.Lines(tt.text); !slices.Equal(got, tt.lines) {
+			t.Errorf("Lines(%q) = %q, want %q", tt.text, got, tt.lines)
+		}
+	}
+	if w := f.Width("ABC"); w != 3 {
+		t.Errorf("Width(ABC) = %d, want 3", w)
+	}
+	rows, starts := f.Layout("ABC")
+	if !slices.Equal(starts, []int{0, 2, 2}) {
+		t.Errorf("Layout(ABC) starts %v, want [0 2 2]", starts)
+	}
+	if len(rows) != 2 || rows[0][1].Rune != ' ' {
+		t.Errorf("Layout(ABC) left the hardblank in: %v", rows)
+	}
+}
+
+func TestParseFIGletSmushing(t *testing.T) {
+	// Fitting moves letters together until they touch; a hardblank keeps
+	// them apart
+	f, err := ParseFIGlet("test", []byte(figlet(0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Lines("C D"), []string{"C D", "C D"}; !slices.Equal(got, want) {
+		t.Errorf("fitted Lines(C D) = %q, want %q", got, want)
+	}
+	if got, want := f.Lines("AC"), []string{"A C", "AAC"}; !slices.Equal(got, want) {
+		t.Errorf("fitted Lines(AC) = %q, want %q", got, want)
+	}
+}
+
+func TestParseFIGletErrors(t *testing.T) {
+	tests := []struct {
+		font, want string
+	}{
+		{"", "empty font"},
+		{"flf2b$ 2 2 4 -1 0", "not a FIGlet font"},
+		{"flf2a$ 2 2", "short FIGlet header"},
+		{"flf2a$ 2 2 four -1 0", `FIGlet header: strconv.Atoi: parsing "four": invalid syntax`},
+		{"flf2a$ 0 0 4 -1 0", "bad font height 0"},
+		{"flf2a$ 1 1 4 -1 3\none comment", "font ends in its comments"},
+		{"flf2a$ 1 1 4 -1 0\n @\n!@\n", `font ends at '"'`},
+	}
+	for _, tt := range tests {
+		_, err := ParseFIGlet("test", []byte(tt.font))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("ParseFIGlet(%q) = %v, want %q", tt.font, err, tt.want)
+		}
+	}
+}
+
+// theDraw builds a TheDraw font of the given kind, named name, with glyphs
+// for the characters given.
+func theDraw(name string, kind byte, spacing byte, glyphs map[rune][]byte) []byte {
+	header := make([]byte, theDrawHeader)
+	copy(header, theDrawMarker)
+	header[4] = byte(len(name))
+	copy(header[5:17], name)
+	header[21], header[22] = kind, spacing
+	var data []byte
+	for i := 0; i < 94; i++ {
+		off := uint16(0xFFFF)
+		if g, ok := glyphs[rune('!'+i)]; ok {
+			off = uint16(len(data))
+			data = append(data, g...)
+		}
+		binary.LittleEndian.PutUint16(header[25+i*2:], off)
+	}
+	binary.LittleEndian.PutUint16(header[23:25], uint16(len(data)))
+	return append(header, data...)
+}
+
+func TestParseTheDraw(t *testing.T) {
+	block := theDraw("BLOCKY", theDrawBlock, 1, map[rune][]byte{
+		// Two by two, the top row ▄█ and the bottom ██
+		'A': {2, 2, 0xDC, 0xDB, '\r', 0xDB, 0xDB, 0},
+		// One column, of three rows
+		'!': {1, 3, 0xDB, '\r', 0xDB, '\r', '.', 0},
+	})
+	color := theDraw("COLORS", theDrawColor, 0, map[rune][]byte{
+		// Yellow on blue
+		'A': {1, 1, 'A', 0x1E, 0},
+	})
+	outline := theDraw("LINES", theDrawOutline, 0, map[rune][]byte{'A': {1, 1, 'A', 0}})
+	data := slices.Concat([]byte(theDrawMagic), outline, block, color)
+
+	fonts, err := Parse("file", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fonts) != 2 {
+		t.Fatalf("%d fonts, want the block and color ones", len(fonts))
+	}
+	f := fonts[0]
+	if f.Name != "BLOCKY" || f.Height != 3 {
+		t.Errorf("font %q is %d high, want BLOCKY, 3", f.Name, f.Height)
+	}
+	if f.Has('B') {
+		t.Error("a glyph for B, which the font leaves out")
+	}
+	want := []string{"▄█ █ ▄█", "██ █ ██", "   ."}
+	if got := f.Lines("A!A"); !slices.Equal(got, want) {
+		t.Errorf("Lines(A!A) = %q, want %q", got, want)
+	}
+	// The space is as wide as an A
+	if w := f.Width("A A"); w != 8 {
+		t.Errorf("Width(A A) = %d, want 8", w)
+	}
+
+	c := fonts[1]
+	rows, _ := c.Layout("A")
+	if cell := rows[0][0]; cell.Rune != 'A' || cell.Style.Fg != "#FFFF55" || cell.Style.Bg != "#0000AA" {
+		t.Errorf("color A is %+v, want yellow on blue", cell)
+	}
+}
+
+func TestParseTheDrawErrors(t *testing.T) {
+	block := theDraw("BLOCKY", theDrawBlock, 1, map[rune][]byte{'A': {1, 1, 0xDB, 0}})
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"no signature", []byte("TheDraw"), "not a TheDraw font"},
+		{"no fonts", []byte(theDrawMagic), "no fonts in file"},
+		{"header cut short", slices.Concat([]byte(theDrawMagic), block[:100]), "no fonts in file"},
+		{"glyphs cut short", slices.Concat([]byte(theDrawMagic), block[:len(block)-1]), `font "BLOCKY" is cut short`},
+		{"outlines", slices.Concat([]byte(theDrawMagic), theDraw("LINES", theDrawOutline, 0, nil)), "TheDraw outline fonts are not supported"},
+	}
+	for _, tt := range tests {
+		_, err := ParseTheDraw(tt.data)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%s: %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestBuiltin(t *testing.T) {
+	names := Names()
+	if !slices.Equal(names, []string{"block", "mini", "sunset"}) {
+		t.Errorf("Names() = %v", names)
+	}
+	for _, name := range names {
+		f, err := Builtin(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		for _, r := range "ABCDEFGHIJKLMNOPQRSTUVWXYZ" {
+			if !f.Has(r) {
+				t.Errorf("%s has no %q", name, r)
+			}
+		}
+		const text = "HELLO WORLD"
+		rows, starts := f.Layout(text)
+		if len(rows) != f.Height {
+			t.Errorf("%s: Layout gives %d rows, want %d", name, len(rows), f.Height)
+		}
+		if w := f.Width(text); len(rows[0]) != w || w == 0 {
+			t.Errorf("%s: Layout is %d wide and Width %d", name, len(rows[0]), w)
+		}
+		if starts[0] != 0 || !slices.IsSorted(starts) || starts[len(starts)-1] >= len(rows[0]) {
+			t.Errorf("%s: letters start at %v in %d columns", name, starts, len(rows[0]))
+		}
+		if got := len(f.Lines(text)); got != f.Height {
+			t.Errorf("%s: %d lines, want %d", name, got, f.Height)
+		}
+	}
+	if _, err := Builtin("nope"); err == nil || err.Error() != `no built-in font "nope"` {
+		t.Errorf("Builtin(nope) = %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package font
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// TheDraw font files start with this signature, then hold one or more fonts,
+// each a fixed header followed by its glyph data.
+const theDrawMagic = "\x13TheDraw FONTS file\x1a"
+
+const (
+	theDrawMarker = "\x55\xaa\x00\xff"
+	theDrawHeader = 4 + 1 + 12 + 4 + 1 + 1 + 2 + 94*2
+)
+
+// TheDraw font types.
+const (
+	theDrawOutline = iota
+	theDrawBlock
+	theDrawColor
+)
+
+// cga is the 16-color palette TheDraw's color attributes index.
+var cga = []lipgloss.Color{
+	"#000000", "#0000AA", "#00AA00", "#00AAAA", "#AA0000", "#AA00AA", "#AA5500", "#AAAAAA",
+	"#555555", "#5555FF", "#55FF55", "#55FFFF", "#FF5555", "#FF55FF", "#FFFF55", "#FFFFFF",
+}
+
+// cp437 maps code page 437 to Unicode for the bytes that differ from ASCII:
+// the symbols below space and everything from 0x7F up.
+var (
+	cp437Low  = []rune(" ☺☻♥♦♣♠•◘○◙♂♀♪♫☼►◄↕‼¶§▬↨↑↓→←∟↔▲▼")
+	cp437High = []rune("⌂ÇüéâäàåçêëèïîìÄÅÉæÆôöòûùÿÖÜ¢£¥₧ƒáíóúñÑªº¿⌐¬½¼¡«»" +
+		"░▒▓│┤╡╢╖╕╣║╗╝╜╛┐└┴┬├─┼╞╟╚╔╩╦╠═╬╧╨╤╥╙╘╒╓╫╪┘┌█▄▌▐▀" +
+		"αßΓπΣσµτΦΘΩδ∞φε∩≡±≥≤⌠⌡÷≈°∙·√ⁿ²■ ")
+)
+
+func fromCP437(b byte) rune {
+	switch {
+	case b < 0x20:
+		return cp437Low[b]
+	case b < 0x7F:
+		return rune(b)
+	default:
+		return cp437High[b-0x7F]
+	}
+}
+
+// ParseTheDraw reads the block and color fonts in a TheDraw .tdf file.
+// Outline fonts, which need TheDraw's outline styles, are skipped.
+func ParseTheDraw(data []byte) ([]*Font, error) {
+	if !strings.HasPrefix(string(data), theDrawMagic) {
+		return nil, errors.New("not a TheDraw font")
+	}
+	var fonts []*Font
+	outlines := 0
+	rest := data[len(theDrawMagic):]
+	for len(rest) >= theDrawHeader && string(rest[:4]) == theDrawMarker {
+		nameLen := min(int(rest[4]), 12)
+		name := strings.TrimRight(string(rest[5:5+nameLen]), "\x00 ")
+		kind := rest[21]
+		spacing := int(rest[22])
+		size := int(binary.LittleEndian.Uint16(rest[23:25]))
+		offsets := rest[25:theDrawHeader]
+		if len(rest) < theDrawHeader+size {
+			return nil, fmt.Errorf("font %q is cut short", name)
+		}
+		block := rest[theDrawHeader : theDrawHeader+size]
+		rest = rest[theDrawHeader+size:]
+
+		if kind == theDrawOutline {
+			outlines++
+			continue
+		}
+		f := &Font{Name: name, spacing: spacing, glyphs: map[rune]glyph{}}
+		for i := 0; i < 94; i++ {
+			off := int(binary.LittleEndian.Uint16(offsets[i*2:]))
+			if off == 0xFFFF || off >= len(block) {
+				continue
+			}
+			g := theDrawGlyph(block[off:], kind == theDrawColor)
+			f.glyphs[rune('!'+i)] = g
+			f.Height = max(f.Height, len(g.rows))
+		}
+		if len(f.glyphs) == 0 {
+			continue
+		}
+		// TheDraw fonts have no space; make one as wide as a typical letter
+		space := glyph{width: 3}
+		if g, ok := f.glyph('A'); ok {
+			space.width = g.width
+		}
+		f.glyphs[' '] = space
+		for r, g := range f.glyphs {
+			g.pad(f.Height)
+			f.glyphs[r] = g
+		}
+		fonts = append(fonts, f)
+	}
+	if len(fonts) == 0 {
+		if outlines > 0 {
+			return nil, errors.New("TheDraw outline fonts are not supported")
+		}
+		return nil, errors.New("no fonts in file")
+	}
+	return fonts, nil
+}
+
+// theDrawGlyph decodes one character: its width and height, then its cells
+// row by row, each a character followed by a color attribute in color
+// fonts. A carriage return ends a row and a zero byte ends the character.
+func theDrawGlyph(data []byte, color bool) glyph {
+	if len(data) < 2 {
+		return glyph{}
+	}
+	g := glyph{width: int(data[0])}
+	var row []canvas.Cell
+	for i := 2; i < len(data); i++ {
+		b := data[i]
+		if b == 0 {
+			break
+		}
+		if b == '\r' {
+			g.rows = append(g.rows, row)
+			row = nil
+			continue
+		}
+		cell := canvas.Cell{Rune: fromCP437(b)}
+		if color && i+1 < len(data) {
+			i++
+			attr := data[i]
+			cell.Style.Fg = cga[attr&0x0F]
+			if bg := attr >> 4 & 0x0F; bg != 0 {
+				cell.Style.Bg = cga[bg]
+			}
+		}
+		row = append(row, cell)
+	}
+	if len(row) > 0 {
+		g.rows = append(g.rows, row)
+	}
+	for _, r := range g.rows {
+		g.width = max(g.width, len(r))
+	}
+	return g
+}
+
+// pad fills g out to width by height with blank cells.
+func (g *glyph) pad(height int) {
+	for len(g.rows) < height {
+		g.rows = append(g.rows, nil)
+	}
+	for i, r := range g.rows {
+		for len(r) < g.width {
+			r = append(r, canvas.Cell{Rune: ' '})
+		}
+		g.rows[i] = r
+	}
+}
@@ -0,0 +1,110 @@
+// Package golden compares demo frames with copies kept in testdata, so a
+// change to a renderer shows up as a failing test. A demo's test simulates
+// it with a fixed size, seed and clock:
+//
+//	func TestFrames(t *testing.T) {
+//		golden.Check(t, func() tea.Model { return initialModel() }, 1, 15, 60)
+//	}
+//
+// Run the tests with -update to write the frames after an intended change.
+package golden
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+	"github.com/yourusername/bubbletea-showcase/common/theme"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden frames in testdata")
+
+// Frames are simulated at this size with this random seed.
+const (
+	Width  = 80
+	Height = 24
+	Seed   = 1
+)
+
+// Check builds a model with newModel and simulates it at the shared frame
+// rate, comparing the views of the given frames, counted from 1, with
+// testdata/<test name>.golden. The user's settings, palettes, theme and
+// terminal are kept out of it: models are built with settings off, an empty
+// config directory and the Dark theme, and render in true color.
+func Check(t *testing.T, newModel func() tea.Model, frames ...int) {
+	t.Helper()
+	config := t.TempDir()
+	t.Setenv("HOME", config)
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("SHOWCASE_SETTINGS", "off")
+	t.Setenv("SHOWCASE_AUDIO", "off")
+	t.Setenv("SHOWCASE_REDUCED_MOTION", "")
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	lipgloss.SetHasDarkBackground(true)
+	canvas.SetColorProfile(termcolor.TrueColor)
+	theme.Apply(theme.Dark)
+	engine.SetFrameRate(engine.DefaultFPS)
+	rng.Seed(Seed)
+
+	var got strings.Builder
+	_, err := engine.Simulate(newModel(), Width, Height, slices.Max(frames), func(n int, view string) {
+		if slices.Contains(frames, n) {
+			fmt.Fprintf(&got, "--- frame %d ---\n%s\n", n, view)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join("testdata", strings.ReplaceAll(t.Name(), "/", "_")+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v; run the test with -update to create it", err)
+	}
+	if diff := firstDiff(string(want), got.String()); diff != "" {
+		t.Errorf("frames differ from %s: %s\nrun the test with -update if the change is intended", path, diff)
+	}
+}
+
+// firstDiff describes the first line where got differs from want, naming
+// the frame it is in, or returns "" if they match.
+func firstDiff(want, got string) string {
+	wl, gl := strings.Split(want, "\n"), strings.Split(got, "\n")
+	frame := ""
+	for i := 0; i < max(len(wl), len(gl)); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if strings.HasPrefix(w, "--- frame ") {
+			frame = strings.Trim(w, "- ")
+		}
+		if w != g {
+			return fmt.Sprintf("%s, line %d:\n  want %q\n   got %q", frame, i+1, w, g)
+		}
+	}
+	return ""
+}
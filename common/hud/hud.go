@@ -0,0 +1,177 @@
+// Package hud is the performance overlay shown over a demo: achieved frame
+// rate, how long a frame takes to render and how many ticks were dropped.
+package hud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// window is how far back the frame rate is averaged.
+const window = time.Second
+
+// HUD collects frame timings and renders them as a small box.
+type HUD struct {
+	visible bool
+
+	frames []time.Time // when each frame in the window was rendered
+
+	render    time.Duration // smoothed View time
+	maxRender time.Duration // worst View time in the current window
+	peak      time.Duration // worst View time in the previous window
+	peakAt    time.Time
+
+	lastTick time.Time
+	interval time.Duration
+	ticks    int
+	dropped  int
+}
+
+// New returns a hidden HUD.
+func New() HUD {
+	return HUD{}
+}
+
+// Visible reports whether the HUD is shown.
+func (h HUD) Visible() bool {
+	return h.visible
+}
+
+// Toggle shows or hides the HUD.
+func (h *HUD) Toggle() {
+	h.visible = !h.visible
+}
+
+// Tick records an animation tick that fired at t for a loop running at the
+// given interval. Gaps longer than the interval count as dropped frames.
+func (h *HUD) Tick(t time.Time, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	if interval != h.interval {
+		// The frame rate changed, so the gap to the last tick means nothing
+		h.interval = interval
+		h.lastTick = time.Time{}
+	}
+	if !h.lastTick.IsZero() {
+		if gap := t.Sub(h.lastTick); gap > interval*3/2 {
+			h.dropped += int((gap+interval/2)/interval) - 1
+		}
+	}
+	h.lastTick = t
+	h.ticks++
+}
+
+// Frame records a frame rendered at t that took render to build.
+func (h *HUD) Frame(t time.Time, render time.Duration) {
+	h.frames = append(h.frames, t)
+	cut := 0
+	for cut < len(h.frames) && t.Sub(h.frames[cut]) > window {
+		cut++
+	}
+	h.frames = h.frames[cut:]
+
+	if h.render == 0 {
+		h.render = render
+	} else {
+		// Smooth over roughly the last ten frames
+		h.render += (render - h.render) / 10
+	}
+	if t.Sub(h.peakAt) > window {
+		h.peak, h.maxRender, h.peakAt = h.maxRender, 0, t
+	}
+	h.maxRender = max(h.maxRender, render)
+}
+
+// FPS returns the number of frames rendered per second, averaged over the
+// last second.
+func (h HUD) FPS() float64 {
+	if len(h.frames) < 2 {
+		return 0
+	}
+	span := h.frames[len(h.frames)-1].Sub(h.frames[0])
+	if span <= 0 {
+		return 0
+	}
+	return float64(len(h.frames)-1) / span.Seconds()
+}
+
+// Dropped returns the number of ticks that were skipped because a frame took
+// longer than the loop interval.
+func (h HUD) Dropped() int {
+	return h.dropped
+}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("#00FF88")).
+			Padding(0, 1)
+	labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	valueStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Bold(true)
+	warnStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555")).Bold(true)
+)
+
+// View renders the HUD box.
+func (h HUD) View() string {
+	row := func(label, value string, warn bool) string {
+		style := valueStyle
+		if warn {
+			style = warnStyle
+		}
+		return labelStyle.Render(fmt.Sprintf("%-7s", label)) + style.Render(value)
+	}
+
+	target := "-"
+	slow := false
+	if h.interval > 0 {
+		fps := float64(time.Second) / float64(h.interval)
+		target = fmt.Sprintf("%.0f", fps)
+		slow = h.FPS() < fps*0.9
+	}
+	peak := max(h.peak, h.maxRender)
+
+	lines := []string{
+		row("FPS", fmt.Sprintf("%.1f / %s", h.FPS(), target), slow),
+		row("Frame", fmt.Sprintf("%.2fms", ms(h.render)), h.interval > 0 && h.render > h.interval),
+		row("Peak", fmt.Sprintf("%.2fms", ms(peak)), h.interval > 0 && peak > h.interval),
+		row("Dropped", fmt.Sprintf("%d / %d", h.dropped, h.ticks+h.dropped), h.dropped > 0),
+	}
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Overlay draws box over the top-right corner of base, which is width cells
+// wide. Lines of base under the box are cut around it, keeping their styling.
+func Overlay(base, box string, width int) string {
+	return Place(base, box, max(0, width-lipgloss.Width(box)), 0)
+}
+
+// Place draws box over base with its top left corner at column x, line y.
+// Lines of base under the box are cut around it, keeping their styling.
+func Place(base, box string, x, y int) string {
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+
+	lines := strings.Split(base, "\n")
+	for len(lines) < y+len(boxLines) {
+		lines = append(lines, "")
+	}
+	for i, b := range boxLines {
+		line := lines[y+i]
+		left := ansi.Truncate(line, x, "")
+		if w := ansi.StringWidth(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := ansi.TruncateLeft(line, x+boxWidth, "")
+		lines[y+i] = left + "\x1b[0m" + b + right
+	}
+	return strings.Join(lines, "\n")
+}
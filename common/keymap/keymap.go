@@ -0,0 +1,196 @@
+// Package keymap is where demos declare the keys they respond to. A demo
+// lists its bindings once as a struct of key.Binding fields, matches them in
+// Update with key.Matches, and gets its help line and the "?" help overlay
+// generated from the same struct:
+//
+//	type keyMap struct {
+//		Palette key.Binding
+//		keymap.Common
+//	}
+//
+//	var keys = keyMap{
+//		Palette: keymap.New("1-4", "palettes", "1", "2", "3", "4"),
+//		Common:  keymap.Animated(),
+//	}
+//
+// engine.Run shows the overlay for any model with a KeyMap method.
+package keymap
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// New returns a binding for keys, shown in help as "[help] desc". With no
+// keys the help text is the key itself.
+func New(help, desc string, keys ...string) key.Binding {
+	if len(keys) == 0 {
+		keys = []string{help}
+	}
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(help, desc))
+}
+
+// Hidden returns a binding with no help entry, for keys already described by
+// a neighbouring binding, such as "down" next to New("↑↓", "speed", "up").
+func Hidden(keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...))
+}
+
+// Pause returns the usual space bar pause binding.
+func Pause() key.Binding {
+	return New("space", "pause", " ")
+}
+
+// Reset returns the usual reset binding.
+func Reset() key.Binding {
+	return New("r", "reset")
+}
+
+// Quit returns the usual quit binding.
+func Quit() key.Binding {
+	return New("q", "quit", "q", "ctrl+c")
+}
+
+// Help returns the binding that opens the help overlay.
+func Help() key.Binding {
+	return New("?", "help")
+}
+
+// Common holds the bindings most animated demos share. Embed it last in a
+// demo's key struct so they are listed at the end.
+type Common struct {
+	Pause key.Binding
+	Reset key.Binding
+	Quit  key.Binding
+	Help  key.Binding
+}
+
+// Animated returns the Common bindings.
+func Animated() Common {
+	return Common{Pause: Pause(), Reset: Reset(), Quit: Quit(), Help: Help()}
+}
+
+// Map is a demo's bindings in the order they are listed in help.
+type Map struct {
+	Bindings []key.Binding
+	// Help toggles the help overlay. It is taken from the field named Help,
+	// so demos that take text input can move it off "?".
+	Help key.Binding
+}
+
+// Of collects the key.Binding fields of a struct in declaration order,
+// descending into embedded structs.
+func Of(v any) Map {
+	var m Map
+	collect(reflect.ValueOf(v), &m)
+	return m
+}
+
+var bindingType = reflect.TypeOf(key.Binding{})
+
+func collect(v reflect.Value, m *Map) {
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		switch {
+		case f.Type == bindingType:
+			b := v.Field(i).Interface().(key.Binding)
+			m.Bindings = append(m.Bindings, b)
+			if f.Name == "Help" {
+				m.Help = b
+			}
+		case f.Anonymous && f.Type.Kind() == reflect.Struct:
+			collect(v.Field(i), m)
+		}
+	}
+}
+
+// Matches reports whether msg matches any enabled binding in the map,
+// including hidden ones.
+func (m Map) Matches(msg tea.KeyMsg) bool {
+	for _, b := range m.Bindings {
+		if key.Matches(msg, b) {
+			return true
+		}
+	}
+	return false
+}
+
+// Listed returns the enabled bindings that have help text.
+func (m Map) Listed() []key.Binding {
+	var out []key.Binding
+	for _, b := range m.Bindings {
+		if b.Enabled() && b.Help().Desc != "" {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+// String returns the one-line help shown under a demo, such as
+// "[space] pause • [r]eset • [q]uit • [?] help".
+func (m Map) String() string {
+	var parts []string
+	for _, b := range m.Listed() {
+		parts = append(parts, Short(b))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// Short formats one binding as in the help line. A single-letter key that
+// starts its description is folded into it, so "r" and "reset" give
+// "[r]eset".
+func Short(b key.Binding) string {
+	h := b.Help()
+	if utf8.RuneCountInString(h.Key) == 1 && strings.HasPrefix(h.Desc, h.Key) {
+		return "[" + h.Key + "]" + h.Desc[len(h.Key):]
+	}
+	return "[" + h.Key + "] " + h.Desc
+}
+
+// View renders the help overlay: every listed binding with its description,
+// followed by the shared bindings every demo has. It is drawn in the theme's
+// colors.
+func (m Map) View(shared []key.Binding) string {
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#00FFFF")).
+		Padding(0, 1)
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(common.Strong)
+	sectionStyle := lipgloss.NewStyle().Faint(true)
+	keyStyle := lipgloss.NewStyle().Bold(true).Foreground(common.Highlight)
+	descStyle := lipgloss.NewStyle().Foreground(common.Text)
+
+	own := m.Listed()
+	width := 0
+	for _, b := range append(own, shared...) {
+		width = max(width, lipgloss.Width(b.Help().Key))
+	}
+	row := func(b key.Binding) string {
+		h := b.Help()
+		pad := strings.Repeat(" ", width-lipgloss.Width(h.Key))
+		return keyStyle.Render(h.Key) + pad + "  " + descStyle.Render(h.Desc)
+	}
+
+	lines := []string{titleStyle.Render("Keys"), ""}
+	for _, b := range own {
+		lines = append(lines, row(b))
+	}
+	if len(shared) > 0 {
+		lines = append(lines, "", sectionStyle.Render("All demos"))
+		for _, b := range shared {
+			lines = append(lines, row(b))
+		}
+	}
+	lines = append(lines, "", sectionStyle.Render("esc or "+m.Help.Help().Key+" to close"))
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
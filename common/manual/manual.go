@@ -0,0 +1,105 @@
+// Package manual renders a demo's markdown page, what the effect is and the
+// math behind it, and shows it in a pager. The launcher opens it with F1 on
+// a demo, and engine.Run with F1 inside one.
+//
+// Each demo keeps its page in a doc.md next to its model, embedded and
+// passed to the registry:
+//
+//	//go:embed doc.md
+//	var doc string
+//
+//	registry.Register(registry.Info{Dir: "01-plasma", Manual: doc, ...})
+//
+// The controls are not written in the page but listed from the demo's key
+// map by Page, so they cannot fall out of date.
+package manual
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/ansi"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// Page returns the demo's doc with a Controls section appended, listing its
+// own keys and then the keys every demo shares.
+func Page(doc string, own, shared []key.Binding) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(doc, "\n"))
+	b.WriteString("\n\n## Controls\n\n")
+	if len(own) == 0 {
+		b.WriteString("No keys of its own.\n")
+	}
+	for _, k := range own {
+		b.WriteString(control(k))
+	}
+	if len(shared) > 0 {
+		b.WriteString("\nIn every demo:\n\n")
+		for _, k := range shared {
+			b.WriteString(control(k))
+		}
+	}
+	return b.String()
+}
+
+// control is one key of the Controls section as a list item.
+func control(k key.Binding) string {
+	h := k.Help()
+	return "- `" + h.Key + "` " + h.Desc + "\n"
+}
+
+// Render renders markdown with glamour, wrapped to width, in the colors of
+// the theme. Should glamour fail, the markdown is shown as it is.
+func Render(md string, width int) string {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStyles(style()),
+		glamour.WithWordWrap(max(width-4, 20)), // less the document's margins
+	)
+	if err != nil {
+		return md
+	}
+	out, err := r.Render(md)
+	if err != nil {
+		return md
+	}
+	return strings.Trim(out, "\n")
+}
+
+// style is glamour's dark or light style, whichever suits the theme's text,
+// with the headings, code and bold text in the theme's colors.
+func style() ansi.StyleConfig {
+	s := styles.DarkStyleConfig
+	if darkText() {
+		s = styles.LightStyleConfig
+	}
+	color := func(c lipgloss.Color) *string {
+		v := string(c)
+		return &v
+	}
+	s.Document.Color = color(common.Text)
+	s.Heading.Color = color(common.Accent)
+	s.H1.Color = color(common.Title)
+	s.H1.BackgroundColor = color(common.Accent)
+	s.Strong.Color = color(common.Strong)
+	s.Code.Color = color(common.Highlight)
+	s.Item.Color = color(common.Text)
+	return s
+}
+
+// darkText reports whether the theme's body text is dark, as a theme for
+// light terminals has it.
+func darkText() bool {
+	var c common.RGB
+	if n, err := strconv.Atoi(string(common.Text)); err == nil {
+		c = termcolor.ANSI(n)
+	} else {
+		c = common.ParseHex(string(common.Text))
+	}
+	return c.HSL().L < 0.5
+}
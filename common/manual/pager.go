@@ -0,0 +1,62 @@
+package manual
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// Pager shows a rendered page full screen, with a title bar above it and a
+// help line below, scrolled with the arrows, the page keys and the wheel.
+// Closing it is left to its host, which names its keys in Help.
+type Pager struct {
+	title string
+	page  string // markdown, rendered again when the width changes
+	view  viewport.Model
+	// Help is the host's keys, shown after the scrolling ones.
+	Help string
+}
+
+// New returns a pager on the markdown page, titled title. It is empty until
+// SetSize gives it room.
+func New(title, page string) Pager {
+	return Pager{title: title, page: page, view: viewport.New(0, 0)}
+}
+
+// SetSize fits the pager to the terminal, wrapping the page to its width.
+func (p *Pager) SetSize(width, height int) {
+	rewrap := width != p.view.Width
+	p.view.Width = width
+	p.view.Height = max(1, height-2) // less the title bar and help line
+	if rewrap {
+		p.view.SetContent(Render(p.page, width))
+	}
+}
+
+// Update scrolls the page.
+func (p Pager) Update(msg tea.Msg) (Pager, tea.Cmd) {
+	var cmd tea.Cmd
+	p.view, cmd = p.view.Update(msg)
+	return p, cmd
+}
+
+func (p Pager) View() string {
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Accent).
+		Padding(0, 1).
+		Render(p.title)
+	scrolled := lipgloss.NewStyle().
+		Foreground(common.Subtle).
+		Render(fmt.Sprintf(" %3.f%%", p.view.ScrollPercent()*100))
+	help := "[↑↓] Scroll • [PgUp/PgDn] Page"
+	if p.Help != "" {
+		help += " • " + p.Help
+	}
+	help = lipgloss.NewStyle().Foreground(common.Subtle).Render(help)
+	return title + scrolled + "\n" + p.view.View() + "\n" + help
+}
@@ -0,0 +1,199 @@
+// Package noise provides Perlin and simplex gradient noise for organic
+// textures such as clouds, terrain and flames.
+//
+// All functions return values in roughly [-1, 1] and are smooth and repeatable:
+// the same input always gives the same output for a given seed.
+package noise
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Noise holds the permutation table that seeds every noise function.
+type Noise struct {
+	perm [512]int
+}
+
+// New returns a Noise seeded with the given value.
+func New(seed int64) *Noise {
+	n := &Noise{}
+	r := rand.New(rand.NewSource(seed))
+	for i, v := range r.Perm(256) {
+		n.perm[i] = v
+		n.perm[i+256] = v
+	}
+	return n
+}
+
+var std = New(0)
+
+// Perlin1 returns 1D Perlin noise from the default generator.
+func Perlin1(x float64) float64 { return std.Perlin1(x) }
+
+// Perlin2 returns 2D Perlin noise from the default generator.
+func Perlin2(x, y float64) float64 { return std.Perlin2(x, y) }
+
+// Perlin3 returns 3D Perlin noise from the default generator.
+func Perlin3(x, y, z float64) float64 { return std.Perlin3(x, y, z) }
+
+// Simplex1 returns 1D simplex noise from the default generator.
+func Simplex1(x float64) float64 { return std.Simplex1(x) }
+
+// Simplex2 returns 2D simplex noise from the default generator.
+func Simplex2(x, y float64) float64 { return std.Simplex2(x, y) }
+
+// Simplex3 returns 3D simplex noise from the default generator.
+func Simplex3(x, y, z float64) float64 { return std.Simplex3(x, y, z) }
+
+// FBM1 sums octaves of a 1D noise function. Each octave runs at lacunarity
+// times the frequency and gain times the amplitude of the one before, and the
+// total is normalised back to the range of a single octave.
+func FBM1(f func(x float64) float64, x float64, octaves int, lacunarity, gain float64) float64 {
+	return fbm(octaves, lacunarity, gain, func(freq float64) float64 {
+		return f(x * freq)
+	})
+}
+
+// FBM2 sums octaves of a 2D noise function. See FBM1.
+func FBM2(f func(x, y float64) float64, x, y float64, octaves int, lacunarity, gain float64) float64 {
+	return fbm(octaves, lacunarity, gain, func(freq float64) float64 {
+		return f(x*freq, y*freq)
+	})
+}
+
+// FBM3 sums octaves of a 3D noise function. See FBM1.
+func FBM3(f func(x, y, z float64) float64, x, y, z float64, octaves int, lacunarity, gain float64) float64 {
+	return fbm(octaves, lacunarity, gain, func(freq float64) float64 {
+		return f(x*freq, y*freq, z*freq)
+	})
+}
+
+func fbm(octaves int, lacunarity, gain float64, sample func(freq float64) float64) float64 {
+	sum, norm := 0.0, 0.0
+	freq, amp := 1.0, 1.0
+	for i := 0; i < octaves; i++ {
+		sum += sample(freq) * amp
+		norm += amp
+		freq *= lacunarity
+		amp *= gain
+	}
+	if norm == 0 {
+		return 0
+	}
+	return sum / norm
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func floor(x float64) int {
+	return int(math.Floor(x))
+}
+
+// Perlin1 returns 1D Perlin noise.
+func (n *Noise) Perlin1(x float64) float64 {
+	xi := floor(x)
+	X := xi & 255
+	x -= float64(xi)
+	g := func(hash int, x float64) float64 {
+		if hash&1 == 0 {
+			return x
+		}
+		return -x
+	}
+	// Raw values peak at 0.5, so double them to fill [-1, 1]
+	return 2 * lerp(fade(x), g(n.perm[X], x), g(n.perm[X+1], x-1))
+}
+
+// Perlin2 returns 2D Perlin noise.
+func (n *Noise) Perlin2(x, y float64) float64 {
+	xi, yi := floor(x), floor(y)
+	X, Y := xi&255, yi&255
+	x -= float64(xi)
+	y -= float64(yi)
+	u, v := fade(x), fade(y)
+
+	p := &n.perm
+	aa := p[p[X]+Y]
+	ab := p[p[X]+Y+1]
+	ba := p[p[X+1]+Y]
+	bb := p[p[X+1]+Y+1]
+
+	return lerp(v,
+		lerp(u, grad2(aa, x, y), grad2(ba, x-1, y)),
+		lerp(u, grad2(ab, x, y-1), grad2(bb, x-1, y-1)))
+}
+
+func grad2(hash int, x, y float64) float64 {
+	switch hash & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+// Perlin3 returns 3D Perlin noise, following Ken Perlin's improved noise.
+func (n *Noise) Perlin3(x, y, z float64) float64 {
+	xi, yi, zi := floor(x), floor(y), floor(z)
+	X, Y, Z := xi&255, yi&255, zi&255
+	x -= float64(xi)
+	y -= float64(yi)
+	z -= float64(zi)
+	u, v, w := fade(x), fade(y), fade(z)
+
+	p := &n.perm
+	A := p[X] + Y
+	AA := p[A] + Z
+	AB := p[A+1] + Z
+	B := p[X+1] + Y
+	BA := p[B] + Z
+	BB := p[B+1] + Z
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad3(p[AA], x, y, z), grad3(p[BA], x-1, y, z)),
+			lerp(u, grad3(p[AB], x, y-1, z), grad3(p[BB], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad3(p[AA+1], x, y, z-1), grad3(p[BA+1], x-1, y, z-1)),
+			lerp(u, grad3(p[AB+1], x, y-1, z-1), grad3(p[BB+1], x-1, y-1, z-1))))
+}
+
+func grad3(hash int, x, y, z float64) float64 {
+	h := hash & 15
+	u := y
+	if h < 8 {
+		u = x
+	}
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,133 @@
+package noise
+
+import "math"
+
+// Gradients for 2D and 3D simplex noise: the midpoints of a cube's edges.
+var edges = [12][3]float64{
+	{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+	{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+	{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+}
+
+var (
+	f2 = 0.5 * (math.Sqrt(3) - 1)
+	g2 = (3 - math.Sqrt(3)) / 6
+)
+
+const (
+	f3 = 1.0 / 3
+	g3 = 1.0 / 6
+)
+
+// Simplex1 returns 1D simplex noise.
+func (n *Noise) Simplex1(x float64) float64 {
+	i0 := floor(x)
+	x0 := x - float64(i0)
+	x1 := x0 - 1
+
+	corner := func(hash int, x float64) float64 {
+		t := 1 - x*x
+		t *= t
+		g := 1 + float64(hash&7)
+		if hash&8 != 0 {
+			g = -g
+		}
+		return t * t * g * x
+	}
+	return 0.395 * (corner(n.perm[i0&255], x0) + corner(n.perm[(i0+1)&255], x1))
+}
+
+// Simplex2 returns 2D simplex noise.
+func (n *Noise) Simplex2(x, y float64) float64 {
+	// Skew into simplex space to find the containing cell
+	s := (x + y) * f2
+	i, j := floor(x+s), floor(y+s)
+	t := float64(i+j) * g2
+	x0 := x - (float64(i) - t)
+	y0 := y - (float64(j) - t)
+
+	// Pick the triangle the point lies in
+	i1, j1 := 0, 1
+	if x0 > y0 {
+		i1, j1 = 1, 0
+	}
+	x1 := x0 - float64(i1) + g2
+	y1 := y0 - float64(j1) + g2
+	x2 := x0 - 1 + 2*g2
+	y2 := y0 - 1 + 2*g2
+
+	p := &n.perm
+	ii, jj := i&255, j&255
+	corner := func(hash int, x, y float64) float64 {
+		t := 0.5 - x*x - y*y
+		if t < 0 {
+			return 0
+		}
+		g := edges[hash%12]
+		t *= t
+		return t * t * (g[0]*x + g[1]*y)
+	}
+	n0 := corner(p[ii+p[jj]], x0, y0)
+	n1 := corner(p[ii+i1+p[jj+j1]], x1, y1)
+	n2 := corner(p[ii+1+p[jj+1]], x2, y2)
+	return 70 * (n0 + n1 + n2)
+}
+
+// Simplex3 returns 3D simplex noise.
+func (n *Noise) Simplex3(x, y, z float64) float64 {
+	// Skew into simplex space to find the containing cell
+	s := (x + y + z) * f3
+	i, j, k := floor(x+s), floor(y+s), floor(z+s)
+	t := float64(i+j+k) * g3
+	x0 := x - (float64(i) - t)
+	y0 := y - (float64(j) - t)
+	z0 := z - (float64(k) - t)
+
+	// Pick the tetrahedron the point lies in
+	var i1, j1, k1, i2, j2, k2 int
+	if x0 >= y0 {
+		switch {
+		case y0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 1, 0
+		case x0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 0, 1
+		default:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 1, 0, 1
+		}
+	} else {
+		switch {
+		case y0 < z0:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 0, 1, 1
+		case x0 < z0:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 0, 1, 1
+		default:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 1, 1, 0
+		}
+	}
+	x1 := x0 - float64(i1) + g3
+	y1 := y0 - float64(j1) + g3
+	z1 := z0 - float64(k1) + g3
+	x2 := x0 - float64(i2) + 2*g3
+	y2 := y0 - float64(j2) + 2*g3
+	z2 := z0 - float64(k2) + 2*g3
+	x3 := x0 - 1 + 3*g3
+	y3 := y0 - 1 + 3*g3
+	z3 := z0 - 1 + 3*g3
+
+	p := &n.perm
+	ii, jj, kk := i&255, j&255, k&255
+	corner := func(hash int, x, y, z float64) float64 {
+		t := 0.6 - x*x - y*y - z*z
+		if t < 0 {
+			return 0
+		}
+		g := edges[hash%12]
+		t *= t
+		return t * t * (g[0]*x + g[1]*y + g[2]*z)
+	}
+	n0 := corner(p[ii+p[jj+p[kk]]], x0, y0, z0)
+	n1 := corner(p[ii+i1+p[jj+j1+p[kk+k1]]], x1, y1, z1)
+	n2 := corner(p[ii+i2+p[jj+j2+p[kk+k2]]], x2, y2, z2)
+	n3 := corner(p[ii+1+p[jj+1+p[kk+1]]], x3, y3, z3)
+	return 32 * (n0 + n1 + n2 + n3)
+}
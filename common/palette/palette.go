@@ -0,0 +1,175 @@
+// Package palette is the registry of color gradients that demos can cycle
+// through, made of a built-in set plus any palettes the user drops into their
+// config directory.
+//
+// User palettes are JSON files in Dir(), each holding one palette or a list:
+//
+//	{"name": "Lagoon", "colors": ["#002B36", "#268BD2", "#2AA198", "#EEE8D5"]}
+package palette
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Palette is a named gradient of hex colors, darkest or coolest first.
+type Palette struct {
+	Name   string   `json:"name"`
+	Colors []string `json:"colors"`
+}
+
+var builtin = []Palette{
+	{Name: "Sunset", Colors: []string{"#1A0533", "#5B1865", "#B42F5A", "#F26B38", "#FFC857"}},
+	{Name: "Glacier", Colors: []string{"#0B1D3A", "#1F4E79", "#3A8DDE", "#9AD1F5", "#F0FAFF"}},
+	{Name: "Toxic", Colors: []string{"#0A1A00", "#1E5200", "#4CAF00", "#A6FF00", "#F4FF9E"}},
+	{Name: "Cotton Candy", Colors: []string{"#FFB3DE", "#E3A8FF", "#B6B8FF", "#A0E7FF", "#C4FFF0"}},
+	{Name: "Lava", Colors: []string{"#120000", "#4A0000", "#A11A00", "#FF5A00", "#FFD000", "#FFFFE0"}},
+	{Name: "Deep Sea", Colors: []string{"#00040F", "#001F3F", "#00526E", "#00998C", "#7FFFD4"}},
+	{Name: "Aurora", Colors: []string{"#050A30", "#0B6E4F", "#21D375", "#B84DFF", "#FF8AD8"}},
+	{Name: "Game Boy", Colors: []string{"#0F380F", "#306230", "#8BAC0F", "#9BBC0F"}},
+}
+
+// Builtin returns the palettes that ship with the showcase.
+func Builtin() []Palette {
+	return append([]Palette(nil), builtin...)
+}
+
+// Dir returns the directory user palettes are loaded from.
+func Dir() (string, error) {
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config, "bubbletea-showcase", "palettes"), nil
+}
+
+// All returns the built-in palettes followed by the user's, sorted by file
+// name. Broken user files are skipped and reported in the error, so callers
+// can always use the returned palettes.
+func All() ([]Palette, error) {
+	palettes := Builtin()
+	dir, err := Dir()
+	if err != nil {
+		return palettes, err
+	}
+	user, err := Load(dir)
+	return append(palettes, user...), err
+}
+
+// Load reads every *.json palette file in dir. A missing directory is not an
+// error.
+func Load(dir string) ([]Palette, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var palettes []Palette
+	var errs []error
+	for _, file := range files {
+		loaded, err := loadFile(file)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		palettes = append(palettes, loaded...)
+	}
+	return palettes, errors.Join(errs...)
+}
+
+func loadFile(path string) ([]Palette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Palette
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		err = json.Unmarshal(data, &list)
+	} else {
+		var p Palette
+		err = json.Unmarshal(data, &p)
+		list = []Palette{p}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	var valid []Palette
+	var errs []error
+	for _, p := range list {
+		if err := p.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(path), err))
+			continue
+		}
+		valid = append(valid, p)
+	}
+	return valid, errors.Join(errs...)
+}
+
+// Save writes the palette to its own file in Dir(), named after it, such as
+// "my-sunset.json" for "My Sunset", and returns the file's path. It does not
+// replace a file already there: the error then matches fs.ErrExist.
+func Save(p Palette) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, FileName(p.Name))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// FileName is the name Save gives the file of a palette called name: its
+// letters and digits in lower case, with dashes between the words.
+func FileName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "palette.json"
+	}
+	return strings.Join(words, "-") + ".json"
+}
+
+var hexColor = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// Validate checks that the palette has a name and at least two hex colors.
+func (p Palette) Validate() error {
+	if p.Name == "" {
+		return errors.New("palette has no name")
+	}
+	if len(p.Colors) < 2 {
+		return fmt.Errorf("palette %q needs at least two colors", p.Name)
+	}
+	for _, c := range p.Colors {
+		if !hexColor.MatchString(c) {
+			return fmt.Errorf("palette %q: %q is not a #RRGGBB color", p.Name, c)
+		}
+	}
+	return nil
+}
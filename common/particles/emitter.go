@@ -0,0 +1,73 @@
+package particles
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// Emitter spawns particles around a point with randomised properties. Each
+// Jitter or Spread field is the full width of a uniform range centered on the
+// matching base value.
+type Emitter struct {
+	X, Y             float64 // center of the spawn area
+	SpreadX, SpreadY float64 // size of the spawn area
+
+	VX, VY             float64 // base velocity
+	JitterVX, JitterVY float64
+
+	Life       float64 // starting life; 0 means 1
+	Size       float64 // base size; 0 means 1
+	JitterSize float64
+
+	Runes  []rune           // picked at random; '•' if empty
+	Colors []lipgloss.Color // picked at random
+
+	// Rate is the number of particles emitted per frame by Update. Rates
+	// below one emit on a matching fraction of frames.
+	Rate float64
+}
+
+// Update emits Rate particles scaled by the time step dt.
+func (e *Emitter) Update(s *System, dt float64) {
+	want := e.Rate * dt
+	n := int(want)
+	if rng.Float64() < want-float64(n) {
+		n++
+	}
+	e.Emit(s, n)
+}
+
+// Emit spawns n particles, fewer if the system fills up.
+func (e *Emitter) Emit(s *System, n int) {
+	for i := 0; i < n; i++ {
+		p := s.Spawn()
+		if p == nil {
+			return
+		}
+		p.X = e.X + jitter(e.SpreadX)
+		p.Y = e.Y + jitter(e.SpreadY)
+		p.VX = e.VX + jitter(e.JitterVX)
+		p.VY = e.VY + jitter(e.JitterVY)
+		if e.Life > 0 {
+			p.Life = e.Life
+		}
+		if e.Size > 0 {
+			p.Size = e.Size + jitter(e.JitterSize)
+		}
+		p.Rune = '•'
+		if len(e.Runes) > 0 {
+			p.Rune = e.Runes[rng.Intn(len(e.Runes))]
+		}
+		if len(e.Colors) > 0 {
+			p.Color = e.Colors[rng.Intn(len(e.Colors))]
+		}
+	}
+}
+
+// jitter returns a uniform random value in [-width/2, width/2).
+func jitter(width float64) float64 {
+	if width == 0 {
+		return 0
+	}
+	return (rng.Float64() - 0.5) * width
+}
@@ -0,0 +1,173 @@
+// Package particles is a small particle engine: a pooled set of particles,
+// emitters that spawn them and forces that push them around.
+//
+// A demo keeps one System, calls Update once per frame with the animator's
+// Delta, then removes whatever it no longer wants with Retain:
+//
+//	sys.Update(dt)
+//	sys.Retain(func(p *particles.Particle) bool { return p.Y < height })
+package particles
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Particle is one point in the system. Demos are free to read and change
+// any field between updates.
+type Particle struct {
+	X, Y   float64
+	VX, VY float64
+	Life   float64 // counts down by the system's Decay; dead at zero
+	Size   float64
+	Rune   rune
+	Color  lipgloss.Color
+}
+
+// Force changes a particle's velocity over a time step of dt frames.
+type Force interface {
+	Apply(p *Particle, dt float64)
+}
+
+// System owns the particles. Storage is allocated once up to Max, so
+// spawning and removing particles does not allocate.
+type System struct {
+	// Forces are applied to every particle in order each update.
+	Forces []Force
+	// Decay is the life each particle loses per frame.
+	Decay float64
+
+	max       int
+	particles []Particle
+}
+
+// New returns a system that holds at most capacity particles.
+func New(capacity int) *System {
+	return &System{max: capacity, particles: make([]Particle, 0, capacity)}
+}
+
+// Max returns the capacity of the system.
+func (s *System) Max() int {
+	return s.max
+}
+
+// Len returns the number of live particles.
+func (s *System) Len() int {
+	return len(s.particles)
+}
+
+// Particles returns the live particles, oldest first. The slice is only
+// valid until the next Spawn, Update or Retain.
+func (s *System) Particles() []Particle {
+	return s.particles
+}
+
+// Spawn adds a particle and returns it for the caller to fill in, or nil if
+// the system is full.
+func (s *System) Spawn() *Particle {
+	if len(s.particles) >= s.max {
+		return nil
+	}
+	s.particles = append(s.particles, Particle{Life: 1, Size: 1})
+	return &s.particles[len(s.particles)-1]
+}
+
+// Update applies the forces, moves every particle by its velocity and ages
+// it, dropping those whose life has run out.
+func (s *System) Update(dt float64) {
+	for i := range s.particles {
+		p := &s.particles[i]
+		for _, f := range s.Forces {
+			f.Apply(p, dt)
+		}
+		p.X += p.VX * dt
+		p.Y += p.VY * dt
+		p.Life -= s.Decay * dt
+	}
+	s.Retain(func(p *Particle) bool { return p.Life > 0 })
+}
+
+// Retain keeps only the particles for which keep returns true, preserving
+// their order.
+func (s *System) Retain(keep func(p *Particle) bool) {
+	n := 0
+	for i := range s.particles {
+		if keep(&s.particles[i]) {
+			s.particles[n] = s.particles[i]
+			n++
+		}
+	}
+	s.particles = s.particles[:n]
+}
+
+// Clear removes every particle.
+func (s *System) Clear() {
+	s.particles = s.particles[:0]
+}
+
+// Draw plots each particle as its rune in its color, faint in the second
+// half of its life. It makes a System a layer for the compose package.
+func (s *System) Draw(c *canvas.Canvas) {
+	for _, p := range s.particles {
+		c.Set(int(p.X), int(p.Y), p.Rune, canvas.Style{Fg: p.Color, Faint: p.Life < 0.5})
+	}
+}
+
+// Gravity accelerates particles by a constant amount per frame. A negative Y
+// pulls upwards.
+type Gravity struct {
+	X, Y float64
+}
+
+// Apply implements Force.
+func (g *Gravity) Apply(p *Particle, dt float64) {
+	p.VX += g.X * dt
+	p.VY += g.Y * dt
+}
+
+// Wind pushes particles sideways. It is a horizontal Gravity kept separate
+// so demos can steer it on its own.
+type Wind struct {
+	Strength float64
+}
+
+// Apply implements Force.
+func (w *Wind) Apply(p *Particle, dt float64) {
+	p.VX += w.Strength * dt
+}
+
+// Drag slows particles down. Factor is the fraction of velocity kept each
+// frame, so 1 means no drag.
+type Drag struct {
+	Factor float64
+}
+
+// Apply implements Force.
+func (d *Drag) Apply(p *Particle, dt float64) {
+	k := math.Pow(d.Factor, dt)
+	p.VX *= k
+	p.VY *= k
+}
+
+// Attractor pulls particles towards a point, or pushes them away with a
+// negative Strength. The pull fades with distance and stops beyond Radius
+// when Radius is set.
+type Attractor struct {
+	X, Y     float64
+	Strength float64
+	Radius   float64
+}
+
+// Apply implements Force.
+func (a *Attractor) Apply(p *Particle, dt float64) {
+	dx, dy := a.X-p.X, a.Y-p.Y
+	dist := math.Hypot(dx, dy)
+	if dist < 0.5 || (a.Radius > 0 && dist > a.Radius) {
+		return
+	}
+	pull := a.Strength / dist * dt
+	p.VX += dx / dist * pull
+	p.VY += dy / dist * pull
+}
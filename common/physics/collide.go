@@ -0,0 +1,89 @@
+package physics
+
+import "math"
+
+// AABB is an axis-aligned box from Min to Max inclusive.
+type AABB struct {
+	Min, Max Vec
+}
+
+// Box returns the box with corners x0, y0 and x1, y1.
+func Box(x0, y0, x1, y1 int) AABB {
+	return AABB{Vec{float64(x0), float64(y0)}, Vec{float64(x1), float64(y1)}}
+}
+
+// Contains reports whether p lies inside the box.
+func (a AABB) Contains(p Vec) bool {
+	return p.X >= a.Min.X && p.X <= a.Max.X && p.Y >= a.Min.Y && p.Y <= a.Max.Y
+}
+
+// Overlaps reports whether the two boxes intersect.
+func (a AABB) Overlaps(o AABB) bool {
+	return a.Min.X <= o.Max.X && o.Min.X <= a.Max.X &&
+		a.Min.Y <= o.Max.Y && o.Min.Y <= a.Max.Y
+}
+
+// Inset returns the box shrunk by d on every side, for example by a body's
+// radius to get the range its center may move in.
+func (a AABB) Inset(d float64) AABB {
+	return AABB{Vec{a.Min.X + d, a.Min.Y + d}, Vec{a.Max.X - d, a.Max.Y - d}}
+}
+
+// Clamp returns the point of the box closest to p.
+func (a AABB) Clamp(p Vec) Vec {
+	return Vec{
+		math.Max(a.Min.X, math.Min(a.Max.X, p.X)),
+		math.Max(a.Min.Y, math.Min(a.Max.Y, p.Y)),
+	}
+}
+
+// Circle is a disc around Center.
+type Circle struct {
+	Center Vec
+	Radius float64
+}
+
+// Overlaps reports whether the two circles intersect.
+func (c Circle) Overlaps(o Circle) bool {
+	r := c.Radius + o.Radius
+	d := c.Center.Sub(o.Center)
+	return d.Dot(d) < r*r
+}
+
+// OverlapsBox reports whether the circle intersects the box.
+func (c Circle) OverlapsBox(a AABB) bool {
+	d := c.Center.Sub(a.Clamp(c.Center))
+	return d.Dot(d) < c.Radius*c.Radius
+}
+
+// Collide resolves a collision between two circular bodies. Overlapping
+// bodies are pushed apart and, if they are moving towards each other, trade
+// momentum along the line between their centers. restitution is the share of
+// the approach speed kept after the hit: 1 is perfectly elastic, 0 makes the
+// bodies stick together. It reports whether the bodies were touching.
+func Collide(a, b *Body, restitution float64) bool {
+	d := b.Pos.Sub(a.Pos)
+	dist := d.Len()
+	r := a.Radius + b.Radius
+	if dist >= r {
+		return false
+	}
+
+	n := Vec{X: 1}
+	if dist > 0 {
+		n = d.Scale(1 / dist)
+	}
+	ia, ib := 1/a.mass(), 1/b.mass()
+
+	// Separate in proportion to inverse mass so light bodies move further
+	push := n.Scale((r - dist) / (ia + ib))
+	a.Pos = a.Pos.Sub(push.Scale(ia))
+	b.Pos = b.Pos.Add(push.Scale(ib))
+
+	if approach := b.Vel.Sub(a.Vel).Dot(n); approach < 0 {
+		j := -(1 + restitution) * approach / (ia + ib)
+		a.Vel = a.Vel.Sub(n.Scale(j * ia))
+		b.Vel = b.Vel.Add(n.Scale(j * ib))
+	}
+	return true
+}
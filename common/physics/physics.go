@@ -0,0 +1,98 @@
+// Package physics holds the small amount of rigid-body math the physics
+// demos share: a 2D vector, integrators, collision tests and wall
+// constraints.
+//
+// Units are the demos' own: positions in cells, velocities in cells per
+// frame and time steps in frames, as returned by engine.Animator.Delta. A
+// typical update integrates every body, resolves collisions between them and
+// then keeps them inside the screen:
+//
+//	physics.Euler(&b, physics.Vec{Y: gravity}, dt)
+//	physics.Bounce(&b, physics.Box(0, 0, width-1, height-1), 0.9)
+package physics
+
+import "math"
+
+// Vec is a 2D vector.
+type Vec struct {
+	X, Y float64
+}
+
+// Add returns v + o.
+func (v Vec) Add(o Vec) Vec {
+	return Vec{v.X + o.X, v.Y + o.Y}
+}
+
+// Sub returns v - o.
+func (v Vec) Sub(o Vec) Vec {
+	return Vec{v.X - o.X, v.Y - o.Y}
+}
+
+// Scale returns v multiplied by k.
+func (v Vec) Scale(k float64) Vec {
+	return Vec{v.X * k, v.Y * k}
+}
+
+// Dot returns the dot product of v and o.
+func (v Vec) Dot(o Vec) float64 {
+	return v.X*o.X + v.Y*o.Y
+}
+
+// Len returns the length of v.
+func (v Vec) Len() float64 {
+	return math.Hypot(v.X, v.Y)
+}
+
+// Normalize returns v scaled to length 1, or the zero vector if v is zero.
+func (v Vec) Normalize() Vec {
+	l := v.Len()
+	if l == 0 {
+		return Vec{}
+	}
+	return v.Scale(1 / l)
+}
+
+// Limit returns v shortened to at most max, keeping its direction.
+func (v Vec) Limit(max float64) Vec {
+	if l := v.Len(); l > max {
+		return v.Scale(max / l)
+	}
+	return v
+}
+
+// Body is a moving circle. A zero Radius makes it a point.
+type Body struct {
+	Pos    Vec
+	Vel    Vec
+	Radius float64
+	Mass   float64 // 0 means 1
+}
+
+// mass returns the body's mass with the zero value treated as 1.
+func (b *Body) mass() float64 {
+	if b.Mass <= 0 {
+		return 1
+	}
+	return b.Mass
+}
+
+// Circle returns the area the body covers.
+func (b *Body) Circle() Circle {
+	return Circle{Center: b.Pos, Radius: b.Radius}
+}
+
+// Euler advances b by dt under a constant acceleration using semi-implicit
+// Euler: the velocity is updated first and the new velocity moves the body.
+// It is cheap and stable enough for bouncing things.
+func Euler(b *Body, accel Vec, dt float64) {
+	b.Vel = b.Vel.Add(accel.Scale(dt))
+	b.Pos = b.Pos.Add(b.Vel.Scale(dt))
+}
+
+// Verlet advances b by dt using velocity Verlet, which is exact for a
+// constant acceleration and keeps orbits and springs from gaining energy.
+// accel is the acceleration at the start of the step.
+func Verlet(b *Body, accel Vec, dt float64) {
+	b.Pos = b.Pos.Add(b.Vel.Scale(dt)).Add(accel.Scale(0.5 * dt * dt))
+	b.Vel = b.Vel.Add(accel.Scale(dt))
+}
@@ -0,0 +1,57 @@
+package physics
+
+// Side is a set of walls, as reported by Bounce.
+type Side uint8
+
+// The walls of a box, with Top at the smallest Y.
+const (
+	Left Side = 1 << iota
+	Right
+	Top
+	Bottom
+)
+
+// Reflect keeps one coordinate between lo and hi. A coordinate that reaches
+// either end is clamped to it and, if still heading outwards, has its
+// velocity reversed and scaled by restitution. It returns -1 for a hit at
+// lo, 1 for a hit at hi and 0 otherwise.
+//
+// Reflect works on bare coordinates so it can be used on particles and other
+// types that are not Bodies.
+func Reflect(pos, vel *float64, lo, hi, restitution float64) int {
+	switch {
+	case *pos <= lo:
+		*pos = lo
+		if *vel < 0 {
+			*vel = -*vel * restitution
+		}
+		return -1
+	case *pos >= hi:
+		*pos = hi
+		if *vel > 0 {
+			*vel = -*vel * restitution
+		}
+		return 1
+	}
+	return 0
+}
+
+// Bounce keeps b's circle inside box, reflecting it off the walls it hits
+// with the given restitution, and returns the walls that were hit.
+func Bounce(b *Body, box AABB, restitution float64) Side {
+	box = box.Inset(b.Radius)
+	var hit Side
+	switch Reflect(&b.Pos.X, &b.Vel.X, box.Min.X, box.Max.X, restitution) {
+	case -1:
+		hit |= Left
+	case 1:
+		hit |= Right
+	}
+	switch Reflect(&b.Pos.Y, &b.Vel.Y, box.Min.Y, box.Max.Y, restitution) {
+	case -1:
+		hit |= Top
+	case 1:
+		hit |= Bottom
+	}
+	return hit
+}
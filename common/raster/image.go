@@ -0,0 +1,285 @@
+package raster
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Size of one cell in pixels, matching the bundled 7x13 bitmap font.
+const (
+	CellWidth  = 7
+	CellHeight = 13
+)
+
+var face = basicfont.Face7x13
+
+// Image renders frame on a cols by rows screen. See Parse.
+func Image(frame string, cols, rows int) *image.RGBA {
+	return Parse(frame, cols, rows).Image()
+}
+
+// Image draws the screen, one CellWidth by CellHeight block per cell.
+func (s *Screen) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, s.Cols*CellWidth, s.Rows*CellHeight))
+	for y := 0; y < s.Rows; y++ {
+		for x := 0; x < s.Cols; x++ {
+			cell := s.At(x, y)
+			rect := image.Rect(x*CellWidth, y*CellHeight, (x+1)*CellWidth, (y+1)*CellHeight)
+			draw.Draw(img, rect, image.NewUniform(cell.Bg), image.Point{}, draw.Src)
+			drawGlyph(img, rect, cell)
+		}
+	}
+	return img
+}
+
+func drawGlyph(img *image.RGBA, rect image.Rectangle, cell Cell) {
+	r := cell.Rune
+	switch {
+	case r == 0 || r == ' ':
+		return
+	case r >= 0x2580 && r <= 0x259F:
+		drawBlock(img, rect, r, cell.Fg)
+		return
+	case r >= 0x2800 && r <= 0x28FF:
+		drawBraille(img, rect, r, cell.Fg)
+		return
+	}
+	if lines, ok := boxLines[r]; ok {
+		drawBox(img, rect, lines, cell.Fg)
+		return
+	}
+	if shape, ok := shapes[r]; ok {
+		shape(img, rect, cell.Fg)
+		return
+	}
+	if alt, ok := fallback[r]; ok {
+		r = alt
+	}
+
+	d := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(cell.Fg),
+		Face: face,
+		Dot:  fixed.P(rect.Min.X, rect.Min.Y+face.Ascent),
+	}
+	d.DrawString(string(r))
+	if cell.Bold {
+		// Overstrike one pixel to the right, like old terminals did
+		d.Dot = fixed.P(rect.Min.X+1, rect.Min.Y+face.Ascent)
+		d.DrawString(string(r))
+	}
+}
+
+// fill paints the part of rect between the fractions x0..x1 and y0..y1 of
+// its width and height.
+func fill(img *image.RGBA, rect image.Rectangle, x0, y0, x1, y1 float64, c color.RGBA) {
+	w, h := float64(rect.Dx()), float64(rect.Dy())
+	part := image.Rect(
+		rect.Min.X+int(x0*w+0.5), rect.Min.Y+int(y0*h+0.5),
+		rect.Min.X+int(x1*w+0.5), rect.Min.Y+int(y1*h+0.5),
+	)
+	draw.Draw(img, part, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// drawBlock handles the Block Elements range: eighths, halves, shades and
+// quadrants.
+func drawBlock(img *image.RGBA, rect image.Rectangle, r rune, c color.RGBA) {
+	switch {
+	case r == '▀':
+		fill(img, rect, 0, 0, 1, 0.5, c)
+	case r >= '▁' && r <= '█':
+		n := float64(r-'▁'+1) / 8
+		fill(img, rect, 0, 1-n, 1, 1, c)
+	case r >= '▉' && r <= '▏':
+		n := float64('▏'-r+1) / 8
+		fill(img, rect, 0, 0, n, 1, c)
+	case r == '▐':
+		fill(img, rect, 0.5, 0, 1, 1, c)
+	case r >= '░' && r <= '▓':
+		shade(img, rect, int(r-'░')+1, c)
+	case r == '▔':
+		fill(img, rect, 0, 0, 1, 1.0/8, c)
+	case r == '▕':
+		fill(img, rect, 7.0/8, 0, 1, 1, c)
+	default:
+		// Quadrants, as bits: upper left, upper right, lower left, lower right
+		q := quadrants[r-'▖']
+		if q&1 != 0 {
+			fill(img, rect, 0, 0, 0.5, 0.5, c)
+		}
+		if q&2 != 0 {
+			fill(img, rect, 0.5, 0, 1, 0.5, c)
+		}
+		if q&4 != 0 {
+			fill(img, rect, 0, 0.5, 0.5, 1, c)
+		}
+		if q&8 != 0 {
+			fill(img, rect, 0.5, 0.5, 1, 1, c)
+		}
+	}
+}
+
+// quadrants covers ▖ through ▟.
+var quadrants = [10]uint8{4, 8, 1, 1 | 4 | 8, 1 | 8, 1 | 2 | 4, 1 | 2 | 8, 2, 2 | 4, 2 | 4 | 8}
+
+// shade draws ░ ▒ ▓ as a pixel pattern covering level quarters of the cell.
+func shade(img *image.RGBA, rect image.Rectangle, level int, c color.RGBA) {
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			var on bool
+			switch level {
+			case 1:
+				on = x%2 == 0 && y%2 == 0
+			case 2:
+				on = (x+y)%2 == 0
+			default:
+				on = x%2 == 0 || y%2 == 0
+			}
+			if on {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+}
+
+// drawBraille draws the 2x4 dot pattern of a braille character.
+func drawBraille(img *image.RGBA, rect image.Rectangle, r rune, c color.RGBA) {
+	bits := int(r - 0x2800)
+	// Dot numbering: 1-3 and 7 down the left column, 4-6 and 8 down the right
+	dots := [8][2]int{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {0, 3}, {1, 3}}
+	for i, d := range dots {
+		if bits&(1<<i) == 0 {
+			continue
+		}
+		x := rect.Min.X + 1 + d[0]*3
+		y := rect.Min.Y + 1 + d[1]*3
+		draw.Draw(img, image.Rect(x, y, x+2, y+2), image.NewUniform(c), image.Point{}, draw.Src)
+	}
+}
+
+// line weights for box drawing
+const (
+	light = iota + 1
+	heavy
+	double
+)
+
+// box lists the weight of the arms of a box drawing character.
+type box struct {
+	up, down, left, right int
+}
+
+var boxLines = map[rune]box{
+	'─': {0, 0, light, light}, '━': {0, 0, heavy, heavy}, '═': {0, 0, double, double},
+	'│': {light, light, 0, 0}, '┃': {heavy, heavy, 0, 0}, '║': {double, double, 0, 0},
+	'┄': {0, 0, light, light}, '┈': {0, 0, light, light}, '┆': {light, light, 0, 0}, '┊': {light, light, 0, 0},
+	'┌': {0, light, 0, light}, '┐': {0, light, light, 0}, '└': {light, 0, 0, light}, '┘': {light, 0, light, 0},
+	'╭': {0, light, 0, light}, '╮': {0, light, light, 0}, '╰': {light, 0, 0, light}, '╯': {light, 0, light, 0},
+	'┏': {0, heavy, 0, heavy}, '┓': {0, heavy, heavy, 0}, '┗': {heavy, 0, 0, heavy}, '┛': {heavy, 0, heavy, 0},
+	'╔': {0, double, 0, double}, '╗': {0, double, double, 0}, '╚': {double, 0, 0, double}, '╝': {double, 0, double, 0},
+	'├': {light, light, 0, light}, '┤': {light, light, light, 0}, '┬': {0, light, light, light}, '┴': {light, 0, light, light},
+	'┣': {heavy, heavy, 0, heavy}, '┫': {heavy, heavy, heavy, 0}, '┳': {0, heavy, heavy, heavy}, '┻': {heavy, 0, heavy, heavy},
+	'╠': {double, double, 0, double}, '╣': {double, double, double, 0}, '╦': {0, double, double, double}, '╩': {double, 0, double, double},
+	'┼': {light, light, light, light}, '╋': {heavy, heavy, heavy, heavy}, '╬': {double, double, double, double},
+	'╴': {0, 0, light, 0}, '╵': {light, 0, 0, 0}, '╶': {0, 0, 0, light}, '╷': {0, light, 0, 0},
+}
+
+// drawBox draws the arms of a box drawing character from the cell center.
+func drawBox(img *image.RGBA, rect image.Rectangle, b box, c color.RGBA) {
+	cx := rect.Min.X + rect.Dx()/2
+	cy := rect.Min.Y + rect.Dy()/2
+	u := image.NewUniform(c)
+	// Each arm is drawn as one or two strokes offset across its direction
+	offsets := func(weight int) []int {
+		switch weight {
+		case heavy:
+			return []int{-1, 0, 1}
+		case double:
+			return []int{-1, 1}
+		case light:
+			return []int{0}
+		}
+		return nil
+	}
+	for _, o := range offsets(b.up) {
+		draw.Draw(img, image.Rect(cx+o, rect.Min.Y, cx+o+1, cy+1), u, image.Point{}, draw.Src)
+	}
+	for _, o := range offsets(b.down) {
+		draw.Draw(img, image.Rect(cx+o, cy, cx+o+1, rect.Max.Y), u, image.Point{}, draw.Src)
+	}
+	for _, o := range offsets(b.left) {
+		draw.Draw(img, image.Rect(rect.Min.X, cy+o, cx+1, cy+o+1), u, image.Point{}, draw.Src)
+	}
+	for _, o := range offsets(b.right) {
+		draw.Draw(img, image.Rect(cx, cy+o, rect.Max.X, cy+o+1), u, image.Point{}, draw.Src)
+	}
+}
+
+// shapes draws the geometric symbols the demos use for dots and particles.
+var shapes = map[rune]func(*image.RGBA, image.Rectangle, color.RGBA){
+	'·': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 1, false, c) },
+	'∙': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 1, false, c) },
+	'•': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 2, false, c) },
+	'●': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 3, false, c) },
+	'○': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 3, true, c) },
+	'◯': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { disc(img, r, 3, true, c) },
+	'■': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { fill(img, r, 0.1, 0.25, 0.9, 0.75, c) },
+	'▪': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { fill(img, r, 0.25, 0.35, 0.75, 0.65, c) },
+	'◆': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { diamond(img, r, false, c) },
+	'◇': func(img *image.RGBA, r image.Rectangle, c color.RGBA) { diamond(img, r, true, c) },
+}
+
+// disc draws a filled or outlined circle of the given radius at the center of
+// the cell.
+func disc(img *image.RGBA, rect image.Rectangle, radius int, ring bool, c color.RGBA) {
+	cx := rect.Min.X + rect.Dx()/2
+	cy := rect.Min.Y + rect.Dy()/2
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			d := dx*dx + dy*dy
+			if d > radius*radius+radius/2 {
+				continue
+			}
+			if ring && d < (radius-1)*(radius-1)+1 {
+				continue
+			}
+			img.SetRGBA(cx+dx, cy+dy, c)
+		}
+	}
+}
+
+func diamond(img *image.RGBA, rect image.Rectangle, outline bool, c color.RGBA) {
+	cx := rect.Min.X + rect.Dx()/2
+	cy := rect.Min.Y + rect.Dy()/2
+	const size = 3
+	for dy := -size; dy <= size; dy++ {
+		for dx := -size; dx <= size; dx++ {
+			d := abs(dx) + abs(dy)
+			if d > size || (outline && d < size) {
+				continue
+			}
+			img.SetRGBA(cx+dx, cy+dy, c)
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// fallback maps characters the bitmap font lacks to an ASCII look-alike.
+var fallback = map[rune]rune{
+	'★': '*', '☆': '*', '✦': '*', '✧': '*', '✶': '*', '✨': '*', '※': '*',
+	'…': '.', '–': '-', '—': '-', '‘': '\'', '’': '\'', '“': '"', '”': '"',
+	'←': '<', '→': '>', '↑': '^', '↓': 'v', '×': 'x', '÷': '/', '°': 'o',
+	'▲': '^', '▼': 'v', '◀': '<', '▶': '>', '►': '>', '◄': '<',
+	'╱': '/', '╲': '\\', '╳': 'X',
+}
@@ -0,0 +1,210 @@
+// Package raster turns rendered terminal frames back into pixels, so frames
+// can be saved as images or animated GIFs.
+package raster
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// Default colors for text with no color set, as a dark terminal theme shows it.
+var (
+	DefaultFg = color.RGBA{R: 0xD0, G: 0xD0, B: 0xD0, A: 0xFF}
+	DefaultBg = color.RGBA{R: 0x0C, G: 0x0C, B: 0x10, A: 0xFF}
+)
+
+// Cell is one character position of a parsed frame.
+type Cell struct {
+	Rune rune // 0 for the right half of a wide character
+	Fg   color.RGBA
+	Bg   color.RGBA
+	Bold bool
+}
+
+// Screen is a parsed frame of Cols by Rows cells.
+type Screen struct {
+	Cols, Rows int
+	Cells      []Cell
+}
+
+// At returns the cell at column x, row y.
+func (s *Screen) At(x, y int) Cell {
+	return s.Cells[y*s.Cols+x]
+}
+
+// pen is the SGR state while parsing.
+type pen struct {
+	fg, bg  color.RGBA
+	bold    bool
+	faint   bool
+	reverse bool
+}
+
+func (p pen) cell(r rune) Cell {
+	fg, bg := p.fg, p.bg
+	if p.reverse {
+		fg, bg = bg, fg
+	}
+	if p.faint {
+		fg = color.RGBA{
+			R: uint8((int(fg.R) + int(bg.R)) / 2),
+			G: uint8((int(fg.G) + int(bg.G)) / 2),
+			B: uint8((int(fg.B) + int(bg.B)) / 2),
+			A: 0xFF,
+		}
+	}
+	return Cell{Rune: r, Fg: fg, Bg: bg, Bold: p.bold}
+}
+
+// Parse lays frame out on a cols by rows screen, the way a terminal would
+// after clearing and printing it at the top left. It understands the SGR
+// color and attribute codes that lipgloss emits and skips other escapes.
+// Text past the edges is dropped.
+func Parse(frame string, cols, rows int) *Screen {
+	reset := pen{fg: DefaultFg, bg: DefaultBg}
+	s := &Screen{Cols: cols, Rows: rows, Cells: make([]Cell, cols*rows)}
+	for i := range s.Cells {
+		s.Cells[i] = reset.cell(' ')
+	}
+
+	p := reset
+	x, y := 0, 0
+	for i := 0; i < len(frame); {
+		switch c := frame[i]; {
+		case c == 0x1b:
+			n, params, final := escape(frame[i:])
+			if final == 'm' {
+				p = sgr(p, reset, params)
+			}
+			i += n
+			continue
+		case c == '\n':
+			x, y = 0, y+1
+			i++
+			continue
+		case c == '\r':
+			x = 0
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(frame[i:])
+		i += size
+		w := 1
+		if r >= 0x1100 {
+			w = ansi.StringWidth(string(r))
+		}
+		if w == 0 || y >= rows {
+			continue
+		}
+		if x+w <= cols {
+			s.Cells[y*cols+x] = p.cell(r)
+			if w == 2 {
+				s.Cells[y*cols+x+1] = p.cell(0)
+			}
+		}
+		x += w
+	}
+	return s
+}
+
+// escape measures the escape sequence at the start of s. For CSI sequences it
+// also returns the parameter string and final byte.
+func escape(s string) (n int, params string, final byte) {
+	if len(s) < 2 {
+		return len(s), "", 0
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1, s[2:i], s[i]
+			}
+		}
+		return len(s), "", 0
+	case ']', 'P', '_':
+		// String sequences run to BEL or ST
+		for i := 2; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return i + 1, "", 0
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2, "", 0
+			}
+		}
+		return len(s), "", 0
+	default:
+		return 2, "", 0
+	}
+}
+
+// sgr applies a Select Graphic Rendition parameter list to the pen.
+func sgr(p, reset pen, params string) pen {
+	if params == "" {
+		return reset
+	}
+	codes := strings.FieldsFunc(params, func(r rune) bool { return r == ';' || r == ':' })
+	num := func(i int) int {
+		if i >= len(codes) {
+			return 0
+		}
+		v, _ := strconv.Atoi(codes[i])
+		return v
+	}
+	for i := 0; i < len(codes); i++ {
+		switch c := num(i); {
+		case c == 0:
+			p = reset
+		case c == 1:
+			p.bold = true
+		case c == 2:
+			p.faint = true
+		case c == 22:
+			p.bold, p.faint = false, false
+		case c == 7:
+			p.reverse = true
+		case c == 27:
+			p.reverse = false
+		case c >= 30 && c <= 37:
+			p.fg = indexed(c - 30)
+		case c >= 90 && c <= 97:
+			p.fg = indexed(c - 90 + 8)
+		case c == 39:
+			p.fg = reset.fg
+		case c >= 40 && c <= 47:
+			p.bg = indexed(c - 40)
+		case c >= 100 && c <= 107:
+			p.bg = indexed(c - 100 + 8)
+		case c == 49:
+			p.bg = reset.bg
+		case c == 38 || c == 48:
+			var col color.RGBA
+			switch num(i + 1) {
+			case 5:
+				col = indexed(num(i + 2))
+				i += 2
+			case 2:
+				col = color.RGBA{R: uint8(num(i + 2)), G: uint8(num(i + 3)), B: uint8(num(i + 4)), A: 0xFF}
+				i += 4
+			default:
+				continue
+			}
+			if c == 38 {
+				p.fg = col
+			} else {
+				p.bg = col
+			}
+		}
+	}
+	return p
+}
+
+func indexed(i int) color.RGBA {
+	c := termcolor.ANSI(i)
+	return color.RGBA{R: c.R, G: c.G, B: c.B, A: 0xFF}
+}
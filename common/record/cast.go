@@ -0,0 +1,92 @@
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// castEncoder streams an asciinema v2 file: a JSON header line followed by
+// one [time, type, data] event per line.
+type castEncoder struct {
+	f          *os.File
+	w          *bufio.Writer
+	cols, rows int
+	started    bool
+	last       time.Duration
+}
+
+func newCast(path string) (*castEncoder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &castEncoder{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+func (c *castEncoder) frame(t time.Duration, frame string, cols, rows int) error {
+	if !c.started {
+		header := map[string]any{
+			"version":   2,
+			"width":     cols,
+			"height":    rows,
+			"timestamp": time.Now().Unix(),
+			"env":       map[string]string{"TERM": os.Getenv("TERM")},
+		}
+		if err := c.line(header); err != nil {
+			return err
+		}
+		// Hide the cursor for the whole recording
+		if err := c.event(0, "o", "\x1b[?25l"); err != nil {
+			return err
+		}
+		c.started = true
+		c.cols, c.rows = cols, rows
+	} else if cols != c.cols || rows != c.rows {
+		c.cols, c.rows = cols, rows
+		if err := c.event(t, "r", fmt.Sprintf("%dx%d", cols, rows)); err != nil {
+			return err
+		}
+	}
+
+	// Redraw from the top left, clearing what each line does not cover
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, line := range strings.Split(frame, "\n") {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString("\x1b[0m\x1b[K")
+	}
+	b.WriteString("\x1b[J")
+	return c.event(t, "o", b.String())
+}
+
+func (c *castEncoder) event(t time.Duration, kind, data string) error {
+	c.last = t
+	return c.line([]any{t.Seconds(), kind, data})
+}
+
+func (c *castEncoder) line(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.w.Write(data)
+	return c.w.WriteByte('\n')
+}
+
+func (c *castEncoder) close() error {
+	if c.started {
+		c.event(c.last, "o", "\x1b[?25h")
+	}
+	err := c.w.Flush()
+	if cerr := c.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,110 @@
+package record
+
+import (
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"os"
+	"time"
+
+	"github.com/yourusername/bubbletea-showcase/common/raster"
+)
+
+// minDelay is the shortest frame GIF viewers reliably honor. Frames arriving
+// faster than this replace the previous one.
+const minDelay = 20 * time.Millisecond
+
+type gifFrame struct {
+	t     time.Duration
+	frame string
+}
+
+// gifEncoder keeps the frames as text and rasterizes them on close, so
+// recording does not slow the demo down. The image size is fixed by the
+// first frame.
+type gifEncoder struct {
+	f          *os.File
+	cols, rows int
+	frames     []gifFrame
+}
+
+func newGIF(path string) (*gifEncoder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &gifEncoder{f: f}, nil
+}
+
+func (g *gifEncoder) frame(t time.Duration, frame string, cols, rows int) error {
+	if len(g.frames) == 0 {
+		g.cols, g.rows = cols, rows
+	}
+	if n := len(g.frames); n > 0 && t-g.frames[n-1].t < minDelay {
+		g.frames[n-1].frame = frame
+		return nil
+	}
+	g.frames = append(g.frames, gifFrame{t: t, frame: frame})
+	return nil
+}
+
+func (g *gifEncoder) close() error {
+	defer g.f.Close()
+	if len(g.frames) == 0 {
+		return nil
+	}
+
+	anim := &gif.GIF{}
+	for i, fr := range g.frames {
+		// Hold each frame until the next one; the last one holds as long as
+		// the one before it
+		var delay time.Duration
+		switch {
+		case i+1 < len(g.frames):
+			delay = g.frames[i+1].t - fr.t
+		case i > 0:
+			delay = fr.t - g.frames[i-1].t
+		default:
+			delay = time.Second
+		}
+		screen := raster.Parse(fr.frame, g.cols, g.rows)
+		anim.Image = append(anim.Image, paletted(screen))
+		anim.Delay = append(anim.Delay, max(2, int(delay/(10*time.Millisecond))))
+	}
+	if err := gif.EncodeAll(g.f, anim); err != nil {
+		return err
+	}
+	return g.f.Close()
+}
+
+// paletted converts a screen to a paletted image. Frames with at most 256
+// distinct colors keep them exactly; busier frames are dithered onto a
+// fixed palette.
+func paletted(s *raster.Screen) *image.Paletted {
+	img := s.Image()
+
+	index := map[color.RGBA]uint8{}
+	var pal color.Palette
+	for _, cell := range s.Cells {
+		for _, c := range []color.RGBA{cell.Fg, cell.Bg} {
+			if _, ok := index[c]; !ok && len(pal) <= 256 {
+				index[c] = uint8(len(pal))
+				pal = append(pal, c)
+			}
+		}
+	}
+
+	if len(pal) > 256 {
+		out := image.NewPaletted(img.Bounds(), palette.Plan9)
+		draw.FloydSteinberg.Draw(out, img.Bounds(), img, image.Point{})
+		return out
+	}
+	out := image.NewPaletted(img.Bounds(), pal)
+	for i := 0; i < len(img.Pix); i += 4 {
+		c := color.RGBA{R: img.Pix[i], G: img.Pix[i+1], B: img.Pix[i+2], A: img.Pix[i+3]}
+		out.Pix[i/4] = index[c]
+	}
+	return out
+}
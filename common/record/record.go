@@ -0,0 +1,84 @@
+// Package record captures the frames a demo renders and saves them as an
+// asciinema v2 cast or an animated GIF, chosen by the file extension.
+package record
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// encoder writes frames in one output format.
+type encoder interface {
+	frame(t time.Duration, frame string, cols, rows int) error
+	close() error
+}
+
+// Recorder collects frames for one output file.
+type Recorder struct {
+	path  string
+	enc   encoder
+	start time.Time
+	cols  int
+	rows  int
+	last  string
+	err   error // first write error, reported by Close
+}
+
+// Create starts a recording to path, which must end in .cast or .gif. The
+// file is created straight away so a bad path fails before anything runs.
+func Create(path string) (*Recorder, error) {
+	var enc encoder
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".cast":
+		enc, err = newCast(path)
+	case ".gif":
+		enc, err = newGIF(path)
+	default:
+		return nil, fmt.Errorf("record: unknown format %q, use .cast or .gif", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{path: path, enc: enc, start: time.Now()}, nil
+}
+
+// Path returns the file being written.
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Resize sets the terminal size frames are laid out on. Frames are ignored
+// until it has been called.
+func (r *Recorder) Resize(cols, rows int) {
+	r.cols, r.rows = cols, rows
+}
+
+// Frame records frame at the current time. Frames identical to the previous
+// one are skipped.
+func (r *Recorder) Frame(frame string) error {
+	return r.FrameAt(time.Since(r.start), frame)
+}
+
+// FrameAt records frame at time t from the start of the recording. Offline
+// renderers use it to lay frames out at exact intervals.
+func (r *Recorder) FrameAt(t time.Duration, frame string) error {
+	if r.err != nil || r.cols <= 0 || r.rows <= 0 || frame == r.last {
+		return r.err
+	}
+	r.last = frame
+	r.err = r.enc.frame(t, frame, r.cols, r.rows)
+	return r.err
+}
+
+// Close finishes and writes the file. It also reports the first error from
+// writing frames.
+func (r *Recorder) Close() error {
+	err := r.enc.close()
+	if r.err != nil {
+		return r.err
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+// Package registry is the list of demos. Each demo package registers itself
+// from an init function, and the showcase menu, its command line and the
+// docs generator read the registry instead of keeping lists of their own:
+//
+//	func init() {
+//		registry.Register(registry.Info{
+//			Dir:      "01-plasma",
+//			Heading:  "🌈 Plasma Effect",
+//			Summary:  "Classic demoscene plasma with multiple color palettes",
+//			Section:  registry.Demoscene,
+//			Keywords: []string{"demoscene", "palette", "sine"},
+//			Build:    New,
+//		})
+//	}
+//
+// Importing a demo package, even for its side effects alone, registers it.
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+)
+
+// Category groups demos in the menu and the docs.
+type Category int
+
+const (
+	Examples Category = iota
+	Demoscene
+	Bubbles
+)
+
+// Categories lists every category in menu order.
+var Categories = []Category{Examples, Demoscene, Bubbles}
+
+func (c Category) String() string {
+	switch c {
+	case Examples:
+		return "Examples"
+	case Demoscene:
+		return "Demoscene"
+	case Bubbles:
+		return "Bubbles"
+	}
+	return fmt.Sprintf("Category(%d)", int(c))
+}
+
+// Visual reports whether the category's demos play by themselves, as attract
+// mode needs, rather than waiting for input.
+func (c Category) Visual() bool {
+	return c != Bubbles
+}
+
+// Demo describes a demo.
+type Demo interface {
+	// Name is the demo's directory, such as "06-vaporwave", which also
+	// names its screenshots and benchmarks.
+	Name() string
+	// Title is the demo's name as the menu shows it, such as "🌆 Vaporwave".
+	Title() string
+	Description() string
+	Category() Category
+	// Tags are more words the menu's search matches.
+	Tags() []string
+	// MinSize is the smallest terminal the demo fits in.
+	MinSize() (width, height int)
+	// MinColors is the fewest colors the demo looks right with, such as 256.
+	MinColors() int
+	// Keybindings lists the keys the demo responds to, besides the ones
+	// engine.Run adds to every demo.
+	Keybindings() []key.Binding
+	// Doc is the demo's page in markdown, what the effect is and the math
+	// behind it, as the manual package shows it. It may be empty.
+	Doc() string
+	// New builds the demo's model.
+	New() tea.Model
+	// Options are the program options the demo needs besides the alternate
+	// screen.
+	Options() []tea.ProgramOption
+}
+
+// The size and colors a demo needs unless its Info says otherwise.
+const (
+	DefaultMinWidth  = 40
+	DefaultMinHeight = 12
+	DefaultMinColors = 256
+)
+
+// Info is a Demo described by its fields. Their names differ from the
+// methods', which return them.
+type Info struct {
+	Dir                 string
+	Heading             string
+	Summary             string
+	Section             Category
+	Keywords            []string
+	MinWidth, MinHeight int    // zero for the defaults
+	Colors              int    // zero for DefaultMinColors
+	Manual              string // the markdown Doc returns
+	Build               func() tea.Model
+	Opts                []tea.ProgramOption
+}
+
+func (i Info) Name() string                 { return i.Dir }
+func (i Info) Title() string                { return i.Heading }
+func (i Info) Description() string          { return i.Summary }
+func (i Info) Category() Category           { return i.Section }
+func (i Info) Tags() []string               { return i.Keywords }
+func (i Info) Doc() string                  { return i.Manual }
+func (i Info) New() tea.Model               { return i.Build() }
+func (i Info) Options() []tea.ProgramOption { return i.Opts }
+
+func (i Info) MinSize() (width, height int) {
+	width, height = i.MinWidth, i.MinHeight
+	if width == 0 {
+		width = DefaultMinWidth
+	}
+	if height == 0 {
+		height = DefaultMinHeight
+	}
+	return width, height
+}
+
+func (i Info) MinColors() int {
+	if i.Colors == 0 {
+		return DefaultMinColors
+	}
+	return i.Colors
+}
+
+// Keybindings asks a model of the demo for its keys, if it declares them
+// with the keymap package.
+func (i Info) Keybindings() []key.Binding {
+	if km, ok := i.Build().(interface{ KeyMap() keymap.Map }); ok {
+		return km.KeyMap().Listed()
+	}
+	return nil
+}
+
+var (
+	mu    sync.Mutex
+	demos []Demo
+)
+
+// Register adds a demo. It panics if a demo with the same name or short
+// name is already registered, since the menu and command line could not
+// tell them apart.
+func Register(d Demo) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, other := range demos {
+		if other.Name() == d.Name() || ShortName(other) == ShortName(d) {
+			panic("registry: demo " + d.Name() + " registered twice")
+		}
+	}
+	demos = append(demos, d)
+}
+
+// All returns every registered demo, by category and then by name, which
+// keeps the numbered directories in order.
+func All() []Demo {
+	mu.Lock()
+	defer mu.Unlock()
+	all := append([]Demo(nil), demos...)
+	sort.SliceStable(all, func(a, b int) bool {
+		if all[a].Category() != all[b].Category() {
+			return all[a].Category() < all[b].Category()
+		}
+		return all[a].Name() < all[b].Name()
+	})
+	return all
+}
+
+// In returns the registered demos of one category, in order.
+func In(c Category) []Demo {
+	var in []Demo
+	for _, d := range All() {
+		if d.Category() == c {
+			in = append(in, d)
+		}
+	}
+	return in
+}
+
+// ShortName is the name a demo is run by on the command line: its
+// directory without the number, such as "vaporwave" for "06-vaporwave".
+func ShortName(d Demo) string {
+	return strings.TrimPrefix(strings.TrimLeft(d.Name(), "0123456789"), "-")
+}
+
+// Lookup finds a demo by its short or directory name, ignoring case.
+func Lookup(name string) (Demo, bool) {
+	for _, d := range All() {
+		if strings.EqualFold(name, ShortName(d)) || strings.EqualFold(name, d.Name()) {
+			return d, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,46 @@
+// Package rng is the random number source the demos share. It is seeded
+// from the clock unless SHOWCASE_SEED gives a number; Seed makes a run
+// repeatable, as benchmarks and the golden-frame tests need.
+package rng
+
+import (
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	mu  sync.Mutex
+	src = rand.New(rand.NewSource(initialSeed()))
+)
+
+func initialSeed() int64 {
+	if seed, err := strconv.ParseInt(os.Getenv("SHOWCASE_SEED"), 10, 64); err == nil {
+		return seed
+	}
+	return time.Now().UnixNano()
+}
+
+// Seed restarts the source from seed, so the same calls return the same
+// numbers again.
+func Seed(seed int64) {
+	mu.Lock()
+	defer mu.Unlock()
+	src = rand.New(rand.NewSource(seed))
+}
+
+// Float64 returns a number in [0, 1).
+func Float64() float64 {
+	mu.Lock()
+	defer mu.Unlock()
+	return src.Float64()
+}
+
+// Intn returns a number in [0, n). It panics if n <= 0.
+func Intn(n int) int {
+	mu.Lock()
+	defer mu.Unlock()
+	return src.Intn(n)
+}
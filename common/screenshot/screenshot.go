@@ -0,0 +1,41 @@
+// Package screenshot saves a rendered frame to disk, once with its ANSI styling
+// intact and once as plain text.
+package screenshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Dir returns the directory screenshots are written to: SHOWCASE_SCREENSHOTS
+// if set, otherwise the current directory.
+func Dir() string {
+	if dir := os.Getenv("SHOWCASE_SCREENSHOTS"); dir != "" {
+		return dir
+	}
+	return "."
+}
+
+// Save writes frame to dir as name-<timestamp>.ans, holding the raw escape
+// sequences, and name-<timestamp>.txt with the styling stripped. It returns
+// the path of the .ans file.
+func Save(dir, name, frame string) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	base := filepath.Join(dir, fmt.Sprintf("%s-%s", name, time.Now().Format("20060102-150405.000")))
+
+	// End with a reset so cat-ing the file leaves the terminal unstyled
+	raw := frame + "\x1b[0m\n"
+	if err := os.WriteFile(base+".ans", []byte(raw), 0o644); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(base+".txt", []byte(ansi.Strip(frame)+"\n"), 0o644); err != nil {
+		return "", err
+	}
+	return base + ".ans", nil
+}
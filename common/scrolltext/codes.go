@@ -0,0 +1,175 @@
+package scrolltext
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// A message can carry control codes in braces, which are not drawn but
+// take effect as the point in the text where they sit reaches the middle
+// of the screen:
+//
+//	{pause:2}   stops the text for two seconds, the wave still running
+//	{speed:2}   scrolls at twice the speed, or any other multiple
+//	{wave:off}  flattens the wave, {wave:on} brings it back and
+//	            {wave:5} makes it five rows high
+//
+// The rest of Codes, {color:name} and {flash}, are handed back by Update
+// for the demo to act on. Anything else in braces is not a code and is
+// drawn as it is, so a mistyped code shows rather than vanishing. Each time
+// the text comes round again the speed and wave go back to how they were.
+
+// Codes are the names of the control codes taken out of a message.
+var Codes = []string{"pause", "speed", "wave", "color", "flash"}
+
+// Code is a control code in the message: {Name:Arg}, or {Name}.
+type Code struct {
+	Name string
+	Arg  string
+	// index is where the code sits in the text as drawn, before the
+	// letter it comes ahead of.
+	index int
+}
+
+// effects are what the codes passed so far have done.
+type effects struct {
+	speed   float64 // a multiple of the normal speed, or 0 for normal
+	wave    float64 // the wave's height, if waveSet
+	waveSet bool
+	pause   float64 // seconds left to stand still
+}
+
+// split takes the codes out of a message, returning the text to draw and
+// the codes in it. A brace that opens no code is drawn as it is, and so is
+// one whose name is not among Codes.
+func split(message string) (string, []Code) {
+	if !strings.Contains(message, "{") {
+		return message, nil
+	}
+	var text strings.Builder
+	var codes []Code
+	n := 0
+	for {
+		open := strings.IndexByte(message, '{')
+		end := strings.IndexByte(message[max(open, 0):], '}')
+		if open < 0 || end < 0 {
+			break
+		}
+		name, arg, _ := strings.Cut(message[open+1:open+end], ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(Codes, name) {
+			// Draw the brace, and look for a code after it
+			text.WriteString(message[:open+1])
+			n += len([]rune(message[:open+1]))
+			message = message[open+1:]
+			continue
+		}
+		before := message[:open]
+		text.WriteString(before)
+		n += len([]rune(before))
+		codes = append(codes, Code{Name: name, Arg: strings.TrimSpace(arg), index: n})
+		message = message[open+end+1:]
+	}
+	text.WriteString(message)
+	return text.String(), codes
+}
+
+// Strip returns the message without its control codes.
+func Strip(message string) string {
+	text, _ := split(message)
+	return text
+}
+
+// codeColumns returns the column each code sits at in the laid out text.
+func (s Scroller) codeColumns(text string, codes []Code) []int {
+	columns := make([]int, len(codes))
+	var starts []int
+	width := len([]rune(text)) * Advance
+	if s.Font != nil {
+		var rows [][]canvas.Cell
+		rows, starts = s.Font.Layout(text)
+		width = len(rows[0])
+	}
+	for i, c := range codes {
+		switch {
+		case c.index >= len([]rune(text)):
+			columns[i] = width
+		case starts != nil:
+			columns[i] = starts[c.index]
+		default:
+			columns[i] = c.index * Advance
+		}
+	}
+	return columns
+}
+
+// passed applies the codes whose column the middle of a screen width wide
+// has reached in moving from one position to the next, and returns the
+// ones it does not know.
+func (s *Scroller) passed(from, to float64, width int) []Code {
+	text, codes := split(s.Message)
+	if len(codes) == 0 {
+		return nil
+	}
+	var unknown []Code
+	for i, column := range s.codeColumns(text, codes) {
+		at := float64(column - width/2)
+		if at <= from || at > to {
+			continue
+		}
+		c := codes[i]
+		switch c.Name {
+		case "pause":
+			if secs, err := strconv.ParseFloat(c.Arg, 64); err == nil && secs > 0 {
+				s.effects.pause = secs
+				// Stand still with the code at the middle of the screen
+				s.Pos = at
+				return unknown
+			}
+		case "speed":
+			if speed, err := strconv.ParseFloat(c.Arg, 64); err == nil && speed > 0 {
+				s.effects.speed = speed
+			}
+		case "wave":
+			switch strings.ToLower(c.Arg) {
+			case "off":
+				s.effects.wave, s.effects.waveSet = 0, true
+			case "on":
+				s.effects.waveSet = false
+			default:
+				if height, err := strconv.ParseFloat(c.Arg, 64); err == nil && height >= 0 {
+					s.effects.wave, s.effects.waveSet = height, true
+				}
+			}
+		default:
+			unknown = append(unknown, c)
+		}
+	}
+	return unknown
+}
+
+// waveHeight is the wave's height, as WaveHeight or as a code has set it.
+func (s Scroller) waveHeight() float64 {
+	if s.effects.waveSet {
+		return s.effects.wave
+	}
+	return s.WaveHeight
+}
+
+// step is how far the text moves in a frame's step, standing still while
+// paused.
+func (s *Scroller) step(step float64) float64 {
+	if s.effects.pause > 0 {
+		s.effects.pause -= step / engine.DefaultFPS
+		return 0
+	}
+	speed := s.effects.speed
+	if speed == 0 {
+		speed = 1
+	}
+	return 0.8 * step * speed
+}
@@ -0,0 +1,305 @@
+// Package scrolltext is the demoscene sine scroller: a message in a 5 by 5
+// bitmap font, or any FIGlet or TheDraw font, sliding in from the right and
+// riding a sine wave, drawn onto a canvas. The scroller demo draws it on its own and the tunnel over its
+// walls.
+//
+// A demo keeps a Scroller in its model, moves it on in each frame's Update
+// and draws it with a style function that colors each lit pixel:
+//
+//	s := scrolltext.New(scrolltext.Greetings)
+//	s.Update(m.anim.Delta(), width)
+//	s.Draw(grid, height/2, func(x, y, index int) (rune, canvas.Style) {
+//		return '█', canvas.Style{Fg: common.Cyan}
+//	})
+package scrolltext
+
+import (
+	"math"
+	"slices"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/font"
+)
+
+// Greetings is the scroller demo's message, for any demo that has none of
+// its own. The closing " * " keeps the end apart from the start.
+const Greetings = "DEMOSCENE GREETINGS! * BUBBLE TEA SHOWCASE * TERMINAL GRAPHICS RULE * "
+
+// Advance is how many columns each letter takes, five of bitmap and a gap.
+const Advance = 6
+
+// Bitmap is a letter, rows of '1' for lit pixels and '0' for the rest.
+type Bitmap []string
+
+// unknown stands in for letters the font does not draw.
+var unknown = Bitmap{"11111", "10001", "10001", "10001", "11111"}
+
+// Font is the scroller's alphabet: capitals, lowercase, digits and the
+// common punctuation. Lowercase letters sit on the bottom four rows, with
+// the top row for ascenders; g, j, p, q and y, having no row below to
+// hang into, are raised to fit.
+var Font = map[rune]Bitmap{
+	'A':  {"01110", "10001", "11111", "10001", "10001"},
+	'B':  {"11110", "10001", "11110", "10001", "11110"},
+	'C':  {"01111", "10000", "10000", "10000", "01111"},
+	'D':  {"11110", "10001", "10001", "10001", "11110"},
+	'E':  {"11111", "10000", "11110", "10000", "11111"},
+	'F':  {"11111", "10000", "11110", "10000", "10000"},
+	'G':  {"01111", "10000", "10011", "10001", "01111"},
+	'H':  {"10001", "10001", "11111", "10001", "10001"},
+	'I':  {"11111", "00100", "00100", "00100", "11111"},
+	'J':  {"11111", "00010", "00010", "10010", "01100"},
+	'K':  {"10010", "10100", "11000", "10100", "10010"},
+	'L':  {"10000", "10000", "10000", "10000", "11111"},
+	'M':  {"10001", "11011", "10101", "10001", "10001"},
+	'N':  {"10001", "11001", "10101", "10011", "10001"},
+	'O':  {"01110", "10001", "10001", "10001", "01110"},
+	'P':  {"11110", "10001", "11110", "10000", "10000"},
+	'Q':  {"01110", "10001", "10101", "10010", "01101"},
+	'R':  {"11110", "10001", "11110", "10010", "10001"},
+	'S':  {"01111", "10000", "01110", "00001", "11110"},
+	'T':  {"11111", "00100", "00100", "00100", "00100"},
+	'U':  {"10001", "10001", "10001", "10001", "01110"},
+	'V':  {"10001", "10001", "10001", "01010", "00100"},
+	'W':  {"10001", "10001", "10101", "11011", "10001"},
+	'X':  {"10001", "01010", "00100", "01010", "10001"},
+	'Y':  {"10001", "10001", "01010", "00100", "00100"},
+	'Z':  {"11111", "00010", "00100", "01000", "11111"},
+	'a':  {"00000", "01111", "10001", "10011", "01101"},
+	'b':  {"10000", "10000", "11110", "10001", "11110"},
+	'c':  {"00000", "01111", "10000", "10000", "01111"},
+	'd':  {"00001", "00001", "01111", "10001", "01111"},
+	'e':  {"00000", "01110", "11111", "10000", "01110"},
+	'f':  {"00110", "01000", "11100", "01000", "01000"},
+	'g':  {"01111", "10001", "01111", "00001", "01110"},
+	'h':  {"10000", "10000", "11110", "10001", "10001"},
+	'i':  {"00100", "00000", "01100", "00100", "01110"},
+	'j':  {"00010", "00000", "00010", "10010", "01100"},
+	'k':  {"10000", "10010", "11100", "10010", "10001"},
+	'l':  {"01100", "00100", "00100", "00100", "01110"},
+	'm':  {"00000", "11010", "10101", "10101", "10001"},
+	'n':  {"00000", "11110", "10001", "10001", "10001"},
+	'o':  {"00000", "01110", "10001", "10001", "01110"},
+	'p':  {"11110", "10001", "11110", "10000", "10000"},
+	'q':  {"01111", "10001", "01111", "00001", "00001"},
+	'r':  {"00000", "10110", "11001", "10000", "10000"},
+	's':  {"00000", "01111", "11100", "00111", "11110"},
+	't':  {"01000", "11110", "01000", "01001", "00110"},
+	'u':  {"00000", "10001", "10001", "10011", "01101"},
+	'v':  {"00000", "10001", "10001", "01010", "00100"},
+	'w':  {"00000", "10001", "10101", "10101", "01010"},
+	'x':  {"00000", "11011", "00100", "00100", "11011"},
+	'y':  {"10001", "10001", "01111", "00001", "01110"},
+	'z':  {"00000", "11111", "00010", "01100", "11111"},
+	' ':  {"00000", "00000", "00000", "00000", "00000"},
+	'*':  {"00100", "10101", "01110", "10101", "00100"},
+	'!':  {"00100", "00100", "00100", "00000", "00100"},
+	'.':  {"00000", "00000", "00000", "00000", "00100"},
+	',':  {"00000", "00000", "00000", "00100", "01000"},
+	'?':  {"01110", "10001", "00110", "00000", "00100"},
+	'-':  {"00000", "00000", "11111", "00000", "00000"},
+	'+':  {"00000", "00100", "01110", "00100", "00000"},
+	':':  {"00000", "00100", "00000", "00100", "00000"},
+	';':  {"00000", "00100", "00000", "00100", "01000"},
+	'\'': {"00100", "00100", "00000", "00000", "00000"},
+	'"':  {"01010", "01010", "00000", "00000", "00000"},
+	'(':  {"00010", "00100", "00100", "00100", "00010"},
+	')':  {"01000", "00100", "00100", "00100", "01000"},
+	'{':  {"00110", "00100", "01000", "00100", "00110"},
+	'}':  {"01100", "00100", "00010", "00100", "01100"},
+	'/':  {"00001", "00010", "00100", "01000", "10000"},
+	'#':  {"01010", "11111", "01010", "11111", "01010"},
+	'@':  {"01110", "10001", "10111", "10110", "01111"},
+	'&':  {"01100", "10010", "01101", "10010", "01101"},
+	'%':  {"11001", "11010", "00100", "01011", "10011"},
+	'0':  {"01110", "10001", "10001", "10001", "01110"},
+	'1':  {"00100", "01100", "00100", "00100", "01110"},
+	'2':  {"01110", "10001", "00110", "01000", "11111"},
+	'3':  {"01110", "10001", "00110", "10001", "01110"},
+	'4':  {"10001", "10001", "11111", "00001", "00001"},
+	'5':  {"11111", "10000", "11110", "00001", "11110"},
+	'6':  {"01110", "10000", "11110", "10001", "01110"},
+	'7':  {"11111", "00001", "00010", "00100", "01000"},
+	'8':  {"01110", "10001", "01110", "10001", "01110"},
+	'9':  {"01110", "10001", "01111", "00001", "01110"},
+}
+
+// Scroller is a message on its way across the screen.
+type Scroller struct {
+	Message string
+	// Pos is how many columns the text has scrolled left; it starts off
+	// the right edge at minus the width of the screen.
+	Pos float64
+	// Time drives the wave, and is there for style functions to use.
+	Time       float64
+	WaveHeight float64
+	// Phase shifts the wave along, in radians, so scrollers on the same
+	// screen need not rise and fall together.
+	Phase float64
+	// Font, if set, draws the text in place of the bitmap font.
+	Font *font.Font
+
+	effects effects
+}
+
+// bitmapHeight is the bitmap font's height in rows, which the wave height
+// is measured against.
+const bitmapHeight = 5
+
+// New returns a scroller for the message with a wave three rows high,
+// starting at the left edge.
+func New(message string) Scroller {
+	return Scroller{Message: message, WaveHeight: 3}
+}
+
+// Update moves the text on by a frame's step of time and brings it back in
+// from the right once it has all gone off the left of a screen width wide.
+// It returns the control codes passed that it leaves to the caller.
+func (s *Scroller) Update(step float64, width int) []Code {
+	s.Time += 0.05 * step
+	from := s.Pos
+	s.Pos += s.step(step)
+	codes := s.passed(from, s.Pos, width)
+	if s.Pos > float64(s.Width()+width) {
+		s.Pos = -float64(width)
+		s.effects = effects{}
+	}
+	return codes
+}
+
+// Reset starts the text again from the right edge of a screen width wide.
+func (s *Scroller) Reset(width int) {
+	s.Time = 0
+	s.Pos = -float64(width)
+	s.effects = effects{}
+}
+
+// Width is the message's width in columns.
+func (s Scroller) Width() int {
+	if s.Font != nil {
+		rows, _ := s.Font.Layout(Strip(s.Message))
+		return len(rows[0])
+	}
+	return len([]rune(Strip(s.Message))) * Advance
+}
+
+// Height is the text's height in rows.
+func (s Scroller) Height() int {
+	if s.Font != nil {
+		return s.Font.Height
+	}
+	return bitmapHeight
+}
+
+// amplitude is how far the wave moves the text up and down on a canvas
+// height rows tall. WaveHeight is for the bitmap font; taller or shorter
+// fonts ride a wave scaled to match, but never so high that the text
+// leaves the canvas.
+func (s Scroller) amplitude(height int) float64 {
+	scaled := s.waveHeight() * float64(s.Height()) / bitmapHeight
+	return max(min(scaled, float64(height-s.Height())/2), 0)
+}
+
+// wave is how far the wave moves the column at x.
+func (s Scroller) wave(x int, amplitude float64) int {
+	return int(math.Sin(float64(x)*0.08+s.Time*2.5+s.Phase) * amplitude)
+}
+
+// Draw lays the visible letters onto c, their middle row at centerY, with
+// each lit pixel moved up or down by the wave. style gives the character
+// and style of the pixel at screen position x, y of the letter at index in
+// the message.
+//
+// With a font, style colors the font's strokes; only its full blocks take
+// the character style gives, and the cells of color fonts keep their own
+// colors.
+func (s Scroller) Draw(c *canvas.Canvas, centerY int, style func(x, y, index int) (rune, canvas.Style)) {
+	if s.Font != nil {
+		s.drawFont(c, centerY, style)
+		return
+	}
+	start := int(-s.Pos)
+	amplitude := s.amplitude(c.Height())
+	for i, r := range []rune(Strip(s.Message)) {
+		x := start + i*Advance
+		if x > -Advance && x < c.Width()+Advance {
+			s.drawLetter(c, r, x, centerY, i, amplitude, style)
+		}
+	}
+}
+
+func (s Scroller) drawLetter(c *canvas.Canvas, r rune, startX, centerY, index int, amplitude float64, style func(x, y, index int) (rune, canvas.Style)) {
+	bitmap, ok := Font[r]
+	if !ok {
+		bitmap = unknown
+	}
+	startY := centerY - len(bitmap)/2
+	for row, line := range bitmap {
+		for col := 0; col < len(line); col++ {
+			if line[col] != '1' {
+				continue
+			}
+			// The wave follows the screen column, not the letter, so the
+			// text rides through it
+			x := startX + col
+			y := startY + row + s.wave(x, amplitude)
+			if c.InBounds(x, y) {
+				ch, st := style(x, y, index)
+				c.Set(x, y, ch, st)
+			}
+		}
+	}
+}
+
+// drawFont draws the text in the font, a column at a time.
+func (s Scroller) drawFont(c *canvas.Canvas, centerY int, style func(x, y, index int) (rune, canvas.Style)) {
+	rows, starts := s.Font.Layout(Strip(s.Message))
+	start := int(-s.Pos)
+	amplitude := s.amplitude(c.Height())
+	startY := centerY - len(rows)/2
+	index := 0
+	for col := range rows[0] {
+		x := start + col
+		for index+1 < len(starts) && starts[index+1] <= col {
+			index++
+		}
+		if x < 0 || x >= c.Width() {
+			continue
+		}
+		wave := s.wave(x, amplitude)
+		for row := range rows {
+			cell := rows[row][col]
+			y := startY + row + wave
+			if (cell.Rune == ' ' && cell.Style.Bg == "") || !c.InBounds(x, y) {
+				continue
+			}
+			ch, st := style(x, y, index)
+			switch {
+			case cell.Style.Fg != "" || cell.Style.Bg != "":
+				ch, st = cell.Rune, cell.Style
+			case cell.Rune != '█':
+				ch = cell.Rune
+			}
+			c.Set(x, y, ch, st)
+		}
+	}
+}
+
+// Normalize prepares typed text for the scroller, closing the loop with
+// " * ".
+func Normalize(text string) string {
+	return text + " * "
+}
+
+// Missing returns the characters of text the bitmap font does not draw,
+// each once, in the order they first appear. Control codes are not drawn,
+// so are not counted.
+func Missing(text string) []rune {
+	var missing []rune
+	for _, r := range Strip(text) {
+		if _, ok := Font[r]; !ok && !slices.Contains(missing, r) {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
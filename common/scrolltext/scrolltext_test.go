@@ -0,0 +1,56 @@
+package scrolltext
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// covered is every character the bitmap font is meant to draw.
+const covered = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789" +
+	" *!.,?-+:;'\"(){}/#@&%"
+
+func TestFontCoverage(t *testing.T) {
+	if missing := Missing(covered); len(missing) > 0 {
+		t.Errorf("no bitmap for %q", string(missing))
+	}
+	if missing := Missing(Greetings); len(missing) > 0 {
+		t.Errorf("the greetings need bitmaps for %q", string(missing))
+	}
+}
+
+func TestFontBitmaps(t *testing.T) {
+	for r, bitmap := range Font {
+		if len(bitmap) != bitmapHeight {
+			t.Errorf("%q has %d rows, want %d", r, len(bitmap), bitmapHeight)
+		}
+		for _, row := range bitmap {
+			if len(row) != Advance-1 || strings.Trim(row, "01") != "" {
+				t.Errorf("%q has a row %q, want %d of 0 and 1", r, row, Advance-1)
+			}
+		}
+	}
+}
+
+func TestCodes(t *testing.T) {
+	tests := []struct {
+		message, text string
+		codes         []string
+	}{
+		{"A{pause:2}B{Speed: 3}C", "ABC", []string{"pause", "speed"}},
+		{"A{color:fire}B{flash}", "AB", []string{"color", "flash"}},
+		{"A{speeed:2}B", "A{speeed:2}B", nil},
+		{"{x {wave:off}y}", "{x y}", []string{"wave"}},
+		{"A{pause:2", "A{pause:2", nil},
+	}
+	for _, tt := range tests {
+		text, codes := split(tt.message)
+		var names []string
+		for _, c := range codes {
+			names = append(names, c.Name)
+		}
+		if text != tt.text || !slices.Equal(names, tt.codes) {
+			t.Errorf("split(%q) = %q, %q, want %q, %q", tt.message, text, names, tt.text, tt.codes)
+		}
+	}
+}
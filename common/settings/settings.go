@@ -0,0 +1,133 @@
+// Package settings keeps each demo's tweakable parameters between runs. All
+// demos share one JSON file, settings.json in the showcase config directory,
+// with an entry per demo:
+//
+//	{"plasma": {"palette": 2, "speed": 1.4, "intensity": 1}}
+//
+// A demo loads its entry in initialModel and implements Saver so engine.Run
+// writes the entry back when the demo quits. Setting SHOWCASE_SETTINGS points
+// at a different file, or turns persistence off with "off".
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Saver is implemented by models with settings to keep. Settings returns the
+// demo's entry name and a JSON-encodable value holding its current settings.
+type Saver interface {
+	Settings() (name string, v any)
+}
+
+// overrides are entries applied over the file's by Load.
+var (
+	mu        sync.Mutex
+	overrides = map[string]json.RawMessage{}
+)
+
+// Override makes Load apply data over the named entry, as if the file held
+// it, until Override is called again with nil. The showcase's playlists set
+// a demo's parameters this way without touching the file.
+func Override(name string, data json.RawMessage) {
+	mu.Lock()
+	defer mu.Unlock()
+	if data == nil {
+		delete(overrides, name)
+		return
+	}
+	overrides[name] = data
+}
+
+// Path returns the settings file, or "" if persistence is turned off.
+func Path() (string, error) {
+	if env := os.Getenv("SHOWCASE_SETTINGS"); env != "" {
+		if env == "off" {
+			return "", nil
+		}
+		return env, nil
+	}
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config, "bubbletea-showcase", "settings.json"), nil
+}
+
+// Load decodes the named entry into v, which should already hold the demo's
+// defaults: fields missing from the file keep them. A missing file or entry
+// is not an error. An override for the entry is decoded over the file's.
+func Load(name string, v any) error {
+	all, err := read()
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	override := overrides[name]
+	mu.Unlock()
+	for _, data := range [][]byte{all[name], override} {
+		if data == nil {
+			continue
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return fmt.Errorf("settings %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Save replaces the named entry with v, keeping every other demo's entry.
+func Save(name string, v any) error {
+	path, err := Path()
+	if err != nil || path == "" {
+		return err
+	}
+	all, err := read()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	all[name] = data
+
+	out, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// Write a temporary file and rename it so a crash never leaves half a file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(out, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// read returns every entry in the settings file.
+func read() (map[string]json.RawMessage, error) {
+	all := map[string]json.RawMessage{}
+	path, err := Path()
+	if err != nil || path == "" {
+		return all, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return all, err
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return map[string]json.RawMessage{}, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return all, nil
+}
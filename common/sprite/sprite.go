@@ -0,0 +1,417 @@
+// Package sprite provides multi-line character art with per-cell colors that
+// can be drawn onto a canvas, loaded from text files or converted from small
+// PNG images, and animated through a list of frames.
+//
+// The text format lists a palette and then one or more frames. Each frame is
+// the art followed by an optional color layer of the same shape, in which
+// every character is a palette key:
+//
+//	# Comments may come before the first frame
+//	@palette g=#40FF40 e=#FFFFFF/#400000
+//	@fps 4
+//	@frame
+//	 /oo\
+//	<____>
+//	@colors
+//	 geeg
+//	gggggg
+//
+// Spaces in the art are transparent. A palette entry is a foreground color,
+// optionally followed by "/" and a background color.
+package sprite
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	_ "image/png" // PNG decoder for LoadImage
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Sprite is a rectangle of cells. Cells with a zero rune are transparent.
+type Sprite struct {
+	Width  int
+	Height int
+	Cells  []canvas.Cell
+}
+
+// New returns a fully transparent sprite.
+func New(width, height int) *Sprite {
+	return &Sprite{Width: width, Height: height, Cells: make([]canvas.Cell, width*height)}
+}
+
+// FromText makes a single-color sprite from multi-line art. Spaces are
+// transparent. Wide characters take two columns, the second left empty.
+func FromText(art string, style canvas.Style) *Sprite {
+	lines := strings.Split(strings.Trim(art, "\n"), "\n")
+	width := 0
+	for _, line := range lines {
+		width = max(width, canvas.StringWidth(line))
+	}
+	s := New(width, len(lines))
+	for y, line := range lines {
+		x := 0
+		for _, r := range line {
+			if r != ' ' {
+				s.Set(x, y, canvas.Cell{Rune: r, Style: style})
+			}
+			x += canvas.RuneWidth(r)
+		}
+	}
+	return s
+}
+
+// At returns the cell at x, y. Coordinates outside the sprite give a
+// transparent cell.
+func (s *Sprite) At(x, y int) canvas.Cell {
+	if x < 0 || y < 0 || x >= s.Width || y >= s.Height {
+		return canvas.Cell{}
+	}
+	return s.Cells[y*s.Width+x]
+}
+
+// Wrap returns the cell at x, y with the sprite tiled endlessly in both
+// directions, for use as a texture.
+func (s *Sprite) Wrap(x, y int) canvas.Cell {
+	if s.Width == 0 || s.Height == 0 {
+		return canvas.Cell{}
+	}
+	x %= s.Width
+	if x < 0 {
+		x += s.Width
+	}
+	y %= s.Height
+	if y < 0 {
+		y += s.Height
+	}
+	return s.Cells[y*s.Width+x]
+}
+
+// Mirror returns the cell at x, y with the sprite tiled endlessly in both
+// directions and every other tile flipped, so each tile meets its own
+// reflection and there are no seams. Glyphs that point one way, such as
+// half blocks and diagonals, are flipped with the tile.
+func (s *Sprite) Mirror(x, y int) canvas.Cell {
+	if s.Width == 0 || s.Height == 0 {
+		return canvas.Cell{}
+	}
+	tx, ty := floorDiv(x, s.Width), floorDiv(y, s.Height)
+	x -= tx * s.Width
+	y -= ty * s.Height
+	flipX, flipY := tx%2 != 0, ty%2 != 0
+	if flipX {
+		x = s.Width - 1 - x
+	}
+	if flipY {
+		y = s.Height - 1 - y
+	}
+	cell := s.Cells[y*s.Width+x]
+	if r, ok := flippedX[cell.Rune]; ok && flipX {
+		cell.Rune = r
+	}
+	if r, ok := flippedY[cell.Rune]; ok && flipY {
+		cell.Rune = r
+	}
+	return cell
+}
+
+// floorDiv divides rounding down, so negative coordinates fall in the tile
+// before zero rather than in it.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+// flippedX and flippedY give the glyph a glyph turns into when mirrored left
+// to right and top to bottom.
+var (
+	flippedX = pairs("▌▐", "▖▗", "▘▝", "▙▟", "▛▜", "▚▞", "◢◣", "◤◥", "╱╲", "/\\", "()", "[]", "{}", "<>", "┌┐", "└┘", "├┤", "╭╮", "╰╯")
+	flippedY = pairs("▀▄", "▖▘", "▗▝", "▙▛", "▟▜", "▚▞", "◢◥", "◣◤", "╱╲", "/\\", "^v", "┌└", "┐┘", "┬┴", "╭╰", "╮╯")
+)
+
+// pairs makes a map that swaps the two glyphs of each pair.
+func pairs(ps ...string) map[rune]rune {
+	m := map[rune]rune{}
+	for _, p := range ps {
+		r := []rune(p)
+		m[r[0]], m[r[1]] = r[1], r[0]
+	}
+	return m
+}
+
+// Set changes the cell at x, y. Out of range coordinates are ignored.
+func (s *Sprite) Set(x, y int, cell canvas.Cell) {
+	if x < 0 || y < 0 || x >= s.Width || y >= s.Height {
+		return
+	}
+	s.Cells[y*s.Width+x] = cell
+}
+
+// Draw paints the sprite onto c with its top left corner at x, y. Transparent
+// cells are skipped, and cells without a background keep the one already on
+// the canvas.
+func (s *Sprite) Draw(c *canvas.Canvas, x, y int) {
+	for sy := 0; sy < s.Height; sy++ {
+		for sx := 0; sx < s.Width; sx++ {
+			cell := s.Cells[sy*s.Width+sx]
+			if cell.Rune == 0 || !c.InBounds(x+sx, y+sy) {
+				continue
+			}
+			if cell.Style.Bg == "" {
+				cell.Style.Bg = c.Get(x+sx, y+sy).Style.Bg
+			}
+			c.Set(x+sx, y+sy, cell.Rune, cell.Style)
+		}
+	}
+}
+
+// Animation is a sequence of sprite frames played at a fixed rate.
+type Animation struct {
+	Frames []*Sprite
+	FPS    float64
+}
+
+// At returns the frame to show after elapsed seconds, looping forever.
+func (a *Animation) At(elapsed float64) *Sprite {
+	if len(a.Frames) == 0 {
+		return New(0, 0)
+	}
+	if a.FPS <= 0 || elapsed < 0 {
+		return a.Frames[0]
+	}
+	return a.Frames[int(elapsed*a.FPS)%len(a.Frames)]
+}
+
+// Draw paints the frame for elapsed seconds onto c. See Sprite.Draw.
+func (a *Animation) Draw(c *canvas.Canvas, x, y int, elapsed float64) {
+	a.At(elapsed).Draw(c, x, y)
+}
+
+// Load reads a sprite file in the text format described in the package
+// documentation.
+func Load(path string) (*Animation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	a, err := Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return a, nil
+}
+
+// Parse reads sprite text in the format described in the package
+// documentation.
+func Parse(text string) (*Animation, error) {
+	palette := map[rune]canvas.Style{}
+	anim := &Animation{}
+
+	var art, colors []string
+	var section string // "", "frame" or "colors"
+	flush := func() {
+		if section != "" {
+			anim.Frames = append(anim.Frames, build(art, colors, palette))
+		}
+		art, colors = nil, nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if !strings.HasPrefix(line, "@") {
+			switch section {
+			case "frame":
+				art = append(art, line)
+			case "colors":
+				colors = append(colors, line)
+			default:
+				if t := strings.TrimSpace(line); t != "" && !strings.HasPrefix(t, "#") {
+					return nil, fmt.Errorf("line %d: art before @frame", n)
+				}
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "@palette":
+			for _, entry := range fields[1:] {
+				key, style, err := parseEntry(entry)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", n, err)
+				}
+				palette[key] = style
+			}
+		case "@fps":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: @fps needs a rate", n)
+			}
+			fps, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			anim.FPS = fps
+		case "@frame":
+			flush()
+			section = "frame"
+		case "@colors":
+			if section != "frame" {
+				return nil, fmt.Errorf("line %d: @colors outside a frame", n)
+			}
+			section = "colors"
+		default:
+			return nil, fmt.Errorf("line %d: unknown directive %s", n, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	if len(anim.Frames) == 0 {
+		return nil, fmt.Errorf("no frames")
+	}
+	return anim, nil
+}
+
+// parseEntry reads a palette entry of the form k=#FG or k=#FG/#BG.
+func parseEntry(entry string) (rune, canvas.Style, error) {
+	key, value, ok := strings.Cut(entry, "=")
+	if !ok || utf8.RuneCountInString(key) != 1 {
+		return 0, canvas.Style{}, fmt.Errorf("bad palette entry %q", entry)
+	}
+	fg, bg, _ := strings.Cut(value, "/")
+	r, _ := utf8.DecodeRuneInString(key)
+	style := canvas.Style{}
+	if fg != "" {
+		style.Fg = lipgloss.Color(fg)
+	}
+	if bg != "" {
+		style.Bg = lipgloss.Color(bg)
+	}
+	return r, style, nil
+}
+
+func build(art, colors []string, palette map[rune]canvas.Style) *Sprite {
+	width := 0
+	for _, line := range art {
+		width = max(width, canvas.StringWidth(line))
+	}
+
+	// The color layer is matched by column, so a wide character takes the
+	// key under its first column
+	s := New(width, len(art))
+	for y, line := range art {
+		var keys []rune
+		if y < len(colors) {
+			keys = []rune(colors[y])
+		}
+		x := 0
+		for _, r := range line {
+			if r != ' ' {
+				cell := canvas.Cell{Rune: r}
+				if x < len(keys) {
+					cell.Style = palette[keys[x]]
+				}
+				s.Set(x, y, cell)
+			}
+			x += canvas.RuneWidth(r)
+		}
+	}
+	return s
+}
+
+// LoadFile reads a sprite file in the text format or, if its name ends in
+// .png, an image, converted with LoadImage at most cols wide.
+func LoadFile(path string, cols int) (*Animation, error) {
+	if !strings.EqualFold(filepath.Ext(path), ".png") {
+		return Load(path)
+	}
+	s, err := LoadImage(path, cols)
+	if err != nil {
+		return nil, err
+	}
+	return &Animation{Frames: []*Sprite{s}}, nil
+}
+
+// Name is what a sprite loaded from path is called: the file's name without
+// its directory or extension, capitalized, so textures/bricks.txt is Bricks.
+func Name(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	r, size := utf8.DecodeRuneInString(name)
+	if size == 0 {
+		return name
+	}
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// LoadImage reads a PNG and converts it with FromImage.
+func LoadImage(path string, cols int) (*Sprite, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return FromImage(img, cols), nil
+}
+
+// FromImage converts an image to block art, two pixels per cell using half
+// blocks. Images wider than cols are scaled down to fit; cols <= 0 keeps the
+// original size. Mostly transparent pixels stay transparent.
+func FromImage(img image.Image, cols int) *Sprite {
+	b := img.Bounds()
+	scale := 1.0
+	if cols > 0 && b.Dx() > cols {
+		scale = float64(b.Dx()) / float64(cols)
+	}
+	w := int(float64(b.Dx()) / scale)
+	h := int(float64(b.Dy()) / scale)
+
+	pixel := func(x, y int) (lipgloss.Color, bool) {
+		if y >= h {
+			return "", false
+		}
+		px := b.Min.X + int(float64(x)*scale)
+		py := b.Min.Y + int(float64(y)*scale)
+		r, g, bl, a := img.At(px, py).RGBA()
+		if a < 0x8000 {
+			return "", false
+		}
+		// Undo the alpha premultiplication
+		rgb := common.RGB{R: uint8(r * 0xFF / a), G: uint8(g * 0xFF / a), B: uint8(bl * 0xFF / a)}
+		return rgb.Color(), true
+	}
+
+	s := New(w, (h+1)/2)
+	for y := 0; y < s.Height; y++ {
+		for x := 0; x < w; x++ {
+			top, hasTop := pixel(x, 2*y)
+			bottom, hasBottom := pixel(x, 2*y+1)
+			switch {
+			case hasTop && hasBottom:
+				s.Set(x, y, canvas.Cell{Rune: '▀', Style: canvas.Style{Fg: top, Bg: bottom}})
+			case hasTop:
+				s.Set(x, y, canvas.Cell{Rune: '▀', Style: canvas.Style{Fg: top}})
+			case hasBottom:
+				s.Set(x, y, canvas.Cell{Rune: '▄', Style: canvas.Style{Fg: bottom}})
+			}
+		}
+	}
+	return s
+}
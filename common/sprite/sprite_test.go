@@ -0,0 +1,18 @@
+package sprite
+
+import "testing"
+
+func TestName(t *testing.T) {
+	tests := []struct{ path, want string }{
+		{"textures/bricks.txt", "Bricks"},
+		{"logo.png", "Logo"},
+		{"/tmp/élan.txt", "Élan"},
+		{"ñu", "Ñu"},
+		{"textures/.txt", ""},
+	}
+	for _, tt := range tests {
+		if got := Name(tt.path); got != tt.want {
+			t.Errorf("Name(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
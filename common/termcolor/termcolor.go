@@ -0,0 +1,217 @@
+// Package termcolor detects how many colors the terminal can show and maps
+// true colors down to what it supports, optionally with ordered dithering.
+package termcolor
+
+import (
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// Profile is a level of terminal color support.
+type Profile int
+
+const (
+	// Mono terminals get no color at all.
+	Mono Profile = iota
+	// ANSI16 is the 16 standard colors.
+	ANSI16
+	// ANSI256 is the xterm 256-color palette.
+	ANSI256
+	// TrueColor is 24-bit RGB.
+	TrueColor
+)
+
+var profileNames = []string{"Mono", "16 colors", "256 colors", "True color"}
+
+// String returns a display name for the profile.
+func (p Profile) String() string {
+	if p < 0 || int(p) >= len(profileNames) {
+		return "Unknown"
+	}
+	return profileNames[p]
+}
+
+// Detect works out the terminal's color support from the environment. It
+// follows NO_COLOR, COLORTERM and the terminfo name in TERM, and can be
+// overridden with SHOWCASE_COLORS set to "truecolor", "256", "16" or "none".
+func Detect() Profile {
+	switch strings.ToLower(os.Getenv("SHOWCASE_COLORS")) {
+	case "truecolor", "24bit":
+		return TrueColor
+	case "256":
+		return ANSI256
+	case "16":
+		return ANSI16
+	case "none", "mono":
+		return Mono
+	}
+
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return Mono
+	}
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case term == "dumb":
+		return Mono
+	case strings.Contains(term, "direct"), strings.Contains(term, "truecolor"):
+		return TrueColor
+	case strings.Contains(term, "256color"):
+		return ANSI256
+	case term == "" && runtime.GOOS == "windows":
+		// Windows Terminal and conhost leave TERM unset but support RGB
+		return TrueColor
+	default:
+		return ANSI16
+	}
+}
+
+// DitherEnabled reports whether ordered dithering is wanted. It is on unless
+// SHOWCASE_DITHER is set to "0", "off", "false" or "no".
+func DitherEnabled() bool {
+	switch strings.ToLower(os.Getenv("SHOWCASE_DITHER")) {
+	case "0", "off", "false", "no":
+		return false
+	}
+	return true
+}
+
+// bayer is the 4x4 ordered dithering matrix, as thresholds in [0, 16).
+var bayer = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// offset returns the dither offset for a cell, in [-0.5, 0.5).
+func offset(x, y int) float64 {
+	return bayer[y&3][x&3]/16 - 0.5
+}
+
+// Quantize maps c to the nearest color the profile can show. With dither set,
+// the cell position picks a threshold from a Bayer matrix, so an area whose
+// color falls between two palette entries becomes a fine mix of both instead
+// of one flat band.
+func Quantize(c common.RGB, p Profile, x, y int, dither bool) lipgloss.Color {
+	d := 0.0
+	if dither {
+		d = offset(x, y)
+	}
+	switch p {
+	case TrueColor:
+		return c.Color()
+	case ANSI256:
+		return lipgloss.Color(strconv.Itoa(nearest256(c, d)))
+	case ANSI16:
+		return lipgloss.Color(strconv.Itoa(nearest16(c, d)))
+	default:
+		return lipgloss.Color("")
+	}
+}
+
+// QuantizeColor is Quantize for lipgloss colors. Colors that are not
+// "#RRGGBB" hex, such as ANSI indexes, are returned unchanged.
+func QuantizeColor(c lipgloss.Color, p Profile, x, y int, dither bool) lipgloss.Color {
+	if p == TrueColor || !strings.HasPrefix(string(c), "#") {
+		return c
+	}
+	return Quantize(common.ParseHex(string(c)), p, x, y, dither)
+}
+
+// cubeLevels are the channel values of the xterm 6x6x6 color cube.
+var cubeLevels = [6]float64{0, 95, 135, 175, 215, 255}
+
+func nearest256(c common.RGB, d float64) int {
+	// Spread the dither across one step of the cube
+	const step = 40.0
+	r := float64(c.R) + d*step
+	g := float64(c.G) + d*step
+	b := float64(c.B) + d*step
+
+	ri, gi, bi := cubeIndex(r), cubeIndex(g), cubeIndex(b)
+	cube := 16 + 36*ri + 6*gi + bi
+	cubeDist := dist(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	// The 24-step gray ramp is finer than the cube for near-neutral colors
+	gray := (r + g + b) / 3
+	gi24 := int(math.Round((gray - 8) / 10))
+	gi24 = max(0, min(23, gi24))
+	level := float64(8 + 10*gi24)
+	if dist(r, g, b, level, level, level) < cubeDist {
+		return 232 + gi24
+	}
+	return cube
+}
+
+func cubeIndex(v float64) int {
+	best, bestDist := 0, math.MaxFloat64
+	for i, level := range cubeLevels {
+		if d := math.Abs(v - level); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best
+}
+
+// ansi16 approximates the standard xterm colors 0-15.
+var ansi16 = [16][3]float64{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+func nearest16(c common.RGB, d float64) int {
+	// The 16-color palette is coarse, so dither across a wider band
+	const spread = 96.0
+	r := float64(c.R) + d*spread
+	g := float64(c.G) + d*spread
+	b := float64(c.B) + d*spread
+
+	best, bestDist := 0, math.MaxFloat64
+	for i, p := range ansi16 {
+		if dd := dist(r, g, b, p[0], p[1], p[2]); dd < bestDist {
+			best, bestDist = i, dd
+		}
+	}
+	return best
+}
+
+func dist(r1, g1, b1, r2, g2, b2 float64) float64 {
+	dr, dg, db := r1-r2, g1-g2, b1-b2
+	// Weight green highest and blue lowest, roughly as the eye does
+	return 2*dr*dr + 4*dg*dg + 3*db*db
+}
+
+// ANSI returns the RGB value of xterm color index i (0-255), as terminals
+// usually show it. Out of range indexes give black.
+func ANSI(i int) common.RGB {
+	switch {
+	case i < 0 || i > 255:
+		return common.RGB{}
+	case i < 16:
+		c := ansi16[i]
+		return common.RGB{R: uint8(c[0]), G: uint8(c[1]), B: uint8(c[2])}
+	case i < 232:
+		i -= 16
+		return common.RGB{
+			R: uint8(cubeLevels[i/36]),
+			G: uint8(cubeLevels[i/6%6]),
+			B: uint8(cubeLevels[i%6]),
+		}
+	default:
+		v := uint8(8 + 10*(i-232))
+		return common.RGB{R: v, G: v, B: v}
+	}
+}
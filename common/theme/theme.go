@@ -0,0 +1,262 @@
+// Package theme is the registry of color themes for the demos' text and
+// chrome: title bars, help lines, borders and the Bubbles components. A
+// theme sets the named colors in package common, which the demos read as
+// they draw, so applying one restyles every demo built or drawn after it.
+// The scenes themselves, plasma and fire and the like, keep their palettes.
+//
+// User themes are JSON files in Dir(), each holding one theme or a list.
+// Colors are "#RRGGBB" or a 256-color index, and any left out are Dark's:
+//
+//	{"name": "Solarized", "text": "#839496", "accent": "#268BD2", "blue": "#268BD2"}
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// Theme is a named set of colors, one for each of the named colors in
+// package common.
+type Theme struct {
+	Name string `json:"name"`
+
+	Blue   string `json:"blue"`
+	Green  string `json:"green"`
+	Red    string `json:"red"`
+	Yellow string `json:"yellow"`
+	Purple string `json:"purple"`
+	Cyan   string `json:"cyan"`
+	Orange string `json:"orange"`
+	Pink   string `json:"pink"`
+
+	Title     string `json:"title"`
+	Strong    string `json:"strong"`
+	Text      string `json:"text"`
+	Muted     string `json:"muted"`
+	Subtle    string `json:"subtle"`
+	Faint     string `json:"faint"`
+	Accent    string `json:"accent"`
+	Highlight string `json:"highlight"`
+}
+
+// Dark is the default theme, the colors the demos were designed with.
+var Dark = Theme{
+	Name:   "Dark",
+	Blue:   "#3498db",
+	Green:  "#2ecc71",
+	Red:    "#e74c3c",
+	Yellow: "#f1c40f",
+	Purple: "#9b59b6",
+	Cyan:   "#00CED1",
+	Orange: "#FFA500",
+	Pink:   "#FF69B4",
+
+	Title:     "#FFFFFF",
+	Strong:    "#FFFFFF",
+	Text:      "252",
+	Muted:     "244",
+	Subtle:    "241",
+	Faint:     "240",
+	Accent:    "#7D56F4",
+	Highlight: "#FFD700",
+}
+
+var builtin = []Theme{
+	Dark,
+	{
+		Name:   "Light",
+		Blue:   "#1F6FB2",
+		Green:  "#1E8449",
+		Red:    "#C0392B",
+		Yellow: "#9A7D0A",
+		Purple: "#7D3C98",
+		Cyan:   "#0E7C86",
+		Orange: "#CA6F1E",
+		Pink:   "#C2185B",
+
+		Title:     "#FFFFFF",
+		Strong:    "#000000",
+		Text:      "236",
+		Muted:     "242",
+		Subtle:    "244",
+		Faint:     "248",
+		Accent:    "#5B2FD1",
+		Highlight: "#A04000",
+	},
+	{
+		Name:   "High Contrast",
+		Blue:   "#0087FF",
+		Green:  "#00FF00",
+		Red:    "#FF0000",
+		Yellow: "#FFFF00",
+		Purple: "#AF00FF",
+		Cyan:   "#00FFFF",
+		Orange: "#FF8700",
+		Pink:   "#FF5FD7",
+
+		Title:     "#FFFFFF",
+		Strong:    "#FFFFFF",
+		Text:      "#FFFFFF",
+		Muted:     "255",
+		Subtle:    "253",
+		Faint:     "250",
+		Accent:    "#8700FF",
+		Highlight: "#FFFF00",
+	},
+}
+
+// Builtin returns the themes that ship with the showcase, Dark first.
+func Builtin() []Theme {
+	return append([]Theme(nil), builtin...)
+}
+
+// Dir returns the directory user themes are loaded from.
+func Dir() (string, error) {
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config, "bubbletea-showcase", "themes"), nil
+}
+
+// All returns the built-in themes followed by the user's, sorted by file
+// name. Broken user files are skipped and reported in the error, so callers
+// can always use the returned themes.
+func All() ([]Theme, error) {
+	themes := Builtin()
+	dir, err := Dir()
+	if err != nil {
+		return themes, err
+	}
+	user, err := Load(dir)
+	return append(themes, user...), err
+}
+
+// Lookup finds a theme of All by name, ignoring case and treating dashes as
+// spaces, so "high-contrast" finds High Contrast.
+func Lookup(name string) (Theme, bool) {
+	themes, _ := All()
+	for _, t := range themes {
+		if strings.EqualFold(t.Name, strings.ReplaceAll(name, "-", " ")) {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// Slug is the theme's name as Lookup and the --theme flag take it.
+func (t Theme) Slug() string {
+	return strings.ToLower(strings.ReplaceAll(t.Name, " ", "-"))
+}
+
+// Load reads every *.json theme file in dir. A missing directory is not an
+// error.
+func Load(dir string) ([]Theme, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var themes []Theme
+	var errs []error
+	for _, file := range files {
+		loaded, err := loadFile(file)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		themes = append(themes, loaded...)
+	}
+	return themes, errors.Join(errs...)
+}
+
+func loadFile(path string) ([]Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each theme starts out as Dark, so it only needs the colors it changes
+	var raw []json.RawMessage
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		err = json.Unmarshal(data, &raw)
+	} else {
+		raw = []json.RawMessage{data}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	var valid []Theme
+	var errs []error
+	for _, r := range raw {
+		t := Dark
+		t.Name = ""
+		if err := json.Unmarshal(r, &t); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(path), err))
+			continue
+		}
+		if err := t.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", filepath.Base(path), err))
+			continue
+		}
+		valid = append(valid, t)
+	}
+	return valid, errors.Join(errs...)
+}
+
+var color = regexp.MustCompile(`^(#[0-9A-Fa-f]{3}|#[0-9A-Fa-f]{6}|[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])$`)
+
+// Validate checks that the theme has a name and that every color is a hex
+// color or a 256-color index.
+func (t Theme) Validate() error {
+	if t.Name == "" {
+		return errors.New("theme has no name")
+	}
+	for _, c := range t.colors() {
+		if !color.MatchString(*c) {
+			return fmt.Errorf("theme %q: %q is not a #RRGGBB color or 0-255", t.Name, *c)
+		}
+	}
+	return nil
+}
+
+// colors lists the theme's colors, in the order of targets.
+func (t *Theme) colors() []*string {
+	return []*string{
+		&t.Blue, &t.Green, &t.Red, &t.Yellow, &t.Purple, &t.Cyan, &t.Orange, &t.Pink,
+		&t.Title, &t.Strong, &t.Text, &t.Muted, &t.Subtle, &t.Faint, &t.Accent, &t.Highlight,
+	}
+}
+
+// targets are the variables in common that Apply sets.
+var targets = []*lipgloss.Color{
+	&common.Blue, &common.Green, &common.Red, &common.Yellow,
+	&common.Purple, &common.Cyan, &common.Orange, &common.Pink,
+	&common.Title, &common.Strong, &common.Text, &common.Muted,
+	&common.Subtle, &common.Faint, &common.Accent, &common.Highlight,
+}
+
+var current = Dark
+
+// Apply sets the named colors in common to the theme's.
+func Apply(t Theme) {
+	for i, c := range t.colors() {
+		*targets[i] = lipgloss.Color(*c)
+	}
+	current = t
+}
+
+// Current returns the theme last applied, Dark until then.
+func Current() Theme {
+	return current
+}
@@ -0,0 +1,195 @@
+// Package timeline reads the scripts the showcase plays as a production:
+// scenes run one after the other, each for its time, with a transition
+// between them. A script is a text file with a scene on each line, naming
+// the demo, its time and any settings to run it with:
+//
+//	# Lines starting with # are comments
+//	plasma 10s palette=fire
+//	transition wipe
+//	scroller 15s message="GREETINGS TO ALL CODERS" speed=1.5
+//	transition dissolve
+//	cube 8s
+//
+// Settings are keys of the demo's entry in the settings file. Values are
+// JSON, such as numbers, true and "quoted strings", and a bare word is taken
+// as a string. A "transition" line picks the effect into the scene after
+// it: crossfade, wipe, dissolve, random or none.
+package timeline
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yourusername/bubbletea-showcase/common/transition"
+)
+
+// Scene is one demo's part of a script.
+type Scene struct {
+	Demo     string
+	Duration time.Duration
+	// Settings is a JSON object applied over the demo's settings, or nil.
+	Settings json.RawMessage
+	// Transition is the effect into the scene, as transition.ParseEffect or
+	// "random" take it, or "" for the player's own.
+	Transition string
+	// Line is the scene's line in the script, for errors about it.
+	Line int
+}
+
+// Script is a parsed script, its scenes in order.
+type Script struct {
+	Scenes []Scene
+}
+
+// Duration is the time of every scene together.
+func (s Script) Duration() time.Duration {
+	var total time.Duration
+	for _, scene := range s.Scenes {
+		total += scene.Duration
+	}
+	return total
+}
+
+// Load reads the script at path. Errors name the file and line.
+func Load(path string) (Script, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Script{}, err
+	}
+	defer f.Close()
+	s, err := Parse(f)
+	if err != nil {
+		return Script{}, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	return s, nil
+}
+
+// Parse reads a script. Errors name the line.
+func Parse(r io.Reader) (Script, error) {
+	var s Script
+	var cut string
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		words, err := split(scanner.Text())
+		if err != nil {
+			return Script{}, fmt.Errorf("line %d: %w", n, err)
+		}
+		if len(words) == 0 {
+			continue
+		}
+		if words[0] == "transition" {
+			if cut, err = effect(words[1:]); err != nil {
+				return Script{}, fmt.Errorf("line %d: %w", n, err)
+			}
+			continue
+		}
+		scene, err := parseScene(words)
+		if err != nil {
+			return Script{}, fmt.Errorf("line %d: %w", n, err)
+		}
+		scene.Transition, scene.Line = cut, n
+		cut = ""
+		s.Scenes = append(s.Scenes, scene)
+	}
+	if err := scanner.Err(); err != nil {
+		return Script{}, err
+	}
+	if len(s.Scenes) == 0 {
+		return Script{}, errors.New("no scenes")
+	}
+	return s, nil
+}
+
+// effect checks the words after "transition".
+func effect(words []string) (string, error) {
+	if len(words) != 1 {
+		return "", errors.New("transition takes one effect")
+	}
+	name := strings.ToLower(words[0])
+	if name == "random" {
+		return name, nil
+	}
+	if _, err := transition.ParseEffect(name); err != nil {
+		return "", fmt.Errorf("%v, random", err)
+	}
+	return name, nil
+}
+
+func parseScene(words []string) (Scene, error) {
+	if len(words) < 2 {
+		return Scene{}, fmt.Errorf("scene %q needs a time, such as 10s", words[0])
+	}
+	d, err := time.ParseDuration(words[1])
+	if err != nil || d <= 0 {
+		return Scene{}, fmt.Errorf("scene %q: %q is not a time, such as 10s or 1m30s", words[0], words[1])
+	}
+	scene := Scene{Demo: words[0], Duration: d}
+	if len(words) == 2 {
+		return scene, nil
+	}
+
+	settings := map[string]json.RawMessage{}
+	for _, word := range words[2:] {
+		key, value, ok := strings.Cut(word, "=")
+		if !ok || key == "" {
+			return Scene{}, fmt.Errorf("scene %q: %q is not a setting, such as speed=2", words[0], word)
+		}
+		settings[key] = jsonValue(value)
+	}
+	if scene.Settings, err = json.Marshal(settings); err != nil {
+		return Scene{}, err
+	}
+	return scene, nil
+}
+
+// jsonValue returns value as it is if it is JSON, or else as a string.
+func jsonValue(value string) json.RawMessage {
+	if json.Valid([]byte(value)) {
+		return json.RawMessage(value)
+	}
+	quoted, _ := json.Marshal(value)
+	return quoted
+}
+
+// split breaks a line into words at spaces, keeping double-quoted text
+// together, quotes and all, and dropping a # comment.
+func split(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	quoted, escaped := false, false
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && r == '#':
+			flush()
+			return words, nil
+		case !quoted && (r == ' ' || r == '\t'):
+			flush()
+			continue
+		}
+		word.WriteRune(r)
+	}
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+	flush()
+	return words, nil
+}
@@ -0,0 +1,100 @@
+package timeline
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	script := `# A show
+plasma 10s palette=fire
+
+transition wipe
+scroller 1m30s message="GREETINGS TO ALL \"CODERS\" # not a comment" speed=1.5
+transition RANDOM   # picked each time
+cube 8s  # the end
+`
+	s, err := Parse(strings.NewReader(script))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Scene{
+		{Demo: "plasma", Duration: 10 * time.Second, Settings: []byte(`{"palette":"fire"}`), Line: 2},
+		{Demo: "scroller", Duration: 90 * time.Second, Settings: []byte(`{"message":"GREETINGS TO ALL \"CODERS\" # not a comment","speed":1.5}`), Transition: "wipe", Line: 5},
+		{Demo: "cube", Duration: 8 * time.Second, Transition: "random", Line: 7},
+	}
+	if !reflect.DeepEqual(s.Scenes, want) {
+		t.Errorf("scenes are\n%+v\nwant\n%+v", s.Scenes, want)
+	}
+	for i := range want {
+		if got := string(s.Scenes[i].Settings); got != string(want[i].Settings) {
+			t.Errorf("scene %d settings %s, want %s", i+1, got, want[i].Settings)
+		}
+	}
+	if d := s.Duration(); d != 108*time.Second {
+		t.Errorf("Duration() = %v, want 1m48s", d)
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	tests := []struct {
+		line, want string
+	}{
+		{"a 1s n=2", `{"n":2}`},
+		{"a 1s on=true", `{"on":true}`},
+		{"a 1s name=fire", `{"name":"fire"}`},
+		{`a 1s name="two words"`, `{"name":"two words"}`},
+		{"a 1s list=[1,2]", `{"list":[1,2]}`},
+		{"a 1s empty=", `{"empty":""}`},
+	}
+	for _, tt := range tests {
+		s, err := Parse(strings.NewReader(tt.line))
+		if err != nil {
+			t.Errorf("%s: %v", tt.line, err)
+			continue
+		}
+		if got := string(s.Scenes[0].Settings); got != tt.want {
+			t.Errorf("%s: settings %s, want %s", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		script, want string
+	}{
+		{"", "no scenes"},
+		{"# only a comment\n\n", "no scenes"},
+		{"plasma", `line 1: scene "plasma" needs a time, such as 10s`},
+		{"plasma 10s\nplasma line", `line 2: scene "plasma": "line" is not a time, such as 10s or 1m30s`},
+		{"plasma 0s", `line 1: scene "plasma": "0s" is not a time`},
+		{"plasma -5s", `line 1: scene "plasma": "-5s" is not a time`},
+		{"plasma 10s speed", `line 1: scene "plasma": "speed" is not a setting, such as speed=2`},
+		{"plasma 10s =2", `line 1: scene "plasma": "=2" is not a setting`},
+		{`plasma 10s message="HELLO`, "line 1: unterminated quote"},
+		{"plasma 10s\ntransition", "line 2: transition takes one effect"},
+		{"plasma 10s\ntransition wipe dissolve", "line 2: transition takes one effect"},
+		{"plasma 10s\n\ntransition swirl", `line 3: unknown transition "swirl"; use none, crossfade, wipe, dissolve, random`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.script))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Parse(%q) = %v, want %q", tt.script, err, tt.want)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "show.timeline")
+	if err := os.WriteFile(path, []byte("plasma 10s\nplasma soon\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Load(path)
+	if want := `show.timeline: line 2: scene "plasma": "soon" is not a time`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Load = %v, want %q", err, want)
+	}
+}
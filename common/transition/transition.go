@@ -0,0 +1,252 @@
+// Package transition blends one rendered frame into another over a few
+// hundred milliseconds, the way the showcase cuts between its menu and the
+// demos. Both frames are parsed back into cells with the raster package and
+// drawn as two layers of a compose stack, the old frame above the new one,
+// with the effect deciding cell by cell how much of the old one is left:
+//
+//	p := transition.New(lastFrame, transition.Wipe)
+//	cmd := p.Start() // in Init
+//	...
+//	if cmd, ok := p.Update(msg); ok { return m, cmd } // in Update
+//	...
+//	return p.View(view, width, height) // in View
+package transition
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/compose"
+	"github.com/yourusername/bubbletea-showcase/common/raster"
+)
+
+// Effect is a way of going from one frame to the next.
+type Effect int
+
+const (
+	// None cuts straight to the new frame.
+	None Effect = iota
+	// Crossfade blends the colors of the old frame into the new one.
+	Crossfade
+	// Wipe sweeps the new frame in from the left.
+	Wipe
+	// Dissolve swaps cells over one by one in a scattered order.
+	Dissolve
+)
+
+// Effects lists every effect but None.
+var Effects = []Effect{Crossfade, Wipe, Dissolve}
+
+var effectNames = []string{"none", "crossfade", "wipe", "dissolve"}
+
+func (e Effect) String() string {
+	if e < 0 || int(e) >= len(effectNames) {
+		return fmt.Sprintf("Effect(%d)", int(e))
+	}
+	return effectNames[e]
+}
+
+// ParseEffect returns the effect named as by String.
+func ParseEffect(name string) (Effect, error) {
+	for i, n := range effectNames {
+		if strings.EqualFold(name, n) {
+			return Effect(i), nil
+		}
+	}
+	return None, fmt.Errorf("unknown transition %q; use %s", name, strings.Join(effectNames, ", "))
+}
+
+// Duration is how long a transition takes, and FPS how often it redraws.
+const (
+	Duration = 400 * time.Millisecond
+	FPS      = 30
+)
+
+// scene is a transition at one moment: the frames parsed to the same size
+// and how far along it is, from 0 to 1.
+type scene struct {
+	from, to *raster.Screen
+	effect   Effect
+	t        float64
+}
+
+// layers draws the new frame, then whatever is left of the old one over it.
+var layers = func() *compose.Stack[*scene] {
+	s := compose.New[*scene]()
+	s.Add("to", 0, compose.Func[*scene]((*scene).drawTo))
+	s.Add("from", 10, compose.Func[*scene]((*scene).drawFrom))
+	return s
+}()
+
+func (s *scene) drawTo(c *canvas.Canvas) {
+	draw(c, s.to, func(x, y int) bool { return true })
+}
+
+func (s *scene) drawFrom(c *canvas.Canvas) {
+	switch s.effect {
+	case Crossfade:
+		s.crossfade(c)
+	case Wipe:
+		edge := int(s.t * float64(s.from.Cols))
+		draw(c, s.from, func(x, y int) bool { return x >= edge })
+	case Dissolve:
+		draw(c, s.from, func(x, y int) bool { return scatter(x, y) >= s.t })
+	}
+}
+
+// crossfade blends each cell's colors, showing the old character for the
+// first half and the new one for the second.
+func (s *scene) crossfade(c *canvas.Canvas) {
+	for y := 0; y < s.to.Rows; y++ {
+		for x := 0; x < s.to.Cols; x++ {
+			from, to := s.from.At(x, y), s.to.At(x, y)
+			cell := from
+			if s.t >= 0.5 {
+				cell = to
+			}
+			if cell.Rune == 0 {
+				continue
+			}
+			cell.Fg = lerp(from.Fg, to.Fg, s.t)
+			cell.Bg = lerp(from.Bg, to.Bg, s.t)
+			c.Set(x, y, cell.Rune, style(cell))
+		}
+	}
+}
+
+// draw copies the cells of screen that keep says to onto c.
+func draw(c *canvas.Canvas, screen *raster.Screen, keep func(x, y int) bool) {
+	for y := 0; y < screen.Rows; y++ {
+		for x := 0; x < screen.Cols; x++ {
+			cell := screen.At(x, y)
+			// The right half of a wide character comes with its left
+			if cell.Rune == 0 || !keep(x, y) {
+				continue
+			}
+			c.Set(x, y, cell.Rune, style(cell))
+		}
+	}
+}
+
+// style turns a parsed cell's colors back into a canvas style, leaving the
+// terminal's default colors unset so they stay the terminal's own.
+func style(cell raster.Cell) canvas.Style {
+	s := canvas.Style{Bold: cell.Bold}
+	if cell.Fg != raster.DefaultFg {
+		s.Fg = hex(cell.Fg)
+	}
+	if cell.Bg != raster.DefaultBg {
+		s.Bg = hex(cell.Bg)
+	}
+	return s
+}
+
+func hex(c color.RGBA) lipgloss.Color {
+	return common.RGB{R: c.R, G: c.G, B: c.B}.Color()
+}
+
+func lerp(a, b color.RGBA, t float64) color.RGBA {
+	c := common.LerpRGB(common.RGB{R: a.R, G: a.G, B: a.B}, common.RGB{R: b.R, G: b.G, B: b.B}, t)
+	return color.RGBA{R: c.R, G: c.G, B: c.B, A: 0xFF}
+}
+
+// scatter gives each cell a fixed value in [0, 1), the moment it dissolves,
+// from an integer hash of its position.
+func scatter(x, y int) float64 {
+	h := uint32(x)*374761393 + uint32(y)*668265263
+	h = (h ^ h>>13) * 1274126177
+	h ^= h >> 16
+	return float64(h&0xFFFF) / 0x10000
+}
+
+// Frame draws from turning into to, t of the way through, on a width by
+// height canvas.
+func Frame(from, to string, effect Effect, t float64, width, height int) string {
+	s := &scene{
+		from:   raster.Parse(from, width, height),
+		to:     raster.Parse(to, width, height),
+		effect: effect,
+		t:      t,
+	}
+	return layers.Render(canvas.New(width, height), s)
+}
+
+// Player plays a transition from a frame over the first frames of a model's
+// view. The zero Player, and one that has finished, shows the view as is.
+type Player struct {
+	from   string
+	effect Effect
+	id     int
+	start  time.Time
+	t      float64
+}
+
+type tickMsg struct {
+	id   int
+	time time.Time
+}
+
+var nextID int
+
+// New returns a Player for a transition from the frame, which starts with
+// Start.
+func New(from string, effect Effect) *Player {
+	nextID++
+	return &Player{from: from, effect: effect, id: nextID}
+}
+
+// Start begins the transition and returns the command that times it.
+func (p *Player) Start() tea.Cmd {
+	if p == nil || p.effect == None {
+		return nil
+	}
+	p.start = time.Now()
+	return p.tick()
+}
+
+func (p *Player) tick() tea.Cmd {
+	id := p.id
+	return tea.Tick(time.Second/FPS, func(t time.Time) tea.Msg {
+		return tickMsg{id: id, time: t}
+	})
+}
+
+// Update moves the transition on. It reports whether msg was the Player's,
+// which the model should not handle itself.
+func (p *Player) Update(msg tea.Msg) (tea.Cmd, bool) {
+	tick, ok := msg.(tickMsg)
+	if !ok || p == nil || tick.id != p.id {
+		return nil, ok
+	}
+	p.t = float64(tick.time.Sub(p.start)) / float64(Duration)
+	if p.t >= 1 {
+		p.from = ""
+		return nil, true
+	}
+	return p.tick(), true
+}
+
+// Active reports whether the transition is still playing.
+func (p *Player) Active() bool {
+	return p != nil && p.effect != None && p.from != ""
+}
+
+// View draws the transition into view, or view itself once it is over.
+func (p *Player) View(view string, width, height int) string {
+	if !p.Active() || width <= 0 || height <= 0 {
+		return view
+	}
+	return Frame(p.from, view, p.effect, ease(p.t), width, height)
+}
+
+// ease starts and ends the transition gently.
+func ease(t float64) float64 {
+	t = common.Clamp(t, 0, 1)
+	return t * t * (3 - 2*t)
+}
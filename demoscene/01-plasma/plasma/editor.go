@@ -0,0 +1,229 @@
+package plasma
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/palette"
+)
+
+// editor is the open palette editor: the color stops of a gradient, one of
+// them selected. The plasma is drawn with it while it is open, and saving
+// writes it to the palette registry as a new user palette.
+type editor struct {
+	stops    []common.HSL
+	selected int
+	colors   []string // the stops as hex, sampled by color
+}
+
+// maxStops is the most stops a palette can be given in the editor.
+const maxStops = 12
+
+type editKeyMap struct {
+	Prev   key.Binding
+	Next   key.Binding
+	Light  key.Binding
+	Darker key.Binding
+	Hue    key.Binding
+	HueUp  key.Binding
+	Sat    key.Binding
+	SatUp  key.Binding
+	Add    key.Binding
+	Remove key.Binding
+	Save   key.Binding
+	Cancel key.Binding
+	Pause  key.Binding
+	Quit   key.Binding
+	Help   key.Binding
+}
+
+var editKeys = editKeyMap{
+	Prev:   keymap.New("←→", "select stop", "left"),
+	Next:   keymap.Hidden("right"),
+	Light:  keymap.New("↑↓", "lightness", "up"),
+	Darker: keymap.Hidden("down"),
+	Hue:    keymap.New("h/H", "hue", "h"),
+	HueUp:  keymap.Hidden("H"),
+	Sat:    keymap.New("s/S", "saturation", "s"),
+	SatUp:  keymap.Hidden("S"),
+	Add:    keymap.New("a", "add stop"),
+	Remove: keymap.New("d", "delete stop"),
+	Save:   keymap.New("enter", "save"),
+	Cancel: keymap.New("esc", "cancel"),
+	Pause:  keymap.Pause(),
+	Quit:   keymap.New("ctrl+c", "quit"),
+	Help:   keymap.Help(),
+}
+
+// newEditor opens the editor on a gradient of hex colors.
+func newEditor(colors []string) *editor {
+	e := &editor{}
+	for _, c := range colors {
+		e.stops = append(e.stops, common.ParseHex(c).HSL())
+	}
+	e.sync()
+	return e
+}
+
+// sync refreshes the hex colors after the stops change.
+func (e *editor) sync() {
+	e.colors = e.colors[:0]
+	for _, s := range e.stops {
+		e.colors = append(e.colors, s.Hex())
+	}
+}
+
+// paletteColors returns the current palette as a gradient to edit. The
+// psychedelic palette is a sweep of hues rather than a gradient, so it is
+// taken at seven points of the sweep.
+func (m model) paletteColors() []string {
+	switch m.palette {
+	case 0:
+		return fireGradient
+	case 1:
+		return oceanGradient
+	case 2:
+		var colors []string
+		for i := 0; i <= 6; i++ {
+			colors = append(colors, string(m.getPsychedelicColor(float64(i)/6)))
+		}
+		return colors
+	case 3:
+		return []string{"#000000", "#FFFFFF"}
+	}
+	return m.extra[m.palette-len(builtinPalettes)].Colors
+}
+
+// editKey handles keys while the editor is open. Each change to the stops
+// shows in the plasma at once.
+func (m model) editKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	e := *m.edit
+	e.stops = append([]common.HSL(nil), e.stops...)
+	e.colors = nil
+	stop := &e.stops[e.selected]
+	switch {
+	case key.Matches(msg, editKeys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, editKeys.Cancel):
+		m.edit = nil
+		return m, nil
+	case key.Matches(msg, editKeys.Save):
+		return m.savePalette(), nil
+	case key.Matches(msg, editKeys.Pause):
+		m.anim.Toggle()
+	case key.Matches(msg, editKeys.Prev):
+		e.selected = max(e.selected-1, 0)
+	case key.Matches(msg, editKeys.Next):
+		e.selected = min(e.selected+1, len(e.stops)-1)
+	case key.Matches(msg, editKeys.Light):
+		stop.L = common.Clamp(stop.L+0.05, 0, 1)
+	case key.Matches(msg, editKeys.Darker):
+		stop.L = common.Clamp(stop.L-0.05, 0, 1)
+	case key.Matches(msg, editKeys.Hue):
+		stop.H = mod360(stop.H - 10)
+	case key.Matches(msg, editKeys.HueUp):
+		stop.H = mod360(stop.H + 10)
+	case key.Matches(msg, editKeys.Sat):
+		stop.S = common.Clamp(stop.S-0.05, 0, 1)
+	case key.Matches(msg, editKeys.SatUp):
+		stop.S = common.Clamp(stop.S+0.05, 0, 1)
+	case key.Matches(msg, editKeys.Add):
+		if len(e.stops) < maxStops {
+			// Halfway to the next stop, or to the one before at the end
+			other := e.selected + 1
+			if other == len(e.stops) {
+				other = e.selected - 1
+			}
+			mid := common.LerpRGB(e.stops[e.selected].RGB(), e.stops[other].RGB(), 0.5).HSL()
+			at := max(e.selected, other)
+			e.stops = append(e.stops[:at], append([]common.HSL{mid}, e.stops[at:]...)...)
+			e.selected = at
+		}
+	case key.Matches(msg, editKeys.Remove):
+		if len(e.stops) > 2 {
+			e.stops = append(e.stops[:e.selected], e.stops[e.selected+1:]...)
+			e.selected = min(e.selected, len(e.stops)-1)
+		}
+	}
+	e.sync()
+	m.edit = &e
+	return m, nil
+}
+
+// mod360 wraps a hue into [0, 360).
+func mod360(h float64) float64 {
+	for h < 0 {
+		h += 360
+	}
+	for h >= 360 {
+		h -= 360
+	}
+	return h
+}
+
+// savePalette saves the edited gradient as a new user palette, named Custom
+// and the first number free, and switches to it.
+func (m model) savePalette() model {
+	taken := map[string]bool{}
+	for _, p := range m.extra {
+		taken[strings.ToLower(p.Name)] = true
+	}
+	for n := 1; ; n++ {
+		name := fmt.Sprintf("Custom %d", n)
+		if taken[strings.ToLower(name)] {
+			continue
+		}
+		_, err := palette.Save(palette.Palette{Name: name, Colors: m.edit.colors})
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			m.note = "Not saved: " + err.Error()
+			return m
+		}
+		m.extra, _ = palette.All()
+		for i, p := range m.extra {
+			if p.Name == name {
+				m.palette = len(builtinPalettes) + i
+			}
+		}
+		m.edit = nil
+		m.note = "Saved as " + name
+		return m
+	}
+}
+
+// view draws the stops as swatches, the selected one in brackets,
+// followed by the gradient they make.
+func (e *editor) view(width int) string {
+	var b strings.Builder
+	for i, c := range e.colors {
+		swatch := lipgloss.NewStyle().Foreground(lipgloss.Color(c)).Render("██")
+		if i == e.selected {
+			b.WriteString("[" + swatch + "]")
+		} else {
+			b.WriteString(" " + swatch + " ")
+		}
+	}
+	b.WriteString("  ")
+	bar := common.Clamp(float64(width-lipgloss.Width(b.String())), 0, 40)
+	for x := 0; x < int(bar); x++ {
+		c := common.Sample(e.colors, float64(x)/max(bar-1, 1))
+		b.WriteString(lipgloss.NewStyle().Foreground(c).Render("█"))
+	}
+	return b.String()
+}
+
+// status describes the selected stop.
+func (e *editor) status() string {
+	s := e.stops[e.selected]
+	return fmt.Sprintf("Editing palette | Stop %d of %d: %s | H %.0f° S %.2f L %.2f",
+		e.selected+1, len(e.stops), e.colors[e.selected], s.H, s.S, s.L)
+}
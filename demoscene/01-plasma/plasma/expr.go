@@ -0,0 +1,201 @@
+package plasma
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// fieldFunc is a plasma formula: the field at (x, y), in field units from 0
+// to 16, at time t.
+type fieldFunc func(x, y, t float64) float64
+
+// The expression language of custom formulas is arithmetic on numbers and
+// the variables below, with + - * / and ^ for powers, brackets, and the
+// functions sin, cos, sqrt and abs:
+//
+//	sin(x*0.5 + t) + sin(r - t*2)
+var (
+	exprVars = map[string]fieldFunc{
+		"x":  func(x, y, t float64) float64 { return x },
+		"y":  func(x, y, t float64) float64 { return y },
+		"t":  func(x, y, t float64) float64 { return t },
+		"r":  func(x, y, t float64) float64 { return math.Hypot(x-8, y-8) }, // from the middle
+		"pi": func(x, y, t float64) float64 { return math.Pi },
+	}
+	exprFuncs = map[string]func(float64) float64{
+		"sin":  math.Sin,
+		"cos":  math.Cos,
+		"sqrt": math.Sqrt,
+		"abs":  math.Abs,
+	}
+)
+
+// parseExpr compiles a custom formula. Errors give the column they were
+// found at, counting from 1.
+func parseExpr(src string) (fieldFunc, error) {
+	p := &exprParser{src: src}
+	p.next()
+	f, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "" {
+		return nil, p.errorf("unexpected %q", p.tok)
+	}
+	return f, nil
+}
+
+// exprParser reads an expression a token at a time, by recursive descent.
+type exprParser struct {
+	src string
+	pos int    // the byte after tok
+	tok string // the current token, empty at the end
+	at  int    // where tok starts
+}
+
+// next moves to the following token: a number, a name or one character.
+func (p *exprParser) next() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+	p.at = p.pos
+	if p.pos == len(p.src) {
+		p.tok = ""
+		return
+	}
+	c := rune(p.src[p.pos])
+	switch {
+	case unicode.IsDigit(c) || c == '.':
+		for p.pos < len(p.src) && (unicode.IsDigit(rune(p.src[p.pos])) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+	case unicode.IsLetter(c):
+		for p.pos < len(p.src) && unicode.IsLetter(rune(p.src[p.pos])) {
+			p.pos++
+		}
+	default:
+		p.pos++
+	}
+	p.tok = p.src[p.at:p.pos]
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("column %d: %s", p.at+1, fmt.Sprintf(format, args...))
+}
+
+// sum is terms joined by + and -.
+func (p *exprParser) sum() (fieldFunc, error) {
+	f, err := p.product()
+	for err == nil && (p.tok == "+" || p.tok == "-") {
+		op := p.tok
+		p.next()
+		var g fieldFunc
+		if g, err = p.product(); err != nil {
+			break
+		}
+		a := f
+		if op == "+" {
+			f = func(x, y, t float64) float64 { return a(x, y, t) + g(x, y, t) }
+		} else {
+			f = func(x, y, t float64) float64 { return a(x, y, t) - g(x, y, t) }
+		}
+	}
+	return f, err
+}
+
+// product is factors joined by * and /.
+func (p *exprParser) product() (fieldFunc, error) {
+	f, err := p.unary()
+	for err == nil && (p.tok == "*" || p.tok == "/") {
+		op := p.tok
+		p.next()
+		var g fieldFunc
+		if g, err = p.unary(); err != nil {
+			break
+		}
+		a := f
+		if op == "*" {
+			f = func(x, y, t float64) float64 { return a(x, y, t) * g(x, y, t) }
+		} else {
+			f = func(x, y, t float64) float64 { return a(x, y, t) / g(x, y, t) }
+		}
+	}
+	return f, err
+}
+
+// unary is a factor with any number of minus signs before it.
+func (p *exprParser) unary() (fieldFunc, error) {
+	if p.tok != "-" {
+		return p.power()
+	}
+	p.next()
+	f, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y, t float64) float64 { return -f(x, y, t) }, nil
+}
+
+// power is an atom, raised to a power with ^, which binds to the right.
+func (p *exprParser) power() (fieldFunc, error) {
+	f, err := p.atom()
+	if err != nil || p.tok != "^" {
+		return f, err
+	}
+	p.next()
+	g, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return func(x, y, t float64) float64 { return math.Pow(f(x, y, t), g(x, y, t)) }, nil
+}
+
+// atom is a number, a variable, a function call or a bracketed sum.
+func (p *exprParser) atom() (fieldFunc, error) {
+	tok := p.tok
+	switch {
+	case tok == "":
+		return nil, p.errorf("expression ends too soon")
+	case tok == "(":
+		p.next()
+		f, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != ")" {
+			return nil, p.errorf("missing )")
+		}
+		p.next()
+		return f, nil
+	case unicode.IsDigit(rune(tok[0])) || tok[0] == '.':
+		v, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, p.errorf("bad number %q", tok)
+		}
+		p.next()
+		return func(x, y, t float64) float64 { return v }, nil
+	case unicode.IsLetter(rune(tok[0])):
+		name := strings.ToLower(tok)
+		if v, ok := exprVars[name]; ok {
+			p.next()
+			return v, nil
+		}
+		fn, ok := exprFuncs[name]
+		if !ok {
+			return nil, p.errorf("unknown name %q", tok)
+		}
+		p.next()
+		if p.tok != "(" {
+			return nil, p.errorf("%s needs (", name)
+		}
+		arg, err := p.atom()
+		if err != nil {
+			return nil, err
+		}
+		return func(x, y, t float64) float64 { return fn(arg(x, y, t)) }, nil
+	}
+	return nil, p.errorf("unexpected %q", tok)
+}
@@ -0,0 +1,90 @@
+package plasma
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestExprValues(t *testing.T) {
+	tests := []struct {
+		src     string
+		x, y, t float64
+		want    float64
+	}{
+		{"1 + 2*3", 0, 0, 0, 7},
+		{"(1 + 2) * 3", 0, 0, 0, 9},
+		{"8 / 4 / 2", 0, 0, 0, 1},
+		{"10 - 4 - 3", 0, 0, 0, 3},
+		{"2^3^2", 0, 0, 0, 512},
+		{"-2^2", 0, 0, 0, -4},
+		{"2 * -3", 0, 0, 0, -6},
+		{"--3", 0, 0, 0, 3},
+		{"2^-1", 0, 0, 0, 0.5},
+		{"x + y*t", 1, 2, 3, 7},
+		{"X + Y", 1, 2, 0, 3},
+		{"r", 11, 12, 0, 5},
+		{"cos(pi)", 0, 0, 0, -1},
+		{"sqrt(x) * abs(y)", 9, -2, 0, 6},
+		{"sin(x)^2 + cos(x)^2", 1.3, 0, 0, 1},
+		{".5 + 1.25", 0, 0, 0, 1.75},
+	}
+	for _, tt := range tests {
+		f, err := parseExpr(tt.src)
+		if err != nil {
+			t.Errorf("parseExpr(%q): %v", tt.src, err)
+			continue
+		}
+		if got := f(tt.x, tt.y, tt.t); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%q at (%g, %g, %g) = %g, want %g", tt.src, tt.x, tt.y, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestExprErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string // in the error
+	}{
+		{"", "column 1: expression ends too soon"},
+		{"1 +", "column 4: expression ends too soon"},
+		{"z", `column 1: unknown name "z"`},
+		{"tan(x)", `column 1: unknown name "tan"`},
+		{"sin x", "column 5: sin needs ("},
+		{"(1 + 2", "column 7: missing )"},
+		{"1 + 2)", `column 6: unexpected ")"`},
+		{"1 2", `column 3: unexpected "2"`},
+		{"1..2", `column 1: bad number "1..2"`},
+		{"x * $", `column 5: unexpected "$"`},
+	}
+	for _, tt := range tests {
+		_, err := parseExpr(tt.src)
+		if err == nil {
+			t.Errorf("parseExpr(%q) succeeded, want an error", tt.src)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("parseExpr(%q) = %q, want %q", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestExprWithoutValue(t *testing.T) {
+	t.Setenv("SHOWCASE_SETTINGS", "off")
+	for _, src := range []string{"sqrt(x-8)", "1/x", "sqrt(-1)", "1/0 - 1/0"} {
+		f, err := parseExpr(src)
+		if err != nil {
+			t.Fatalf("parseExpr(%q): %v", src, err)
+		}
+		m := initialModel()
+		m.custom, m.expr = f, src
+		m.formula = len(formulas)
+		for _, p := range [][2]float64{{0, 0}, {4, 4}, {15, 9}} {
+			if v := m.field(p[0], p[1], 1); math.IsNaN(v) || v < -1 || v > 1 {
+				t.Errorf("%q at %v = %g, want -1 to 1", src, p, v)
+			}
+		}
+		// Drawing the whole screen must not fall over where it has no value
+		m.View()
+	}
+}
@@ -0,0 +1,219 @@
+package plasma
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/noise"
+)
+
+// formula is one of the fields the plasma can draw. Each gives values from
+// -1 to 1, which the palette is looked up with.
+type formula struct {
+	name   string
+	fn     fieldFunc
+	period float64 // how long until it repeats, or 0 if it never does
+}
+
+// formulas are the built-in fields, cycled with f. A custom expression,
+// once typed, comes after them.
+var formulas = []formula{
+	// Every speed in the sine formulas is a multiple of 0.1, so each sine
+	// has come round a whole number of times after 2π/0.1
+	{"Classic", classic, 20 * math.Pi},
+	{"Radial", radial, 20 * math.Pi},
+	// After 64 the scroll has moved 5·256 columns and 3·256 rows
+	{"XOR", xor, 64},
+	{"Turbulent", turbulent, 0},
+}
+
+// customFormula is the name of the typed expression among the formulas.
+const customFormula = "Custom"
+
+// classic is five sine waves running in different directions at different
+// speeds, averaged.
+func classic(x, y, t float64) float64 {
+	return (math.Sin(x*0.5+t) +
+		math.Sin(y*0.3+t*1.2) +
+		math.Sin((x+y)*0.25+t*0.8) +
+		math.Sin(math.Sqrt(x*x+y*y)*0.4+t*1.5) +
+		math.Sin(x*0.1+y*0.2+t*0.6)) / 5
+}
+
+// radial is the rings spreading from two centers that circle the field,
+// crossing where they meet.
+func radial(x, y, t float64) float64 {
+	x1, y1 := 8+5*math.Sin(t*0.5), 8+5*math.Cos(t*0.7)
+	x2, y2 := 8+6*math.Cos(t*0.4), 8+4*math.Sin(t*0.6)
+	return (math.Sin(math.Hypot(x-x1, y-y1)*0.8-t*2) +
+		math.Sin(math.Hypot(x-x2, y-y2)*0.6-t*1.5)) / 2
+}
+
+// xor is the XOR texture: the bits of the column and row numbers, each
+// scrolling at its own speed, XORed together.
+func xor(x, y, t float64) float64 {
+	a := uint8(int(x*16 + t*20))
+	b := uint8(int(y*16 + t*12))
+	return float64(a^b)/127.5 - 1
+}
+
+// turbulent is marble: sine bands bent out of line by turbulence, octaves of
+// the absolute value of noise, which drifts with time.
+func turbulent(x, y, t float64) float64 {
+	turbulence := noise.FBM3(func(x, y, z float64) float64 {
+		return math.Abs(noise.Simplex3(x, y, z))
+	}, x*0.15, y*0.15, t*0.2, 4, 2, 0.5)
+	return math.Sin(x*0.3 + y*0.2 + turbulence*3 + t)
+}
+
+// bounce folds any value back into -1 to 1, as a triangle wave, so a typed
+// expression of any range keeps to the palette without a seam.
+func bounce(v float64) float64 {
+	v = math.Mod(v+1, 4)
+	if v < 0 {
+		v += 4
+	}
+	if v > 2 {
+		v = 4 - v
+	}
+	return v - 1
+}
+
+// formulaNames lists the formulas in the order f cycles them.
+func (m model) formulaNames() []string {
+	var names []string
+	for _, f := range formulas {
+		names = append(names, f.name)
+	}
+	if m.custom != nil {
+		names = append(names, customFormula)
+	}
+	return names
+}
+
+// field is the current formula at (x, y) in field units, at time t. Where a
+// typed formula has no value, as sqrt(-1) or 1/0, the field is 0.
+func (m model) field(x, y, t float64) float64 {
+	if m.formula < len(formulas) {
+		return formulas[m.formula].fn(x, y, t)
+	}
+	v := m.custom(x, y, t)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0
+	}
+	return bounce(v)
+}
+
+// formulaByName returns the index of the named formula, or 0 for Classic.
+func (m model) formulaByName(name string) int {
+	for i, n := range m.formulaNames() {
+		if strings.EqualFold(n, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+type exprKeyMap struct {
+	Apply  key.Binding
+	Cancel key.Binding
+	Taken  key.Binding
+	Quit   key.Binding
+	Help   key.Binding
+}
+
+// While an expression is typed, + and - are text rather than the frame
+// rate, and help is on F1.
+var exprKeys = exprKeyMap{
+	Apply:  keymap.New("enter", "apply"),
+	Cancel: keymap.New("esc", "cancel"),
+	Taken:  keymap.Hidden("+", "-"),
+	Quit:   keymap.New("ctrl+c", "quit"),
+	Help:   keymap.New("F1", "help", "f1"),
+}
+
+// typing is the expression being typed, drawn with as it is typed whenever
+// it parses.
+type typing struct {
+	input textinput.Model
+	err   string
+	// The formula to go back to on esc
+	formula int
+	custom  fieldFunc
+	expr    string
+}
+
+// defaultExpr is offered the first time an expression is typed.
+const defaultExpr = "sin(x*0.5 + t) + sin(r - t*2)"
+
+// openExpr starts typing an expression, from the last one typed.
+func (m model) openExpr() model {
+	in := textinput.New()
+	in.Prompt = "f(x, y, t, r) = "
+	in.CharLimit = 200
+	in.Width = max(20, m.width-lipgloss.Width(in.Prompt)-1)
+	in.Cursor.SetMode(cursor.CursorStatic)
+	in.SetValue(m.expr)
+	if m.expr == "" {
+		in.SetValue(defaultExpr)
+	}
+	in.Focus()
+	m.typing = &typing{input: in, formula: m.formula, custom: m.custom, expr: m.expr}
+	return m.tryExpr()
+}
+
+// tryExpr draws with the typed expression if it parses, and otherwise keeps
+// the last one that did and shows what is wrong.
+func (m model) tryExpr() model {
+	tp := *m.typing
+	src := strings.TrimSpace(tp.input.Value())
+	f, err := parseExpr(src)
+	if err != nil {
+		tp.err = err.Error()
+	} else {
+		tp.err = ""
+		m.custom, m.expr = f, src
+		m.formula = len(formulas)
+	}
+	m.typing = &tp
+	return m
+}
+
+// exprKey handles keys while an expression is typed.
+func (m model) exprKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, exprKeys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, exprKeys.Cancel):
+		tp := m.typing
+		m.formula, m.custom, m.expr = tp.formula, tp.custom, tp.expr
+		m.typing = nil
+		return m, nil
+	case key.Matches(msg, exprKeys.Apply):
+		if m.typing.err == "" {
+			m.typing = nil
+		}
+		return m, nil
+	}
+	tp := *m.typing
+	var cmd tea.Cmd
+	tp.input, cmd = tp.input.Update(msg)
+	m.typing = &tp
+	return m.tryExpr(), cmd
+}
+
+// status is what is wrong with the expression, or else how to write one.
+func (t *typing) status() string {
+	if t.err != "" {
+		return lipgloss.NewStyle().Foreground(common.Red).Render(t.err)
+	}
+	return lipgloss.NewStyle().Foreground(common.Cyan).
+		Render("Use x, y, t, r and pi, with sin, cos, sqrt, abs and + - * / ^")
+}
@@ -0,0 +1,93 @@
+package plasma
+
+import (
+	"math"
+	"strings"
+)
+
+// maxLayers is how many fields the plasma can blend at once.
+const maxLayers = 3
+
+// layer is one of the blended fields, running on its own clock at its own
+// speed relative to the plasma's.
+type layer struct {
+	speed float64
+	time  float64
+}
+
+// layerShapes turn and stretch each layer's field about the middle, so the
+// same formula gives each layer different bands.
+var layerShapes = [maxLayers]struct{ angle, scale float64 }{
+	{0, 1},
+	{math.Pi / 3, 1.3},
+	{-0.7, 0.7},
+}
+
+// defaultLayerSpeeds are the layers' speeds until changed.
+var defaultLayerSpeeds = [maxLayers]float64{1, 0.6, 1.7}
+
+// blends are the ways layers are combined, cycled with b.
+var blends = []string{"Additive", "Difference", "Screen"}
+
+// blendByName returns the index of the named blend, or 0 for additive.
+func blendByName(name string) int {
+	for i, b := range blends {
+		if strings.EqualFold(b, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// advanceLayers moves each layer's clock on by a frame's step of time.
+func (m *model) advanceLayers(step float64) {
+	for i := range m.layers {
+		m.layers[i].time += step * m.layers[i].speed
+	}
+}
+
+// blended is the field at (x, y): the current formula, or with more than one
+// layer the formula on each layer's clock and shape, blended.
+//
+// Additive sums the layers and folds the sum back into -1 to 1 as typed
+// formulas are. Difference and screen work on values from 0 to 1, as in an
+// image editor: difference is how far apart two layers are, and screen
+// lightens, as two slides projected on one screen do.
+func (m model) blended(x, y float64) float64 {
+	if m.layerCount < 2 {
+		return m.field(x, y, m.time)
+	}
+	var sum, u float64
+	for i, l := range m.layers[:m.layerCount] {
+		shape := layerShapes[i]
+		sin, cos := math.Sincos(shape.angle)
+		dx, dy := x-8, y-8
+		lx := 8 + (dx*cos-dy*sin)*shape.scale
+		ly := 8 + (dx*sin+dy*cos)*shape.scale
+		v := m.field(lx, ly, l.time)
+		w := (v + 1) / 2
+		switch {
+		case i == 0:
+			sum, u = v, w
+		case m.blend == 0:
+			sum += v
+		case m.blend == 1:
+			u = math.Abs(u - w)
+		default:
+			u = 1 - (1-u)*(1-w)
+		}
+	}
+	if m.blend == 0 {
+		return bounce(sum)
+	}
+	return u*2 - 1
+}
+
+// layerSpeeds lists the layers' speeds to keep in the settings.
+func (m model) layerSpeeds() []float64 {
+	speeds := make([]float64, len(m.layers))
+	for i, l := range m.layers {
+		speeds[i] = l.speed
+	}
+	return speeds
+}
@@ -0,0 +1,106 @@
+package plasma
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/record"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// loopFPS is the frame rate of an exported loop, the demo's own.
+const loopFPS = 30
+
+// maxLoopFrames is the longest loop ExportLoop picks by itself, a minute.
+// Longer ones need their frame count asked for.
+const maxLoopFrames = 60 * loopFPS
+
+// ExportLoop renders one period of the plasma, as it starts with its saved
+// settings, off-screen at width by height cells, and writes it to a GIF at
+// path that loops without a seam. With frames zero the loop plays at the
+// plasma's speed; otherwise the period is spread over that many frames. It
+// returns the number of frames written.
+func ExportLoop(path string, width, height, frames int) (int, error) {
+	if ext := strings.ToLower(filepath.Ext(path)); ext != ".gif" {
+		return 0, fmt.Errorf("a loop is written as a .gif, not %q", ext)
+	}
+	if width <= 0 || height <= 0 {
+		return 0, fmt.Errorf("size %dx%d is too small", width, height)
+	}
+	m := initialModel()
+	period, err := m.period()
+	if err != nil {
+		return 0, err
+	}
+	if frames <= 0 {
+		frames = int(math.Round(period / (0.1 * m.anim.Speed())))
+		if frames > maxLoopFrames {
+			return 0, fmt.Errorf("one loop is %d frames long; ask for fewer frames, or give the layers speeds with a common factor", frames)
+		}
+	}
+
+	// The field alone, in full color whatever the terminal takes
+	m.width, m.height = width, height
+	m.screen.Resize(width, height)
+	m.pixels.Resize(width, height)
+	profile := canvas.ColorProfile()
+	canvas.SetColorProfile(termcolor.TrueColor)
+	defer canvas.SetColorProfile(profile)
+
+	rec, err := record.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	rec.Resize(width, height)
+	for i := 0; i < frames; i++ {
+		// Frame i of n is i/n of the way round, so the last frame leads
+		// back into the first
+		t := period * float64(i) / float64(frames)
+		m.time = t
+		for l := range m.layers {
+			m.layers[l].time = t * math.Round(m.layers[l].speed*10) / 10
+		}
+		view := m.renderPlasma()
+		if m.res != canvas.Normal {
+			view = m.renderPixels()
+		}
+		rec.FrameAt(time.Duration(i)*time.Second/loopFPS, view)
+	}
+	return frames, rec.Close()
+}
+
+// period is how far the plasma's clock runs before the animation repeats.
+// Every built-in formula but the turbulent one repeats; blended layers
+// repeat once each has come round a whole number of times, which their
+// speeds, kept to tenths, always allow.
+func (m model) period() (float64, error) {
+	if m.formula >= len(formulas) {
+		return 0, errors.New("a typed formula need not repeat, so it cannot be looped")
+	}
+	f := formulas[m.formula]
+	if f.period == 0 {
+		return 0, fmt.Errorf("the %s formula never repeats, so it cannot be looped", strings.ToLower(f.name))
+	}
+	if m.layerCount < 2 {
+		return f.period, nil
+	}
+	// Layer i runs at n/10 of the clock, so after 10·period/n it has come
+	// round once; all of them have after 10·period/gcd of the n
+	g := 0
+	for _, l := range m.layers[:m.layerCount] {
+		g = gcd(g, int(math.Round(l.speed*10)))
+	}
+	return 10 * f.period / float64(g), nil
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
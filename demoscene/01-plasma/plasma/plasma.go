@@ -0,0 +1,504 @@
+// Package plasma is the classic demoscene plasma with multiple color palettes.
+package plasma
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/palette"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	width     int
+	height    int
+	time      float64
+	palette   int
+	extra     []palette.Palette // registry palettes, cycled after the built-in four
+	intensity float64
+	anim      engine.Animator
+	res       canvas.Resolution // Normal or HalfBlock
+	screen    *canvas.Canvas
+	pixels    *canvas.Pixels
+	edit      *editor // the palette editor, while it is open
+	note      string  // the outcome of saving a palette, until the next key
+	formula   int     // into formulas, or past them for the custom one
+	custom    fieldFunc
+	expr      string  // the custom formula as typed
+	typing    *typing // the expression input, while it is open
+	// With more than one layer, the formula is drawn on each and blended
+	layers     [maxLayers]layer
+	layerCount int
+	layer      int // the layer whose speed the keys change
+	blend      int // into blends
+	// The pointer is a heat source rippling the field around it, which
+	// cools off once the mouse stops moving.
+	pointerX, pointerY int
+	heat               float64
+}
+
+type keyMap struct {
+	Palette     key.Binding
+	Cycle       key.Binding
+	Faster      key.Binding
+	Slower      key.Binding
+	Weaker      key.Binding
+	Stronger    key.Binding
+	HiRes       key.Binding
+	Formula     key.Binding
+	Expr        key.Binding
+	Edit        key.Binding
+	Layers      key.Binding
+	Blend       key.Binding
+	Layer       key.Binding
+	LayerFaster key.Binding
+	LayerSlower key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Palette:     keymap.New("1-4", "palettes", "1", "2", "3", "4"),
+	Cycle:       keymap.New("c", "cycle palettes"),
+	Faster:      keymap.New("↑↓", "speed", "up"),
+	Slower:      keymap.Hidden("down"),
+	Weaker:      keymap.New("←→", "intensity", "left"),
+	Stronger:    keymap.Hidden("right"),
+	HiRes:       keymap.New("h", "hi-res"),
+	Formula:     keymap.New("f", "formulas"),
+	Expr:        keymap.New("x", "expression"),
+	Edit:        keymap.New("e", "edit palette"),
+	Layers:      keymap.New("l", "layers"),
+	Blend:       keymap.New("b", "blend"),
+	Layer:       keymap.New("tab", "next layer"),
+	LayerFaster: keymap.New("<>", "layer speed", ">"),
+	LayerSlower: keymap.Hidden("<"),
+	Common:      keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Palette   paletteIndex      `json:"palette"`
+	Speed     float64           `json:"speed"`
+	Intensity float64           `json:"intensity"`
+	Res       canvas.Resolution `json:"res"`
+	Formula   string            `json:"formula"`
+	Expr      string            `json:"expr"` // the custom formula
+	Layers    int               `json:"layers"`
+	Blend     string            `json:"blend"`
+	// LayerSpeeds are relative to the plasma's speed
+	LayerSpeeds []float64 `json:"layerSpeeds"`
+}
+
+// paletteIndex is a palette's place among the built-in and registry ones. The
+// settings file keeps the number, but a name is taken as well, so a timeline
+// script can ask for palette=fire.
+type paletteIndex int
+
+func (p *paletteIndex) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return json.Unmarshal(data, (*int)(p))
+	}
+	extra, _ := palette.All()
+	names := append([]string(nil), builtinPalettes...)
+	for _, e := range extra {
+		names = append(names, e.Name)
+	}
+	for i, n := range names {
+		if strings.EqualFold(n, strings.ReplaceAll(name, "-", " ")) {
+			*p = paletteIndex(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown palette %q", name)
+}
+
+func initialModel() model {
+	extra, _ := palette.All()
+	p := prefs{Speed: 1.0, Intensity: 1.0, Layers: 1}
+	settings.Load("plasma", &p)
+	if p.Palette < 0 || int(p.Palette) >= len(builtinPalettes)+len(extra) {
+		p.Palette = 0
+	}
+	if p.Res != canvas.HalfBlock {
+		p.Res = canvas.Normal
+	}
+
+	anim := engine.New(engine.SharedFPS)
+	anim.SetSpeed(common.Clamp(p.Speed, 0.1, 3.0))
+	m := model{
+		width:     80,
+		height:    24,
+		palette:   int(p.Palette),
+		extra:     extra,
+		intensity: common.Clamp(p.Intensity, 0.3, 2.0),
+		anim:      anim,
+		res:       p.Res,
+		screen:    canvas.New(80, 24),
+		pixels:    canvas.NewPixels(p.Res, 80, 24),
+	}
+	if f, err := parseExpr(p.Expr); err == nil {
+		m.custom, m.expr = f, p.Expr
+	}
+	m.formula = m.formulaByName(p.Formula)
+	m.layerCount = min(max(p.Layers, 1), maxLayers)
+	m.blend = blendByName(p.Blend)
+	for i := range m.layers {
+		m.layers[i].speed = defaultLayerSpeeds[i]
+		if i < len(p.LayerSpeeds) {
+			m.layers[i].speed = common.Clamp(p.LayerSpeeds[i], 0.1, 3.0)
+		}
+	}
+	return m
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "01-plasma",
+		Heading:  "🌈 Plasma Effect",
+		Summary:  "Classic demoscene plasma with multiple color palettes",
+		Section:  registry.Demoscene,
+		Keywords: []string{"demoscene", "palette", "sine"},
+		Manual:   doc,
+		Build:    New,
+		Opts:     []tea.ProgramOption{tea.WithMouseAllMotion()},
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "plasma", prefs{Palette: paletteIndex(m.palette), Speed: m.anim.Speed(), Intensity: m.intensity, Res: m.res,
+		Formula: m.formulaNames()[m.formula], Expr: m.expr,
+		Layers: m.layerCount, Blend: blends[m.blend], LayerSpeeds: m.layerSpeeds()}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.screen.Resize(m.width, m.height)
+		m.pixels.Resize(m.width, m.height)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.1 * m.anim.Delta()
+			m.advanceLayers(0.1 * m.anim.Delta())
+			m.heat = math.Max(m.heat-heatLoss*m.anim.Delta(), 0)
+		}
+		return m, cmd
+
+	case tea.MouseMsg:
+		// The field starts below the title, status and editor lines
+		x, y := msg.X, msg.Y-fieldTop
+		if x >= 0 && x < m.width && y >= 0 && y < m.height {
+			m.pointerX, m.pointerY = x, y
+			m.heat = 1
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		m.note = ""
+		if m.edit != nil {
+			return m.editKey(msg)
+		}
+		if m.typing != nil {
+			return m.exprKey(msg)
+		}
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.time = 0
+			for i := range m.layers {
+				m.layers[i].time = 0
+			}
+			m.anim.Reset()
+		case key.Matches(msg, keys.Palette):
+			// Classic fire, ocean, psychedelic, monochrome
+			m.palette = int(msg.String()[0] - '1')
+		case key.Matches(msg, keys.Cycle):
+			m.palette = (m.palette + 1) % (len(builtinPalettes) + len(m.extra))
+		case key.Matches(msg, keys.Faster):
+			m.anim.SetSpeed(math.Min(m.anim.Speed()+0.2, 3.0))
+		case key.Matches(msg, keys.Slower):
+			m.anim.SetSpeed(math.Max(m.anim.Speed()-0.2, 0.1))
+		case key.Matches(msg, keys.Weaker):
+			m.intensity = math.Max(m.intensity-0.1, 0.3)
+		case key.Matches(msg, keys.Stronger):
+			m.intensity = math.Min(m.intensity+0.1, 2.0)
+		case key.Matches(msg, keys.HiRes):
+			// Braille would light every dot of a cell in one color, no
+			// finer than a full cell, so half blocks are the only step up
+			if m.res == canvas.Normal {
+				m.res = canvas.HalfBlock
+			} else {
+				m.res = canvas.Normal
+			}
+			m.pixels.SetResolution(m.res)
+		case key.Matches(msg, keys.Formula):
+			m.formula = (m.formula + 1) % len(m.formulaNames())
+		case key.Matches(msg, keys.Expr):
+			m = m.openExpr()
+		case key.Matches(msg, keys.Layers):
+			m.layerCount = m.layerCount%maxLayers + 1
+			m.layer = min(m.layer, m.layerCount-1)
+		case key.Matches(msg, keys.Blend) && m.layerCount > 1:
+			m.blend = (m.blend + 1) % len(blends)
+		case key.Matches(msg, keys.Layer) && m.layerCount > 1:
+			m.layer = (m.layer + 1) % m.layerCount
+		case key.Matches(msg, keys.LayerFaster) && m.layerCount > 1:
+			m.layers[m.layer].speed = math.Min(m.layers[m.layer].speed+0.1, 3.0)
+		case key.Matches(msg, keys.LayerSlower) && m.layerCount > 1:
+			m.layers[m.layer].speed = math.Max(m.layers[m.layer].speed-0.1, 0.1)
+		case key.Matches(msg, keys.Edit):
+			m.edit = newEditor(m.paletteColors())
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper. The palette editor has keys of its own.
+func (m model) KeyMap() keymap.Map {
+	if m.edit != nil {
+		return keymap.Of(editKeys)
+	}
+	if m.typing != nil {
+		return keymap.Of(exprKeys)
+	}
+	k := keys
+	for _, b := range []*key.Binding{&k.Blend, &k.Layer, &k.LayerFaster, &k.LayerSlower} {
+		b.SetEnabled(m.layerCount > 1)
+	}
+	return keymap.Of(k)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#FF0080")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🌈 Plasma Effect")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := statusStyle.Render(fmt.Sprintf(
+		"Palette: %s | Formula: %s | Speed: %.1f | Intensity: %.1f | Res: %s | %s",
+		m.paletteName(), m.formulaNames()[m.formula], m.anim.Speed(), m.intensity, m.res,
+		map[bool]string{true: "⏸ Paused", false: "🌈 Flowing"}[m.anim.Paused()],
+	))
+	if m.layerCount > 1 {
+		status += statusStyle.Render(fmt.Sprintf(" | Layers: %d, %s | Layer %d: %.1fx",
+			m.layerCount, blends[m.blend], m.layer+1, m.layers[m.layer].speed))
+	}
+	if m.note != "" {
+		status += statusStyle.Render(" | " + m.note)
+	}
+	gap := ""
+	if m.edit != nil {
+		status = statusStyle.Render(m.edit.status())
+		gap = m.edit.view(m.width)
+	}
+	if m.typing != nil {
+		status = m.typing.status()
+		gap = m.typing.input.View()
+	}
+
+	// Render plasma
+	var plasma string
+	if m.res == canvas.Normal {
+		plasma = m.renderPlasma()
+	} else {
+		plasma = m.renderPixels()
+	}
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	return fmt.Sprintf("%s\n%s\n%s\n%s\n%s",
+		title, status, gap, plasma, help)
+}
+
+func (m model) renderPlasma() string {
+	eachRow(m.height, func(y int) {
+		for x := 0; x < m.width; x++ {
+			// Convert to character and color
+			char, color := m.getPlasmaChar(m.value(float64(x), float64(y)))
+			m.screen.SetString(x, y, char, canvas.Style{Fg: color})
+		}
+	})
+
+	// Unchanged rows are reused from the previous frame
+	return m.screen.Render()
+}
+
+// renderPixels draws the plasma two pixels to a cell, each half of the cell
+// in its own color, so the gradient shows without the character ramp.
+func (m model) renderPixels() string {
+	_, sy := m.res.Scale()
+	m.pixels.Clear()
+	eachRow(m.pixels.Height(), func(py int) {
+		for px := 0; px < m.pixels.Width(); px++ {
+			// Sample the field at the pixel centre, in cell coordinates
+			y := (float64(py)+0.5)/float64(sy) - 0.5
+			m.pixels.Set(px, py, m.color(m.value(float64(px), y)))
+		}
+	})
+	m.pixels.Draw(m.screen)
+	return m.screen.Render()
+}
+
+// eachRow calls draw for rows 0 to n-1, split into a band for each core and
+// the bands drawn at once. Every cell of the plasma is worked out on its own,
+// so the bands never wait on one another; draw must only write to its row.
+func eachRow(n int, draw func(y int)) {
+	bands := min(runtime.GOMAXPROCS(0), n)
+	if bands <= 1 {
+		for y := 0; y < n; y++ {
+			draw(y)
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	for b := 0; b < bands; b++ {
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			for y := from; y < to; y++ {
+				draw(y)
+			}
+		}(n*b/bands, n*(b+1)/bands)
+	}
+	wg.Wait()
+}
+
+// value is the plasma at (x, y) in cell coordinates, from 0 to 1.
+func (m model) value(x, y float64) float64 {
+	fx := x / float64(m.width) * 16
+	fy := y / float64(m.height) * 16
+
+	value := m.blended(fx, fy)
+	if m.heat > 0 {
+		value += m.heatAt(fx, fy)
+	}
+
+	// Normalize and apply intensity
+	value = (value + 1) / 2 * m.intensity
+	return math.Max(0, math.Min(1, value))
+}
+
+// fieldTop is the screen row the plasma starts on.
+const fieldTop = 3
+
+// heatLoss is how much of the pointer's heat is lost each frame, so the
+// ripples fade about a second and a half after the mouse stops.
+const heatLoss = 0.02
+
+// heatAt is the pointer's ripple at (fx, fy) in field units: a sine running
+// out from the pointer, fading with distance, as strong as the heat left.
+func (m model) heatAt(fx, fy float64) float64 {
+	px := float64(m.pointerX) / float64(m.width) * 16
+	py := float64(m.pointerY) / float64(m.height) * 16
+	d := math.Hypot(fx-px, fy-py)
+	return 0.3 * m.heat * math.Sin(d*2-m.time*4) * math.Exp(-d*0.3)
+}
+
+func (m model) getPlasmaChar(value float64) (string, lipgloss.Color) {
+	// Choose character based on intensity
+	chars := []string{" ", "·", "∘", "•", "◦", "○", "●", "▫", "▪", "▒", "▓", "█"}
+	charIndex := min(max(int(value*float64(len(chars)-1)), 0), len(chars)-1)
+	char := chars[charIndex]
+
+	return char, m.color(value)
+}
+
+// color looks value up in the palette being edited, or else the current one.
+func (m model) color(value float64) lipgloss.Color {
+	if m.edit != nil {
+		return common.Sample(m.edit.colors, value)
+	}
+	return m.paletteColor(value)
+}
+
+// paletteColor looks value up in the current palette.
+func (m model) paletteColor(value float64) lipgloss.Color {
+	var color lipgloss.Color
+	switch m.palette {
+	case 0: // Fire palette
+		color = m.getFireColor(value)
+	case 1: // Ocean palette
+		color = m.getOceanColor(value)
+	case 2: // Psychedelic palette
+		color = m.getPsychedelicColor(value)
+	case 3: // Monochrome palette
+		color = m.getMonochromeColor(value)
+	default: // Registry palettes
+		color = common.Sample(m.extra[m.palette-len(builtinPalettes)].Colors, value)
+	}
+	return color
+}
+
+var builtinPalettes = []string{"Fire", "Ocean", "Psychedelic", "Monochrome"}
+
+func (m model) paletteName() string {
+	if m.palette < len(builtinPalettes) {
+		return builtinPalettes[m.palette]
+	}
+	return m.extra[m.palette-len(builtinPalettes)].Name
+}
+
+var (
+	fireGradient  = []string{"#330000", "#660000", "#990000", "#CC3300", "#FF4400", "#FF8800", "#FFCC00"}
+	oceanGradient = []string{"#000033", "#000066", "#003399", "#0066CC", "#0099FF", "#33CCFF", "#66FFFF"}
+)
+
+func (m model) getFireColor(value float64) lipgloss.Color {
+	return common.Sample(fireGradient, value)
+}
+
+func (m model) getOceanColor(value float64) lipgloss.Color {
+	return common.Sample(oceanGradient, value)
+}
+
+func (m model) getPsychedelicColor(value float64) lipgloss.Color {
+	// Sweep the full color wheel, starting from hot pink
+	return common.HSL{H: 330 - value*360, S: 1, L: 0.5}.Color()
+}
+
+func (m model) getMonochromeColor(value float64) lipgloss.Color {
+	gray := common.ParseHex("#FFFFFF")
+	return common.LerpRGB(common.RGB{}, gray, value).Color()
+}
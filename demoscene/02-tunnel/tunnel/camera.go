@@ -0,0 +1,50 @@
+package tunnel
+
+import (
+	"math"
+	"strings"
+)
+
+// cameraPath is a way of flying down the tunnel. At time t it moves the
+// vanishing point by x and y, as fractions of the screen, from where it is
+// steered, and banks the view by an angle in radians.
+type cameraPath struct {
+	name string
+	at   func(t float64) (x, y, bank float64)
+}
+
+// cameraPaths are the presets c cycles through. The banking follows the
+// sideways motion, leaning into each turn as an aircraft does.
+var cameraPaths = []cameraPath{
+	{"Fixed", func(t float64) (float64, float64, float64) {
+		return 0, 0, 0
+	}},
+	{"Sway", func(t float64) (float64, float64, float64) {
+		return 0.2 * math.Sin(t*0.7), 0, 0.35 * math.Cos(t*0.7)
+	}},
+	{"Lissajous", func(t float64) (float64, float64, float64) {
+		return 0.25 * math.Sin(t*0.6), 0.2 * math.Sin(t*0.4+math.Pi/4), 0.3 * math.Cos(t*0.6)
+	}},
+	{"Corkscrew", func(t float64) (float64, float64, float64) {
+		return 0.15 * math.Cos(t*0.5), 0.15 * math.Sin(t*0.5), t * 0.3
+	}},
+}
+
+// cameraByName returns the index of the named camera path, or 0 for Fixed.
+func cameraByName(name string) int {
+	for i, c := range cameraPaths {
+		if strings.EqualFold(c.name, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// vanishingPoint returns where the vanishing point is, in cells, and the angle the
+// view is banked by, with the camera path added to the steering.
+func (m model) vanishingPoint() (x, y, bank float64) {
+	dx, dy, bank := cameraPaths[m.camera].at(m.time)
+	x = float64(m.width) * (m.centerX + dx)
+	y = float64(m.height) * (m.centerY + dy)
+	return x, y, bank
+}
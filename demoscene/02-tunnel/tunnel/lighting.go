@@ -0,0 +1,54 @@
+package tunnel
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// Fog thickens in steps of fogStep up to maxFog, and the light turns round
+// in steps of an eighth of a circle.
+const (
+	fogStep   = 0.1
+	maxFog    = 1.0
+	lightStep = math.Pi / 4
+)
+
+// shadeLevels is how finely fog and light are graded. Keeping to a few
+// levels keeps the number of distinct colors, and so of cached escape
+// sequences, small.
+const shadeLevels = 16
+
+// lightArrows point at the lit side of the wall for each step of the light.
+var lightArrows = []string{"→", "↘", "↓", "↙", "←", "↖", "↑", "↗"}
+
+// shade lights a color by the side of the wall it is on and fades it into
+// the fog with depth.
+//
+// The light is directional: the side of the wall facing it gets its full
+// strength and the opposite side none, only what light is left over. The
+// fog grows as 1 − e^(−density·z) with the distance z down the tunnel,
+// which is proportional to 1 / r on the screen.
+func (m model) shade(c lipgloss.Color, distance, angle float64) lipgloss.Color {
+	if c == "" || (m.fog == 0 && m.light == 0) {
+		return c
+	}
+	lit := 1 - m.light + m.light*(0.5+0.5*math.Cos(angle-m.lightAngle))
+	z := 40 / math.Max(distance, 1)
+	fog := 1 - math.Exp(-m.fog*z/10)
+
+	lit = math.Round(lit*shadeLevels) / shadeLevels
+	fog = math.Round(fog*shadeLevels) / shadeLevels
+	rgb := common.LerpRGB(common.RGB{}, common.ParseHex(string(c)), lit)
+	return common.LerpRGB(rgb, m.fogColor, fog).Color()
+}
+
+// lightArrow is the arrow pointing at the lit side, for the status line.
+func (m model) lightArrow() string {
+	step := int(math.Round(m.lightAngle/lightStep)) % len(lightArrows)
+	if step < 0 {
+		step += len(lightArrows)
+	}
+	return lightArrows[step]
+}
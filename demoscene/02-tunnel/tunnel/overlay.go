@@ -0,0 +1,32 @@
+package tunnel
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// The text speed is a multiple of the scroller's own, set apart from the
+// tunnel's.
+const (
+	minTextSpeed  = 0.2
+	maxTextSpeed  = 4.0
+	textSpeedStep = 0.2
+)
+
+// textColors are swept along the text, as the scroller's rainbow wave is.
+var textColors = []string{"#FF0000", "#FF8000", "#FFFF00", "#00FF00", "#0080FF", "#8000FF"}
+
+// drawText draws the scroller across the middle of the tunnel, over a black
+// shadow a row below it so the letters stand clear of the walls.
+func (m model) drawText() {
+	shadow := canvas.Style{Fg: "#000000"}
+	m.text.Draw(m.grid, m.height/2+1, func(x, y, index int) (rune, canvas.Style) {
+		return '█', shadow
+	})
+	m.text.Draw(m.grid, m.height/2, func(x, y, index int) (rune, canvas.Style) {
+		sweep := math.Mod(float64(x+index*20)*0.05+m.text.Time, 1.0)
+		return '█', canvas.Style{Fg: common.Sample(textColors, sweep), Bold: true}
+	})
+}
@@ -0,0 +1,98 @@
+package tunnel
+
+import (
+	"embed"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/sprite"
+)
+
+// textureFiles are the bundled wall textures, in the sprite text format.
+//
+//go:embed textures/*.txt
+var textureFiles embed.FS
+
+// texture is an image the textured mode wraps around the tunnel's wall.
+type texture struct {
+	name string
+	art  *sprite.Animation
+}
+
+// textured is the mode that maps a texture onto the wall.
+const textured = 4
+
+// texelsAround is about how many texture columns go once round the wall.
+// Textures repeat a whole number of times, so there is no seam.
+const texelsAround = 64
+
+// bundledTextures parses the textures compiled into the demo, in file name
+// order, each named after its file.
+func bundledTextures() []texture {
+	entries, err := textureFiles.ReadDir("textures")
+	if err != nil {
+		panic(err)
+	}
+	var textures []texture
+	for _, e := range entries {
+		data, err := textureFiles.ReadFile(path.Join("textures", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		art, err := sprite.Parse(string(data))
+		if err != nil {
+			panic(e.Name() + ": " + err.Error())
+		}
+		textures = append(textures, texture{name: sprite.Name(e.Name()), art: art})
+	}
+	return textures
+}
+
+// loadTexture reads a texture from a sprite text file, or from a PNG, which
+// is turned into half blocks at most 32 columns wide.
+func loadTexture(file string) (texture, error) {
+	art, err := sprite.LoadFile(file, 32)
+	return texture{name: sprite.Name(file), art: art}, err
+}
+
+// textureByName returns the index of the named texture, or 0 for the first.
+func (m model) textureByName(name string) int {
+	for i, t := range m.textures {
+		if strings.EqualFold(t.name, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// texturedTunnel looks the cell up in the texture, using the angle round the
+// wall and the depth into the tunnel as texture coordinates. Far down the
+// tunnel the wall fades into the fog, or with the fog off is drawn faint.
+func (m model) texturedTunnel(distance, angle float64) (rune, canvas.Style) {
+	if distance < 1 {
+		distance = 1
+	}
+	frame := m.textures[m.texture].art.At(m.anim.Elapsed())
+	if frame.Width == 0 || frame.Height == 0 {
+		return ' ', canvas.Style{}
+	}
+
+	depth := 40.0/distance + m.time*3
+	repeats := max(1, math.Round(texelsAround/float64(frame.Width)))
+	u := (angle + math.Pi) / (2 * math.Pi) * repeats * float64(frame.Width)
+	v := depth * 2 // two texture rows per unit of depth
+
+	cell := frame.Wrap(int(math.Floor(u)), int(math.Floor(v)))
+	if cell.Rune == 0 || cell.Rune == canvas.Continued {
+		return ' ', canvas.Style{}
+	}
+	style := cell.Style
+	style.Fg = m.shade(style.Fg, distance, angle)
+	style.Bg = m.shade(style.Bg, distance, angle)
+	if m.fog == 0 && distance < 6 {
+		style.Faint = true
+	}
+	return cell.Rune, style
+}
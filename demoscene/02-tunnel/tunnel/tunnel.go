@@ -0,0 +1,635 @@
+// Package tunnel is a hypnotic tunnel with six procedural rendering modes
+// and texture-mapped walls.
+package tunnel
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/scrolltext"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	width      int
+	height     int
+	grid       *canvas.Canvas
+	time       float64
+	tunnelMode int
+	anim       engine.Animator
+	textures   []texture
+	texture    int
+	// The vanishing point, as a fraction of the screen's width and height,
+	// and where it is being steered to, by the mouse or under manual
+	// control by the arrow keys
+	centerX, centerY float64
+	aimX, aimY       float64
+	manual           bool
+	camera           int
+	// Fog density and color, and the strength of the light and the angle it
+	// falls from
+	fog        float64
+	fogColor   common.RGB
+	light      float64
+	lightAngle float64
+	// The scroller drawn over the walls when overlay is on, at its own
+	// speed
+	overlay   bool
+	text      scrolltext.Scroller
+	textSpeed float64
+}
+
+type keyMap struct {
+	Mode    key.Binding
+	Texture key.Binding
+	Manual  key.Binding
+	Camera  key.Binding
+	Fog     key.Binding
+	FogUp   key.Binding
+	Light   key.Binding
+	LightUp key.Binding
+	Overlay key.Binding
+	TextUp  key.Binding
+	TextDn  key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	Faster  key.Binding
+	Slower  key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Mode:    keymap.New("1-7", "tunnel modes", "1", "2", "3", "4", "5", "6", "7"),
+	Texture: keymap.New("t", "texture"),
+	Manual:  keymap.New("m", "manual steering"),
+	Camera:  keymap.New("c", "camera path"),
+	Fog:     keymap.New("f/F", "fog", "f"),
+	FogUp:   keymap.Hidden("F"),
+	Light:   keymap.New("l/L", "light", "l"),
+	LightUp: keymap.Hidden("L"),
+	Overlay: keymap.New("s", "scroller"),
+	TextUp:  keymap.New("<>", "text speed", ">"),
+	TextDn:  keymap.Hidden("<"),
+	Up:      keymap.New("shift+↑↓←→", "steer", "shift+up"),
+	Down:    keymap.Hidden("shift+down"),
+	Left:    keymap.Hidden("shift+left", "left"),
+	Right:   keymap.Hidden("shift+right", "right"),
+	Faster:  keymap.New("↑↓", "speed", "up"),
+	Slower:  keymap.Hidden("down"),
+	Common:  keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Mode    int     `json:"mode"`
+	Speed   float64 `json:"speed"`
+	Texture string  `json:"texture"`
+	Manual  bool    `json:"manual"`
+	Camera  string  `json:"camera"`
+	// Fog is the fog's density from 0, none, to 1, and Light how much the
+	// light falls on one side, from 0, evenly lit, to 1
+	Fog        float64 `json:"fog"`
+	FogColor   string  `json:"fogColor"`
+	Light      float64 `json:"light"`
+	LightAngle float64 `json:"lightAngle"` // degrees clockwise from the right
+	Overlay    bool    `json:"overlay"`
+	TextSpeed  float64 `json:"textSpeed"`
+	// Message is the scroller's text, without the " * " that closes the loop
+	Message string `json:"message"`
+}
+
+func initialModel() model {
+	p := prefs{Speed: 1.0, Fog: 0.4, FogColor: "#000000", Light: 0.5, LightAngle: -90, TextSpeed: 1.0}
+	settings.Load("tunnel", &p)
+	anim := engine.New(engine.SharedFPS)
+	anim.SetSpeed(common.Clamp(p.Speed, 0.1, 3.0))
+	m := model{
+		width:      80,
+		height:     24,
+		grid:       canvas.New(80, 24),
+		tunnelMode: min(max(p.Mode, 0), len(modeNames)-1),
+		anim:       anim,
+		textures:   bundledTextures(),
+		centerX:    0.5,
+		centerY:    0.5,
+		aimX:       0.5,
+		aimY:       0.5,
+		manual:     p.Manual,
+		camera:     cameraByName(p.Camera),
+		fog:        common.Clamp(p.Fog, 0, maxFog),
+		fogColor:   common.ParseHex(p.FogColor),
+		light:      common.Clamp(p.Light, 0, 1),
+		lightAngle: p.LightAngle * math.Pi / 180,
+		overlay:    p.Overlay,
+		text:       scrolltext.New(scrolltext.Greetings),
+		textSpeed:  common.Clamp(p.TextSpeed, minTextSpeed, maxTextSpeed),
+	}
+	m.texture = m.textureByName(p.Texture)
+	if p.Message != "" {
+		m.text.Message = scrolltext.Normalize(p.Message)
+	}
+	return m
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+// NewWithTexture returns the demo's model showing the textured walls with
+// the sprite text file or PNG at path, after the bundled textures.
+func NewWithTexture(path string) (tea.Model, error) {
+	m := initialModel()
+	t, err := loadTexture(path)
+	if err != nil {
+		return nil, err
+	}
+	m.textures = append(m.textures, t)
+	m.texture = len(m.textures) - 1
+	m.tunnelMode = textured
+	return m, nil
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "02-tunnel",
+		Heading:  "🕳️ Tunnel Effect",
+		Summary:  "Hypnotic tunnel with 6 procedural modes and texture-mapped walls",
+		Section:  registry.Demoscene,
+		Keywords: []string{"demoscene", "3d", "texture"},
+		Manual:   doc,
+		Build:    New,
+		Opts:     []tea.ProgramOption{tea.WithMouseAllMotion()},
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "tunnel", prefs{Mode: m.tunnelMode, Speed: m.anim.Speed(), Texture: m.textures[m.texture].name,
+		Manual: m.manual, Camera: cameraPaths[m.camera].name,
+		Fog: m.fog, FogColor: m.fogColor.Hex(), Light: m.light, LightAngle: m.lightAngle * 180 / math.Pi,
+		Overlay: m.overlay, TextSpeed: m.textSpeed, Message: strings.TrimSuffix(m.text.Message, " * ")}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.grid.Resize(m.width, m.height)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.1 * m.anim.Delta()
+			// Ease the vanishing point towards the aim, so the tunnel
+			// swings round rather than jumping
+			ease := math.Min(steerRate*m.anim.Delta(), 1)
+			m.centerX += (m.aimX - m.centerX) * ease
+			m.centerY += (m.aimY - m.centerY) * ease
+			// The text keeps its own speed whatever the tunnel's
+			m.text.Update(m.anim.Delta()/m.anim.Speed()*m.textSpeed, m.width)
+		}
+		return m, cmd
+
+	case tea.MouseMsg:
+		// The tunnel starts below the title and status lines
+		if !m.manual && m.width > 0 && m.height > 0 {
+			m.aimX = common.Clamp(float64(msg.X)/float64(m.width), 0, 1)
+			m.aimY = common.Clamp(float64(msg.Y-tunnelTop)/float64(m.height), 0, 1)
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.time = 0
+			m.anim.Reset()
+			m.centerX, m.centerY = 0.5, 0.5
+			m.aimX, m.aimY = 0.5, 0.5
+			m.text.Reset(m.width)
+		case key.Matches(msg, keys.Mode):
+			// Classic, checkerboard, spiral, ripple, textured, wormhole,
+			// starburst
+			m.tunnelMode = int(msg.String()[0] - '1')
+		case key.Matches(msg, keys.Texture):
+			// The first press shows the current texture
+			if m.tunnelMode == textured {
+				m.texture = (m.texture + 1) % len(m.textures)
+			}
+			m.tunnelMode = textured
+		case key.Matches(msg, keys.Manual):
+			m.manual = !m.manual
+		case key.Matches(msg, keys.Camera):
+			m.camera = (m.camera + 1) % len(cameraPaths)
+		case key.Matches(msg, keys.Fog):
+			m.fog = math.Max(math.Round((m.fog-fogStep)*10)/10, 0)
+		case key.Matches(msg, keys.FogUp):
+			m.fog = math.Min(math.Round((m.fog+fogStep)*10)/10, maxFog)
+		case key.Matches(msg, keys.Light):
+			m.lightAngle = math.Mod(m.lightAngle-lightStep, 2*math.Pi)
+		case key.Matches(msg, keys.LightUp):
+			m.lightAngle = math.Mod(m.lightAngle+lightStep, 2*math.Pi)
+		case key.Matches(msg, keys.Overlay):
+			m.overlay = !m.overlay
+		case m.overlay && key.Matches(msg, keys.TextUp):
+			m.textSpeed = math.Min(m.textSpeed+textSpeedStep, maxTextSpeed)
+		case m.overlay && key.Matches(msg, keys.TextDn):
+			m.textSpeed = math.Max(m.textSpeed-textSpeedStep, minTextSpeed)
+		case m.manual && key.Matches(msg, keys.Up):
+			m.aimY = math.Max(m.aimY-steerStep, 0)
+		case m.manual && key.Matches(msg, keys.Down):
+			m.aimY = math.Min(m.aimY+steerStep, 1)
+		case m.manual && key.Matches(msg, keys.Left):
+			m.aimX = math.Max(m.aimX-steerStep, 0)
+		case m.manual && key.Matches(msg, keys.Right):
+			m.aimX = math.Min(m.aimX+steerStep, 1)
+		case key.Matches(msg, keys.Faster):
+			m.anim.SetSpeed(math.Min(m.anim.Speed()+0.2, 3.0))
+		case key.Matches(msg, keys.Slower):
+			m.anim.SetSpeed(math.Max(m.anim.Speed()-0.2, 0.1))
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper. Under manual steering the arrow keys
+// with shift steer, ← and → alone too, while ↑ and ↓ keep the speed. The
+// text speed is only listed with the scroller showing.
+func (m model) KeyMap() keymap.Map {
+	k := keys
+	if m.manual {
+		k.Manual.SetHelp("m", "mouse steering")
+	}
+	k.Up.SetEnabled(m.manual)
+	k.TextUp.SetEnabled(m.overlay)
+	return keymap.Of(k)
+}
+
+// modeNames are the tunnel modes in the order of their number keys.
+var modeNames = []string{"Classic", "Checkerboard", "Spiral", "Ripple", "Textured", "Wormhole", "Starburst"}
+
+// tunnelTop is the screen row the tunnel starts on.
+const tunnelTop = 3
+
+// steerRate is how much of the way to its aim the vanishing point moves each
+// frame, and steerStep how far a steering key moves the aim.
+const (
+	steerRate = 0.15
+	steerStep = 0.05
+)
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#8800FF")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🕳️ Tunnel Effect")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Purple)
+	mode := modeNames[m.tunnelMode]
+	if m.tunnelMode == textured {
+		mode += " (" + m.textures[m.texture].name + ")"
+	}
+	camera := cameraPaths[m.camera].name
+	if m.manual {
+		camera += ", steered by keys"
+	}
+	speed := fmt.Sprintf("%.1f", m.anim.Speed())
+	if m.overlay {
+		speed += fmt.Sprintf(", text %.1f", m.textSpeed)
+	}
+	status := statusStyle.Render(fmt.Sprintf(
+		"Mode: %s | Speed: %s | Camera: %s | Fog: %.1f | Light: %s | %s",
+		mode, speed, camera, m.fog, m.lightArrow(),
+		map[bool]string{true: "⏸ Paused", false: "🕳️ Tunneling"}[m.anim.Paused()],
+	))
+
+	// Render tunnel
+	scene := m.renderTunnel()
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s",
+		title, status, scene, help)
+}
+
+func (m model) renderTunnel() string {
+	m.grid.Clear()
+	centerX, centerY, bank := m.vanishingPoint()
+
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			// Calculate distance from center
+			dx := float64(x) - centerX
+			dy := (float64(y) - centerY) * 2 // Adjust for character aspect ratio
+			distance := math.Sqrt(dx*dx + dy*dy)
+			
+			// Calculate angle
+			angle := math.Atan2(dy, dx) + bank
+			
+			if m.tunnelMode == textured {
+				r, style := m.texturedTunnel(distance, angle)
+				m.grid.Set(x, y, r, style)
+				continue
+			}
+
+			// Apply tunnel effect based on mode
+			var intensity float64
+			var char string
+			var color lipgloss.Color
+			
+			switch m.tunnelMode {
+			case 0: // Classic tunnel
+				intensity, char, color = m.classicTunnel(distance, angle)
+			case 1: // Checkerboard tunnel
+				intensity, char, color = m.checkerboardTunnel(distance, angle)
+			case 2: // Spiral tunnel
+				intensity, char, color = m.spiralTunnel(distance, angle)
+			case 3: // Ripple tunnel
+				intensity, char, color = m.rippleTunnel(distance, angle)
+			case 5: // Wormhole
+				intensity, char, color = m.wormholeTunnel(distance, angle)
+			case 6: // Starburst
+				intensity, char, color = m.starburstTunnel(distance, angle)
+			}
+			
+			style := canvas.Style{Fg: m.shade(color, distance, angle)}
+			if intensity < 0.1 {
+				style.Faint = true
+			} else if intensity > 0.8 {
+				style.Bold = true
+			}
+			
+			r, _ := utf8.DecodeRuneInString(char)
+			m.grid.Set(x, y, r, style)
+		}
+	}
+
+	if m.overlay {
+		m.drawText()
+	}
+	return m.grid.Render()
+}
+
+func (m model) classicTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+	
+	// Create tunnel depth effect
+	depth := 50.0/distance + m.time*2
+	ringPos := math.Mod(depth, 2.0)
+	
+	var intensity float64
+	var char string
+	
+	if ringPos < 1.0 {
+		intensity = ringPos
+		char = "▓"
+	} else {
+		intensity = 2.0 - ringPos
+		char = "▒"
+	}
+	
+	// Color based on depth
+	colorValue := math.Mod(depth*0.2, 1.0)
+	color := m.getDepthColor(colorValue)
+	
+	return intensity, char, color
+}
+
+func (m model) checkerboardTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+	
+	depth := 30.0/distance + m.time*3
+	angleSegments := int((angle + math.Pi) / (math.Pi / 8))
+	depthSegments := int(depth)
+	
+	var intensity float64
+	var char string
+	
+	if (angleSegments+depthSegments)%2 == 0 {
+		intensity = 0.8
+		char = "█"
+	} else {
+		intensity = 0.2
+		char = "░"
+	}
+	
+	colorValue := math.Mod(depth*0.1, 1.0)
+	color := m.getDepthColor(colorValue)
+	
+	return intensity, char, color
+}
+
+func (m model) spiralTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+	
+	depth := 40.0/distance + m.time*2
+	spiralAngle := angle + depth*0.5
+	spiralValue := math.Sin(spiralAngle * 4)
+	
+	var intensity float64
+	var char string
+	
+	if spiralValue > 0 {
+		intensity = spiralValue
+		char = "◤"
+	} else {
+		intensity = -spiralValue
+		char = "◥"
+	}
+	
+	colorValue := math.Mod(depth*0.15, 1.0)
+	color := m.getSpiralColor(colorValue)
+	
+	return intensity, char, color
+}
+
+func (m model) rippleTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+	
+	depth := 35.0/distance + m.time*2.5
+	ripple := math.Sin(distance*0.3 - m.time*4)
+	wave := math.Sin(depth*2 + ripple*2)
+	
+	intensity := (wave + 1) / 2
+	
+	var char string
+	if intensity > 0.7 {
+		char = "●"
+	} else if intensity > 0.4 {
+		char = "◦"
+	} else {
+		char = "·"
+	}
+	
+	colorValue := math.Mod(depth*0.25 + ripple*0.1, 1.0)
+	color := m.getRippleColor(colorValue)
+	
+	return intensity, char, color
+}
+
+func (m model) wormholeTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+
+	// The texture coordinates twist further round the deeper they go, and
+	// the twist itself wobbles, so the walls writhe
+	depth := 35.0/distance + m.time*2.5
+	twist := angle + depth*0.4 + math.Sin(depth*0.3+m.time)*0.8
+	bands := math.Sin(twist*6) * math.Sin(depth*math.Pi)
+	intensity := (bands + 1) / 2
+
+	var char string
+	if intensity > 0.75 {
+		char = "█"
+	} else if intensity > 0.5 {
+		char = "▓"
+	} else if intensity > 0.25 {
+		char = "▒"
+	} else {
+		char = "░"
+	}
+
+	colorValue := math.Mod(depth*0.1+(twist+math.Pi)/(2*math.Pi)+100, 1.0)
+	color := m.getWormholeColor(colorValue)
+
+	return intensity, char, color
+}
+
+func (m model) starburstTunnel(distance, angle float64) (float64, string, lipgloss.Color) {
+	if distance < 1 {
+		distance = 1
+	}
+
+	// Each of 48 narrow wedges carries a streak racing outwards at a speed
+	// and phase of its own
+	wedge := int((angle + math.Pi) / (2 * math.Pi) * 48)
+	_, seed := math.Modf(math.Abs(math.Sin(float64(wedge)*12.9898) * 43758.5453))
+	pos := math.Mod(distance*0.04-m.time*(0.8+seed)+seed*10+100, 1.0)
+	intensity := math.Pow(1-pos, 3)*(0.4+0.6*seed) + 2/distance
+	if intensity < 0.15 {
+		return intensity, " ", lipgloss.Color("#000000")
+	}
+
+	// Streaks are drawn along the direction they travel
+	var char string
+	switch dir := math.Mod(angle+2*math.Pi, math.Pi); {
+	case dir < math.Pi/8 || dir >= 7*math.Pi/8:
+		char = "─"
+	case dir < 3*math.Pi/8:
+		char = "╲"
+	case dir < 5*math.Pi/8:
+		char = "│"
+	default:
+		char = "╱"
+	}
+	if intensity > 0.8 {
+		char = "*"
+	}
+
+	color := m.getStarburstColor(math.Min(intensity, 1))
+
+	return intensity, char, color
+}
+
+func (m model) getDepthColor(value float64) lipgloss.Color {
+	// Blue to red gradient for depth
+	if value < 0.33 {
+		return lipgloss.Color("#0000FF")
+	} else if value < 0.66 {
+		return lipgloss.Color("#8800FF")
+	} else {
+		return lipgloss.Color("#FF00FF")
+	}
+}
+
+func (m model) getSpiralColor(value float64) lipgloss.Color {
+	// Green to yellow gradient for spiral
+	if value < 0.33 {
+		return lipgloss.Color("#00FF00")
+	} else if value < 0.66 {
+		return lipgloss.Color("#88FF00")
+	} else {
+		return lipgloss.Color("#FFFF00")
+	}
+}
+
+func (m model) getRippleColor(value float64) lipgloss.Color {
+	// Cyan to white gradient for ripples
+	if value < 0.25 {
+		return lipgloss.Color("#00FFFF")
+	} else if value < 0.5 {
+		return lipgloss.Color("#44FFFF")
+	} else if value < 0.75 {
+		return lipgloss.Color("#88FFFF")
+	} else {
+		return lipgloss.Color("#CCFFFF")
+	}
+}
+
+func (m model) getWormholeColor(value float64) lipgloss.Color {
+	// Violet through magenta to orange and back round the twist
+	if value < 0.25 {
+		return lipgloss.Color("#6000C0")
+	} else if value < 0.5 {
+		return lipgloss.Color("#C000C0")
+	} else if value < 0.75 {
+		return lipgloss.Color("#FF6000")
+	} else {
+		return lipgloss.Color("#C000C0")
+	}
+}
+
+func (m model) getStarburstColor(value float64) lipgloss.Color {
+	// Red embers out to white-hot streak heads
+	if value < 0.3 {
+		return lipgloss.Color("#C02000")
+	} else if value < 0.55 {
+		return lipgloss.Color("#FF8000")
+	} else if value < 0.8 {
+		return lipgloss.Color("#FFD040")
+	} else {
+		return lipgloss.Color("#FFFFFF")
+	}
+}
@@ -0,0 +1,32 @@
+package tunnel
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/golden"
+)
+
+func TestFrames(t *testing.T) {
+	golden.Check(t, func() tea.Model { return initialModel() }, 1, 45)
+}
+
+func TestManualKeys(t *testing.T) {
+	t.Setenv("SHOWCASE_SETTINGS", "off")
+	m := initialModel()
+	m.manual = true
+	press := func(k tea.KeyType) {
+		next, _ := m.Update(tea.KeyMsg{Type: k})
+		m = next.(model)
+	}
+	speed, aimX, aimY := m.anim.Speed(), m.aimX, m.aimY
+	press(tea.KeyUp)
+	if m.anim.Speed() <= speed || m.aimY != aimY {
+		t.Errorf("↑ under manual steering: speed %g, aim %g, want faster and the aim kept", m.anim.Speed(), m.aimY)
+	}
+	press(tea.KeyShiftUp)
+	press(tea.KeyRight)
+	if m.aimY >= aimY || m.aimX <= aimX {
+		t.Errorf("steering moved the aim to %g, %g from %g, %g, want up and right", m.aimX, m.aimY, aimX, aimY)
+	}
+}
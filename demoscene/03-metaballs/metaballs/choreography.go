@@ -0,0 +1,196 @@
+package metaballs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// A choreography is the balls' every move over a stretch of time, recorded
+// as keyframes a tenth of a second apart and saved as JSON. Played back, it
+// puts the balls where they were at each moment, whatever the frame rate,
+// so a sequence can be worked out once and shown the same every time.
+
+// keyframeStep is the time between keyframes, in seconds of animation.
+const keyframeStep = 0.1
+
+// choreography is a recorded sequence.
+type choreography struct {
+	Threshold float64    `json:"threshold"`
+	Keyframes []keyframe `json:"keyframes"`
+}
+
+// keyframe is every ball at a moment, T seconds in.
+type keyframe struct {
+	T     float64     `json:"t"`
+	Time  float64     `json:"time"` // the model's clock, which colors use
+	Balls []ballState `json:"balls"`
+}
+
+// ballState is a ball as it is saved. Velocities are in cells a frame.
+type ballState struct {
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+	Z        float64 `json:"z,omitempty"`
+	VX       float64 `json:"vx"`
+	VY       float64 `json:"vy"`
+	Radius   float64 `json:"radius"`
+	Strength float64 `json:"strength"`
+	Phase    float64 `json:"phase"`
+	Color    string  `json:"color"`
+}
+
+// take is a recording in progress.
+type take struct {
+	choreography
+	start float64 // when it began, by the animator's clock
+}
+
+// Duration is how long the choreography runs.
+func (c *choreography) Duration() float64 {
+	if len(c.Keyframes) == 0 {
+		return 0
+	}
+	return c.Keyframes[len(c.Keyframes)-1].T
+}
+
+// keyframe captures the balls as they are now.
+func (m model) keyframe(t float64) keyframe {
+	k := keyframe{T: t, Time: m.time}
+	for _, b := range m.metaballs {
+		k.Balls = append(k.Balls, ballState{
+			X: b.x, Y: b.y, Z: b.z, VX: b.vx, VY: b.vy,
+			Radius: b.radius, Strength: b.strength, Phase: b.colorPhase,
+			Color: b.color.Hex(),
+		})
+	}
+	return k
+}
+
+// record adds a keyframe to the take whenever one is due.
+func (m *model) record() {
+	t := m.anim.Elapsed() - m.recording.start
+	n := len(m.recording.Keyframes)
+	if n == 0 || t >= m.recording.Keyframes[n-1].T+keyframeStep-1e-9 {
+		m.recording.Keyframes = append(m.recording.Keyframes, m.keyframe(t))
+	}
+}
+
+// saveTake ends the recording and writes it to a file named for the time
+// in the current directory, returning the file's path.
+func (m *model) saveTake() (string, error) {
+	tk := m.recording
+	m.recording = nil
+	tk.Keyframes = append(tk.Keyframes, m.keyframe(m.anim.Elapsed()-tk.start))
+	tk.Threshold = m.threshold
+	data, err := json.MarshalIndent(tk.choreography, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := fmt.Sprintf("metaballs-%s.json", time.Now().Format("20060102-150405"))
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// loadChoreography reads a choreography saved by a recording.
+func loadChoreography(path string) (*choreography, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c choreography
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(c.Keyframes) == 0 {
+		return nil, fmt.Errorf("%s: no keyframes", path)
+	}
+	for i, k := range c.Keyframes {
+		if len(k.Balls) == 0 {
+			return nil, fmt.Errorf("%s: keyframe %d has no balls", path, i+1)
+		}
+		if i > 0 && k.T < c.Keyframes[i-1].T {
+			return nil, fmt.Errorf("%s: keyframe %d is out of order", path, i+1)
+		}
+	}
+	return &c, nil
+}
+
+// at returns the balls and the clock t seconds into the choreography.
+//
+// Between two keyframes with the same balls, each ball follows the cubic
+// that leaves the first at its velocity there and arrives at the second at
+// its velocity there, so the motion is as smooth as it was recorded. Where a
+// ball was added or deleted between them, the balls jump to the second.
+func (c *choreography) at(t float64) ([]metaball, float64) {
+	ks := c.Keyframes
+	i := 0
+	for i < len(ks)-2 && ks[i+1].T <= t {
+		i++
+	}
+	if len(ks) == 1 {
+		return ks[0].metaballs(), ks[0].Time
+	}
+	k0, k1 := ks[i], ks[i+1]
+	span := k1.T - k0.T
+	if len(k0.Balls) != len(k1.Balls) || span <= 0 {
+		return k1.metaballs(), k1.Time
+	}
+	s := common.Clamp((t-k0.T)/span, 0, 1)
+	// Velocities are per frame; over the span they move this much
+	frames := span * engine.DefaultFPS
+	h00 := 2*s*s*s - 3*s*s + 1
+	h10 := s*s*s - 2*s*s + s
+	h01 := -2*s*s*s + 3*s*s
+	h11 := s*s*s - s*s
+	// Weighted so the ends give the keyframes' values exactly
+	lerp := func(a, b float64) float64 { return a*(1-s) + b*s }
+
+	balls := k0.metaballs()
+	for j := range balls {
+		a, b := k0.Balls[j], k1.Balls[j]
+		balls[j].x = h00*a.X + h10*frames*a.VX + h01*b.X + h11*frames*b.VX
+		balls[j].y = h00*a.Y + h10*frames*a.VY + h01*b.Y + h11*frames*b.VY
+		balls[j].z = lerp(a.Z, b.Z)
+		balls[j].vx, balls[j].vy = lerp(a.VX, b.VX), lerp(a.VY, b.VY)
+		balls[j].radius = lerp(a.Radius, b.Radius)
+		balls[j].strength = lerp(a.Strength, b.Strength)
+	}
+	return balls, lerp(k0.Time, k1.Time)
+}
+
+// metaballs turns a keyframe's balls back into metaballs.
+func (k keyframe) metaballs() []metaball {
+	balls := make([]metaball, len(k.Balls))
+	for i, b := range k.Balls {
+		balls[i] = metaball{
+			x: b.X, y: b.Y, z: b.Z, vx: b.VX, vy: b.VY,
+			radius: b.Radius, strength: b.Strength, colorPhase: b.Phase,
+			color: common.ParseHex(b.Color),
+		}
+	}
+	return balls
+}
+
+// replayFrame moves the balls to where the choreography has them, starting
+// it over once it ends.
+func (m *model) replayFrame() {
+	t := m.anim.Elapsed() - m.replayStart
+	if d := m.replay.Duration(); t > d {
+		m.replayStart = m.anim.Elapsed()
+		t = 0
+	}
+	m.metaballs, m.time = m.replay.at(t)
+}
+
+// replayTime is how far into the choreography the replay is.
+func (m model) replayTime() float64 {
+	return math.Max(m.anim.Elapsed()-m.replayStart, 0)
+}
@@ -0,0 +1,126 @@
+package metaballs
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ball is a saved ball at x, y moving at vx cells a frame.
+func ball(x, y, vx float64) ballState {
+	return ballState{X: x, Y: y, VX: vx, Radius: 4, Strength: 1, Color: "#ff4080"}
+}
+
+func TestChoreographyRoundTrip(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(dir)
+
+	m := initialModel()
+	m.recording = &take{start: -0.2}
+	tk := m.recording
+	tk.Keyframes = append(tk.Keyframes, m.keyframe(0))
+	m.metaballs[0].x += 3
+	m.time += 0.5
+	tk.Keyframes = append(tk.Keyframes, m.keyframe(0.1))
+	path, err := m.saveTake()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.recording != nil {
+		t.Error("saving left the take recording")
+	}
+	c, err := loadChoreography(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Keyframes) != 3 || c.Duration() != 0.2 {
+		t.Fatalf("loaded %d keyframes over %gs, want 3 over 0.2s", len(c.Keyframes), c.Duration())
+	}
+	if !reflect.DeepEqual(*c, tk.choreography) {
+		t.Errorf("loaded\n%+v\nwant\n%+v", *c, tk.choreography)
+	}
+	if c.Threshold != m.threshold {
+		t.Errorf("threshold %g, want %g", c.Threshold, m.threshold)
+	}
+
+	// The ends of the replay are the first and last keyframes exactly
+	for _, k := range []keyframe{c.Keyframes[0], c.Keyframes[2]} {
+		balls, clock := c.at(k.T)
+		if !reflect.DeepEqual(balls, k.metaballs()) || clock != k.Time {
+			t.Errorf("at(%g) = %+v, %g, want %+v, %g", k.T, balls, clock, k.metaballs(), k.Time)
+		}
+	}
+}
+
+func TestChoreographyAt(t *testing.T) {
+	// Over the 0.1s between keyframes 3 frames pass at the default rate
+	tests := []struct {
+		name   string
+		a, b   ballState
+		t      float64
+		wantX  float64
+		wantVX float64
+	}{
+		{"start", ball(0, 5, 0), ball(10, 5, 0), 0, 0, 0},
+		{"middle at rest", ball(0, 5, 0), ball(10, 5, 0), 0.05, 5, 0},
+		{"eased in", ball(0, 5, 0), ball(10, 5, 0), 0.025, 1.5625, 0},
+		{"end", ball(0, 5, 0), ball(10, 5, 0), 0.1, 10, 0},
+		{"steady", ball(0, 5, 10.0/3), ball(10, 5, 10.0/3), 0.025, 2.5, 10.0 / 3},
+		{"leaving fast", ball(0, 5, 4.0/3), ball(10, 5, 0), 0.05, 5.5, 2.0 / 3},
+	}
+	for _, tt := range tests {
+		c := choreography{Keyframes: []keyframe{
+			{T: 0, Time: 1, Balls: []ballState{tt.a}},
+			{T: 0.1, Time: 2, Balls: []ballState{tt.b}},
+		}}
+		balls, _ := c.at(tt.t)
+		if len(balls) != 1 {
+			t.Fatalf("%s: %d balls, want 1", tt.name, len(balls))
+		}
+		if b := balls[0]; math.Abs(b.x-tt.wantX) > 1e-9 || math.Abs(b.vx-tt.wantVX) > 1e-9 || b.y != 5 {
+			t.Errorf("%s: at(%g) is at %g, %g moving %g, want %g, 5 moving %g", tt.name, tt.t, b.x, b.y, b.vx, tt.wantX, tt.wantVX)
+		}
+	}
+}
+
+func TestChoreographyBallAdded(t *testing.T) {
+	c := choreography{Keyframes: []keyframe{
+		{T: 0, Time: 1, Balls: []ballState{ball(0, 0, 0)}},
+		{T: 0.1, Time: 2, Balls: []ballState{ball(10, 0, 0), ball(20, 0, 0)}},
+	}}
+	balls, clock := c.at(0.05)
+	if !reflect.DeepEqual(balls, c.Keyframes[1].metaballs()) || clock != 2 {
+		t.Errorf("at(0.05) = %+v, %g, want the second keyframe", balls, clock)
+	}
+}
+
+func TestLoadChoreographyErrors(t *testing.T) {
+	tests := []struct {
+		json string
+		want string
+	}{
+		{`{"keyframes": []}`, "no keyframes"},
+		{`{"keyframes": [{"t": 0, "balls": []}]}`, "keyframe 1 has no balls"},
+		{`{"keyframes": [{"t": 0.2, "balls": [{"x": 1}]}, {"t": 0.1, "balls": [{"x": 1}]}]}`, "keyframe 2 is out of order"},
+		{`{"keyframes": `, "unexpected end of JSON input"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "take.json")
+		if err := os.WriteFile(path, []byte(tt.json), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := loadChoreography(path)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("loading %s: %v, want %q", tt.json, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package metaballs
+
+import (
+	"math"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Marching squares samples the field at the corners of every cell rather
+// than its center. Each corner is inside or outside the blobs, and the four
+// of them give one of sixteen cases; a cell with some corners in and some
+// out has the blob's edge running through it, which is drawn with a line.
+//
+// Corners are numbered as bits of the case: top left 8, top right 4,
+// bottom right 2 and bottom left 1.
+
+// Where the edge only clips a corner, a rounded corner joining the two
+// sides it crosses is drawn instead of a line, indexed by the corner.
+var roundCorners = map[int]rune{
+	1: '╮', // left and bottom
+	2: '╭', // bottom and right
+	4: '╰', // top and right
+	8: '╯', // top and left
+}
+
+// renderContours draws the blobs with their outlines traced by marching
+// squares, filled as usual inside.
+func (m model) renderContours() []string {
+	// The field at the corners, which lie half a cell off the centers
+	corners := make([][]float64, m.height+1)
+	for y := range corners {
+		corners[y] = make([]float64, m.width+1)
+		for x := range corners[y] {
+			corners[y][x], _ = m.field(float64(x)-0.5, float64(y)-0.5)
+		}
+	}
+
+	lines := make([]string, m.height)
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		for x := 0; x < m.width; x++ {
+			totalStrength, colorInfluence := m.field(float64(x), float64(y))
+			tl, tr := corners[y][x], corners[y][x+1]
+			bl, br := corners[y+1][x], corners[y+1][x+1]
+			edge := m.edgeChar(tl, tr, br, bl, totalStrength)
+			if edge == 0 {
+				line.WriteString(m.fillCell(totalStrength, colorInfluence))
+				continue
+			}
+			normalizedStrength := common.Clamp((totalStrength-m.threshold)/(m.threshold*2), 0, 1)
+			style := canvas.Style{Fg: m.getMetaballColor(normalizedStrength, colorInfluence), Bold: true}
+			line.WriteString(style.Render(string(edge)))
+		}
+		lines[y] = line.String()
+	}
+	return lines
+}
+
+// edgeChar is the line the blob's edge makes through a cell with the field
+// tl, tr, br and bl at its corners and center at its middle, or 0 if the
+// cell is all inside or all outside.
+func (m model) edgeChar(tl, tr, br, bl, center float64) rune {
+	inside := func(v float64) int {
+		if v >= m.threshold {
+			return 1
+		}
+		return 0
+	}
+	c := inside(tl)<<3 | inside(tr)<<2 | inside(br)<<1 | inside(bl)
+	switch c {
+	case 0, 15:
+		return 0
+	case 5, 10:
+		// A saddle: the center says whether the two corners inside are
+		// joined across the cell or cut off from each other
+		if (c == 5) == (center >= m.threshold) {
+			return '╱'
+		}
+		return '╲'
+	}
+
+	// Where the edge crosses each side, from 0 at its top or left end to 1
+	// at its bottom or right one, found by interpolating the field
+	cross := func(a, b float64) float64 {
+		return (m.threshold - a) / (b - a)
+	}
+	top := [2]float64{cross(tl, tr), 0}
+	bottom := [2]float64{cross(bl, br), 1}
+	left := [2]float64{0, cross(tl, bl)}
+	right := [2]float64{1, cross(tr, br)}
+
+	// The ends of the edge, and the one corner on its own side of it
+	var p, q [2]float64
+	corner := c
+	switch c {
+	case 1, 14:
+		p, q, corner = left, bottom, 1
+	case 2, 13:
+		p, q, corner = bottom, right, 2
+	case 4, 11:
+		p, q, corner = top, right, 4
+	case 8, 7:
+		p, q, corner = top, left, 8
+	case 3, 12:
+		p, q = left, right
+	case 6, 9:
+		p, q = top, bottom
+	}
+
+	// An edge that only clips a corner, crossing both sides near it, is a
+	// rounded corner
+	if r, ok := roundCorners[corner]; ok {
+		cx, cy := 0.0, 0.0
+		if corner&(4|2) != 0 {
+			cx = 1
+		}
+		if corner&(2|1) != 0 {
+			cy = 1
+		}
+		if math.Abs(p[0]-cx)+math.Abs(p[1]-cy) < 0.5 && math.Abs(q[0]-cx)+math.Abs(q[1]-cy) < 0.5 {
+			return r
+		}
+	}
+
+	// Otherwise the line nearest the edge's slope, with rows counting
+	// double as they do on screen
+	dx, dy := q[0]-p[0], (q[1]-p[1])*2
+	angle := math.Atan2(math.Abs(dy), math.Abs(dx))
+	switch {
+	case angle < math.Pi/8:
+		return '─'
+	case angle > 3*math.Pi/8:
+		return '│'
+	case (dx > 0) == (dy > 0):
+		return '╲'
+	default:
+		return '╱'
+	}
+}
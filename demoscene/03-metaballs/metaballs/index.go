@@ -0,0 +1,99 @@
+package metaballs
+
+// Summing every ball at every cell costs cells times balls, which with
+// dozens of balls, and the many samples of hi-res and 3D, is too slow. A
+// ball's field falls off with the square of the distance, though, so far
+// from it its share is small and barely changes from one cell to the next.
+//
+// The screen is cut into bins. Balls within reach of a bin, where their
+// field there could come to more than a small fraction of the threshold,
+// are summed exactly at every point in it; the rest are summed only at the
+// bin's corners, and blended between them across the bin.
+
+const (
+	// binWidth and binHeight are a bin's size in cells, square on screen.
+	binWidth  = 8
+	binHeight = 4
+
+	// cutoff is the fraction of the threshold below which a ball's field
+	// counts as far.
+	cutoff = 1.0 / 32
+
+	// maxBalls is as many balls as a will add.
+	maxBalls = 48
+)
+
+// bin is the balls near one bin and what the far ones add at its corners:
+// top left, top right, bottom left and bottom right.
+type bin struct {
+	balls        []metaball
+	x0, y0       float64
+	far          [4]float64
+	farInfluence [4]influence
+}
+
+// ballIndex is the bins covering the screen, rebuilt for every frame.
+type ballIndex struct {
+	cols, rows int
+	bins       []bin
+}
+
+// index sorts the balls into bins for the frame about to be drawn.
+func (m model) index() *ballIndex {
+	cols := (m.width + binWidth - 1) / binWidth
+	rows := (m.height + binHeight - 1) / binHeight
+	idx := &ballIndex{cols: cols, rows: rows, bins: make([]bin, cols*rows)}
+	for by := 0; by < rows; by++ {
+		for bx := 0; bx < cols; bx++ {
+			b := &idx.bins[by*cols+bx]
+			// The bin's extent in cells, counting the half cell past the
+			// first and last centers that hi-res and outlines sample
+			x0, x1 := float64(bx*binWidth)-0.5, float64((bx+1)*binWidth)-0.5
+			y0, y1 := float64(by*binHeight)-0.5, float64((by+1)*binHeight)-0.5
+			b.x0, b.y0 = x0, y0
+			corners := [4][2]float64{{x0, y0}, {x1, y0}, {x0, y1}, {x1, y1}}
+			for _, ball := range m.metaballs {
+				// The nearest the ball comes to the bin, rows doubled
+				dx := max(x0-ball.x, 0, ball.x-x1)
+				dy := max(y0-ball.y, 0, ball.y-y1) * 2
+				reach := ball.strength * ball.radius * ball.radius / (cutoff * m.threshold)
+				if dx*dx+dy*dy < reach {
+					b.balls = append(b.balls, ball)
+					continue
+				}
+				for i, c := range corners {
+					dx, dy := c[0]-ball.x, (c[1]-ball.y)*2
+					strength := ball.strength * ball.radius * ball.radius / (dx*dx + dy*dy)
+					b.far[i] += strength
+					b.farInfluence[i].add(ball, strength, m.threshold)
+				}
+			}
+		}
+	}
+	return idx
+}
+
+// at returns the bin a point in cells lies in, or the nearest one.
+func (idx *ballIndex) at(x, y float64) *bin {
+	bx := min(max(int(x+0.5)/binWidth, 0), idx.cols-1)
+	by := min(max(int(y+0.5)/binHeight, 0), idx.rows-1)
+	return &idx.bins[by*idx.cols+bx]
+}
+
+// farAt is what the far balls add at a point in the bin, blended from the
+// corners.
+func (b *bin) farAt(x, y float64) (float64, influence) {
+	u := min(max((x-b.x0)/binWidth, 0), 1)
+	v := min(max((y-b.y0)/binHeight, 0), 1)
+	w := [4]float64{(1 - u) * (1 - v), u * (1 - v), (1 - u) * v, u * v}
+	var total float64
+	var in influence
+	for i := range w {
+		total += w[i] * b.far[i]
+		in.phase += w[i] * b.farInfluence[i].phase
+		in.r += w[i] * b.farInfluence[i].r
+		in.g += w[i] * b.farInfluence[i].g
+		in.b += w[i] * b.farInfluence[i].b
+	}
+	return total, in
+}
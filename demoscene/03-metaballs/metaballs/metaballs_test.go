@@ -0,0 +1,32 @@
+package metaballs
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/golden"
+)
+
+func TestFrames(t *testing.T) {
+	golden.Check(t, func() tea.Model { return initialModel() }, 1, 45)
+}
+
+func TestDragWhilePaused(t *testing.T) {
+	m := initialModel()
+	m.anim.Pause()
+	ball := m.metaballs[0]
+	x, y := int(ball.x), int(ball.y)+fieldTop
+	m = m.mouse(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if m.held != 0 {
+		t.Fatalf("pressing on ball 0 held %d", m.held)
+	}
+	m = m.mouse(tea.MouseMsg{X: x + 10, Y: y + 2, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+	got := m.metaballs[0]
+	if dx, dy := got.x-ball.x, got.y-ball.y; dx != 10 || dy != 2 {
+		t.Errorf("dragging while paused moved the ball by %g, %g, want 10, 2", dx, dy)
+	}
+	m = m.mouse(tea.MouseMsg{X: x + 10, Y: y + 2, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	if got := m.metaballs[0]; m.held != -1 || got.vx != 0 || got.vy != 0 || got.fling != 0 {
+		t.Errorf("letting go while paused left held %d and speed %g, %g, fling %g", m.held, got.vx, got.vy, got.fling)
+	}
+}
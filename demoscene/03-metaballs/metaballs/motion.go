@@ -0,0 +1,134 @@
+package metaballs
+
+import (
+	"math"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/physics"
+)
+
+// motion is a way for the balls to move. Given the model it returns the
+// acceleration of each ball, in cells a frame per frame, before the walls
+// and the speed limit have their say.
+//
+// Forces are worked out with rows counting double, as the field is, so the
+// balls pull on each other the same in every direction on screen.
+type motion struct {
+	name  string
+	accel func(m model) []physics.Vec
+}
+
+// motions are the physics modes p cycles through.
+var motions = []motion{
+	{"Drift", drift},
+	{"Gravity", gravity},
+	{"Springs", springs},
+	{"Orbit", orbit},
+}
+
+const (
+	// gravityG scales the balls' pull on each other, whose masses are
+	// their strength times their radius squared, and softening keeps the
+	// pull finite as two balls pass through each other.
+	gravityG  = 0.5
+	softening = 16.0
+
+	// springRest is how close balls come before they push each other off,
+	// with a stiffness of springK, and centering pulls them all gently
+	// back to the middle.
+	springRest = 24.0
+	springK    = 0.004
+	centering  = 0.0005
+
+	// orbitGM is the pull of the unseen mass the balls circle in orbit
+	// mode, at the middle of the screen.
+	orbitGM = 12.0
+)
+
+// motionByName returns the index of the named motion, or 0 for Drift.
+func motionByName(name string) int {
+	for i, mo := range motions {
+		if strings.EqualFold(mo.name, name) {
+			return i
+		}
+	}
+	return 0
+}
+
+// offset is the vector from a ball to a point, with rows doubled.
+func offset(ball metaball, x, y float64) physics.Vec {
+	return physics.Vec{X: x - ball.x, Y: (y - ball.y) * 2}
+}
+
+// onScreen turns an acceleration worked out with rows doubled back into
+// cells.
+func onScreen(a physics.Vec) physics.Vec {
+	return physics.Vec{X: a.X, Y: a.Y / 2}
+}
+
+// drift is the original motion: the balls sail straight and are nudged by
+// slow sines.
+func drift(m model) []physics.Vec {
+	acc := make([]physics.Vec, len(m.metaballs))
+	for i, ball := range m.metaballs {
+		acc[i] = physics.Vec{
+			X: math.Sin(m.time*0.7+ball.colorPhase) * 0.05,
+			Y: math.Cos(m.time*0.8+ball.colorPhase) * 0.05,
+		}
+	}
+	return acc
+}
+
+// gravity pulls every ball towards every other, more the heavier and the
+// closer the other is, so they fall together, swing past and clump.
+func gravity(m model) []physics.Vec {
+	acc := make([]physics.Vec, len(m.metaballs))
+	for i, a := range m.metaballs {
+		for j, b := range m.metaballs {
+			if i == j {
+				continue
+			}
+			d := offset(a, b.x, b.y)
+			r2 := d.Dot(d) + softening
+			mass := b.strength * b.radius * b.radius
+			acc[i] = acc[i].Add(d.Scale(gravityG * mass / (r2 * math.Sqrt(r2))))
+		}
+		acc[i] = onScreen(acc[i])
+	}
+	return acc
+}
+
+// springs pushes balls that come too close apart, as though on a spring
+// between them, and pulls them all towards the middle, so they jostle and
+// wobble about a loose cluster.
+func springs(m model) []physics.Vec {
+	acc := make([]physics.Vec, len(m.metaballs))
+	cx, cy := float64(m.width)/2, float64(m.height)/2
+	for i, a := range m.metaballs {
+		for j, b := range m.metaballs {
+			if i == j {
+				continue
+			}
+			d := offset(a, b.x, b.y)
+			if l := d.Len(); l < springRest {
+				acc[i] = acc[i].Sub(d.Normalize().Scale(springK * (springRest - l)))
+			}
+		}
+		acc[i] = acc[i].Add(offset(a, cx, cy).Scale(centering))
+		acc[i] = onScreen(acc[i])
+	}
+	return acc
+}
+
+// orbit pulls every ball towards a heavy mass at the middle of the screen,
+// which they swing round in ellipses.
+func orbit(m model) []physics.Vec {
+	acc := make([]physics.Vec, len(m.metaballs))
+	cx, cy := float64(m.width)/2, float64(m.height)/2
+	for i, ball := range m.metaballs {
+		d := offset(ball, cx, cy)
+		r2 := d.Dot(d) + softening
+		acc[i] = onScreen(d.Scale(orbitGM / (r2 * math.Sqrt(r2))))
+	}
+	return acc
+}
@@ -0,0 +1,144 @@
+package metaballs
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// In 3D the balls are spheres, each bobbing towards and away from the
+// viewer, and the field is summed in space rather than on the screen. A ray
+// is marched into the screen from every cell until the field reaches the
+// threshold; that point is on the surface, which is shaded by how it faces
+// the light.
+//
+// Space is measured in columns, with rows counting double so the spheres
+// come out round, and z running into the screen.
+
+const (
+	// The rays start depthRange in front of the screen and stop as far
+	// behind it, taking marchSteps steps between and refineSteps halvings
+	// to close in on the surface once they pass it.
+	depthRange  = 18.0
+	marchSteps  = 36
+	refineSteps = 4
+
+	// bobDepth is how far the balls move towards and away from the viewer.
+	bobDepth = 6.0
+
+	ambient   = 0.15
+	shininess = 24.0
+)
+
+// The direction the light comes from, up, left and in front of the screen,
+// and the halfway vector between it and the viewer for the highlights.
+var (
+	lightDir = normalize(-0.5, -0.7, -1)
+	halfway  = normalize(lightDir[0], lightDir[1], lightDir[2]-1)
+)
+
+func normalize(x, y, z float64) [3]float64 {
+	l := math.Sqrt(x*x + y*y + z*z)
+	return [3]float64{x / l, y / l, z / l}
+}
+
+// field3D returns the field at a point in space, the balls' influence on
+// its color and its gradient.
+func (m model) field3D(x, y, z float64) (float64, influence, [3]float64) {
+	total := 0.0
+	var in influence
+	var grad [3]float64
+	balls := m.metaballs
+	if m.near != nil {
+		// Far balls add too little to bend the surface; only their field
+		// in the plane of the screen is counted
+		b := m.near.at(x, y)
+		total, in = b.farAt(x, y)
+		balls = b.balls
+	}
+	for _, ball := range balls {
+		dx := x - ball.x
+		dy := (y - ball.y) * 2
+		dz := z - ball.z
+		d2 := dx*dx + dy*dy + dz*dz
+		if d2 == 0 {
+			continue
+		}
+		strength := ball.strength * ball.radius * ball.radius / d2
+		total += strength
+		in.add(ball, strength, m.threshold)
+		// The derivative of s·r²/d² is −2·s·r²·d/d⁴
+		k := -2 * strength / d2
+		grad[0] += k * dx
+		grad[1] += k * dy
+		grad[2] += k * dz
+	}
+	return total, in, grad
+}
+
+// surface marches a ray into the screen at (x, y) in cells and returns the
+// shaded color of the blob it hits, or false if it misses them all.
+func (m model) surface(x, y float64) (lipgloss.Color, bool) {
+	// Along the ray each ball's field is at most what it is in the plane
+	// of the screen, so where that is under the threshold the ray misses
+	if f, _ := m.field(x, y); f < m.threshold {
+		return "", false
+	}
+	step := 2 * depthRange / marchSteps
+	z := -depthRange
+	for i := 0; i <= marchSteps; i++ {
+		if f, _, _ := m.field3D(x, y, z); f >= m.threshold {
+			// The surface lies in the last step; halve it to find it
+			lo, hi := z-step, z
+			for j := 0; j < refineSteps; j++ {
+				mid := (lo + hi) / 2
+				if f, _, _ := m.field3D(x, y, mid); f >= m.threshold {
+					hi = mid
+				} else {
+					lo = mid
+				}
+			}
+			return m.shadeSurface(m.field3D(x, y, hi)), true
+		}
+		z += step
+	}
+	return "", false
+}
+
+// shadeSurface lights a point on the surface. The field falls away from
+// the blobs, so the normal is the opposite of its gradient; the light is
+// diffuse, plus a Blinn-Phong highlight for the gloss.
+func (m model) shadeSurface(_ float64, in influence, grad [3]float64) lipgloss.Color {
+	n := normalize(-grad[0], -grad[1], -grad[2])
+	diffuse := math.Max(0, n[0]*lightDir[0]+n[1]*lightDir[1]+n[2]*lightDir[2])
+	spec := math.Pow(math.Max(0, n[0]*halfway[0]+n[1]*halfway[1]+n[2]*halfway[2]), shininess)
+
+	// Graded to a few levels to keep the number of colors down
+	diffuse = math.Round(diffuse*16) / 16
+	spec = math.Round(spec*16) / 16
+
+	base := common.ParseHex(string(m.getMetaballColor(diffuse, in)))
+	lit := common.LerpRGB(common.RGB{}, base, ambient+(1-ambient)*diffuse)
+	return common.LerpRGB(lit, common.RGB{R: 255, G: 255, B: 255}, spec).Color()
+}
+
+// renderShaded draws the blobs in 3D, a block of the surface's color to a
+// cell.
+func (m model) renderShaded() []string {
+	lines := make([]string, m.height)
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		for x := 0; x < m.width; x++ {
+			if color, ok := m.surface(float64(x), float64(y)); ok {
+				line.WriteString(canvas.Style{Fg: color}.Render("█"))
+			} else {
+				line.WriteString(" ")
+			}
+		}
+		lines[y] = line.String()
+	}
+	return lines
+}
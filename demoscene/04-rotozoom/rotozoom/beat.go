@@ -0,0 +1,49 @@
+package rotozoom
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// With music playing the rotozoomer moves to it: every beat kicks the spin
+// faster and bounces the zoom in, and both settle back before the next. A
+// beat kicks as hard as it is strong, times the sensitivity, so soft beats
+// can be made to count or left out.
+
+const (
+	// kickSpin is how many times faster than usual a full kick turns the
+	// texture, and kickZoom how far in it bounces the zoom.
+	kickSpin = 4.0
+	kickZoom = 0.3
+
+	// kickDecay is how much of a kick is left after a frame.
+	kickDecay = 0.85
+
+	// The sensitivity goes up and down by sensitivityStep between
+	// minSensitivity and maxSensitivity.
+	sensitivityStep = 0.25
+	minSensitivity  = 0.25
+	maxSensitivity  = 3.0
+)
+
+// kickBy starts a kick of the given strength, from 0 for none to 1 for a
+// strong beat, unless one at least as hard is still going.
+func (m *model) kickBy(strength float64) {
+	m.kick = math.Max(m.kick, common.Clamp(strength*m.sensitivity, 0, 1))
+}
+
+// kicked is how much of the last kick is left, which reduced motion turns
+// off.
+func (m model) kicked() float64 {
+	if engine.ReducedMotion() {
+		return 0
+	}
+	return m.kick
+}
+
+// bounce is what the kick multiplies the zoom by.
+func (m model) bounce() float64 {
+	return 1 + kickZoom*m.kicked()
+}
@@ -0,0 +1,217 @@
+package rotozoom
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+)
+
+// A Pattern is a texture the rotozoomer can spin: a color and a glyph for
+// every point of an endless plane. x and y are in texture units, a column
+// wide and half a row high, and t is the animation's clock in seconds, for
+// patterns that move.
+//
+// Patterns are picked with the number keys, in the order they were added.
+// A new one needs only a file of its own that registers it:
+//
+//	func init() {
+//		RegisterPattern("Plasma", PatternFunc(plasma))
+//	}
+type Pattern interface {
+	Sample(x, y, t float64) (rune, lipgloss.Color)
+}
+
+// PatternFunc lets an ordinary function be a Pattern.
+type PatternFunc func(x, y, t float64) (rune, lipgloss.Color)
+
+// Sample calls f.
+func (f PatternFunc) Sample(x, y, t float64) (rune, lipgloss.Color) {
+	return f(x, y, t)
+}
+
+// namedPattern is a pattern and what the status line calls it.
+type namedPattern struct {
+	name string
+	Pattern
+}
+
+// spriteTile is the pattern that tiles a sprite, the invaders unless
+// another texture was loaded. The model samples it itself, since its
+// texture and tiling are the model's.
+const spriteTile = 5
+
+// maxPatterns is as many patterns as there are number keys.
+const maxPatterns = 9
+
+// patterns are the patterns in the order of their keys.
+var patterns = []namedPattern{
+	{"Checkerboard", PatternFunc(checkerboard)},
+	{"Stripes", PatternFunc(stripes)},
+	{"Dots", PatternFunc(dots)},
+	{"Mandala", PatternFunc(mandala)},
+	{"Circuit", PatternFunc(circuit)},
+	{"Invaders", nil},
+}
+
+// RegisterPattern adds a pattern under the next number key. It is meant to
+// be called from an init function, and panics if the name is taken or the
+// keys have run out.
+func RegisterPattern(name string, p Pattern) {
+	for _, other := range patterns {
+		if other.name == name {
+			panic("rotozoom: pattern " + name + " registered twice")
+		}
+	}
+	if len(patterns) == maxPatterns {
+		panic("rotozoom: no key left for pattern " + name)
+	}
+	patterns = append(patterns, namedPattern{name, p})
+}
+
+// patternKey is the binding for the number keys of the patterns there are.
+func patternKey() key.Binding {
+	digits := make([]string, len(patterns))
+	for i := range digits {
+		digits[i] = fmt.Sprint(i + 1)
+	}
+	return keymap.New(fmt.Sprintf("1-%d", len(patterns)), "patterns", digits...)
+}
+
+func checkerboard(x, y, t float64) (rune, lipgloss.Color) {
+	tileSize := 4.0
+	tileX := int(math.Floor(x / tileSize))
+	tileY := int(math.Floor(y / tileSize))
+
+	if (tileX+tileY)%2 == 0 {
+		return '█', lipgloss.Color("#FFFFFF")
+	} else {
+		return '█', lipgloss.Color("#000000")
+	}
+}
+
+func stripes(x, y, t float64) (rune, lipgloss.Color) {
+	stripeWidth := 3.0
+	stripeIndex := int(math.Floor(x / stripeWidth))
+
+	colors := []lipgloss.Color{
+		lipgloss.Color("#FF0000"),
+		lipgloss.Color("#00FF00"),
+		lipgloss.Color("#0000FF"),
+		lipgloss.Color("#FFFF00"),
+		lipgloss.Color("#FF00FF"),
+		lipgloss.Color("#00FFFF"),
+	}
+
+	colorIndex := stripeIndex % len(colors)
+	if colorIndex < 0 {
+		colorIndex += len(colors)
+	}
+
+	return '█', colors[colorIndex]
+}
+
+func dots(x, y, t float64) (rune, lipgloss.Color) {
+	gridSize := 6.0
+	dotRadius := 2.0
+
+	// Find grid position
+	gridX := math.Mod(x, gridSize)
+	gridY := math.Mod(y, gridSize)
+
+	// Distance from grid center
+	centerX := gridSize / 2
+	centerY := gridSize / 2
+	distance := math.Sqrt((gridX-centerX)*(gridX-centerX) + (gridY-centerY)*(gridY-centerY))
+
+	if distance < dotRadius {
+		// Color based on position
+		colorValue := math.Sin(x*0.1) * math.Cos(y*0.1)
+		if colorValue > 0.3 {
+			return '●', lipgloss.Color("#FF4080")
+		} else if colorValue > -0.3 {
+			return '●', lipgloss.Color("#4080FF")
+		} else {
+			return '●', lipgloss.Color("#80FF40")
+		}
+	} else {
+		return ' ', lipgloss.Color("#000000")
+	}
+}
+
+func mandala(x, y, t float64) (rune, lipgloss.Color) {
+	// Distance from origin
+	distance := math.Sqrt(x*x + y*y)
+	// Angle from origin
+	angle := math.Atan2(y, x)
+
+	// Create mandala pattern
+	rings := math.Sin(distance * 0.3)
+	spokes := math.Sin(angle * 8)
+	pattern := rings * spokes
+
+	// Add time-based rotation
+	timePattern := math.Sin(distance*0.2-t*6) * math.Cos(angle*6+t*3)
+
+	combinedPattern := (pattern + timePattern) / 2
+
+	var char rune
+	var color lipgloss.Color
+
+	if combinedPattern > 0.6 {
+		char = '◆'
+		color = lipgloss.Color("#FFD700")
+	} else if combinedPattern > 0.2 {
+		char = '◇'
+		color = lipgloss.Color("#FF8000")
+	} else if combinedPattern > -0.2 {
+		char = '○'
+		color = lipgloss.Color("#FF4000")
+	} else if combinedPattern > -0.6 {
+		char = '∘'
+		color = lipgloss.Color("#800040")
+	} else {
+		char = ' '
+		color = lipgloss.Color("#000000")
+	}
+
+	return char, color
+}
+
+func circuit(x, y, t float64) (rune, lipgloss.Color) {
+	gridSize := 8.0
+	lineWidth := 1.0
+
+	// Grid coordinates
+	gridX := math.Mod(x, gridSize)
+	gridY := math.Mod(y, gridSize)
+
+	// Circuit board traces
+	isHorizontalTrace := math.Abs(gridY-gridSize/2) < lineWidth
+	isVerticalTrace := math.Abs(gridX-gridSize/2) < lineWidth
+
+	// Circuit pads at intersections
+	isNearCenter := math.Abs(gridX-gridSize/2) < lineWidth*2 && math.Abs(gridY-gridSize/2) < lineWidth*2
+
+	// Add some randomness based on position
+	hash := math.Sin(math.Floor(x/gridSize)*12.345 + math.Floor(y/gridSize)*67.890)
+
+	if isNearCenter && hash > 0.3 {
+		return '●', lipgloss.Color("#00FF80")
+	} else if isHorizontalTrace || isVerticalTrace {
+		if hash > 0 {
+			return '─', lipgloss.Color("#80FF80")
+		} else {
+			return '│', lipgloss.Color("#80FF80")
+		}
+	} else {
+		// Background with occasional components
+		if hash > 0.8 {
+			return '▪', lipgloss.Color("#404040")
+		} else {
+			return ' ', lipgloss.Color("#000000")
+		}
+	}
+}
@@ -0,0 +1,450 @@
+// Package rotozoom is rotating and zooming patterns in several styles.
+package rotozoom
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/audio"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+	"github.com/yourusername/bubbletea-showcase/common/sprite"
+)
+
+//go:embed doc.md
+var doc string
+
+//go:embed invader.txt
+var invaderSprite string
+
+type model struct {
+	width    int
+	height   int
+	time     float64
+	rotation float64
+	zoom     float64
+	offsetX  float64
+	offsetY  float64
+	pattern  int
+	anim     engine.Animator
+	texture  *sprite.Animation
+	// textureName is what the sprite pattern is called, after its file
+	textureName string
+	mirror      bool // flip every other tile of the sprite
+	layers      int  // how many of the layers are shown
+	smooth      bool // blend neighboring texels rather than take the nearest
+	// auto drives the motion by time; otherwise it is steered by hand, and
+	// dragging pans from where the pointer was last
+	auto         bool
+	dragging     bool
+	dragX, dragY int
+
+	// Music, when playing, kicks the spin and zoom on the beat
+	player      *audio.Player
+	song        string
+	rows        bool    // the music is a tracker module, timed by its rows
+	kick        float64 // what is left of the last beat's kick
+	sensitivity float64 // how hard beats kick
+}
+
+type keyMap struct {
+	Pattern key.Binding
+	Mirror  key.Binding
+	Layers  key.Binding
+	Smooth  key.Binding
+	Auto    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	ZoomIn  key.Binding
+	ZoomOut key.Binding
+	More    key.Binding
+	Less    key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Mirror:  keymap.New("m", "mirror tiles"),
+	Layers:  keymap.New("l", "layers"),
+	Smooth:  keymap.New("s", "smooth"),
+	Auto:    keymap.New("a", "manual control"),
+	Left:    keymap.New("←→", "rotate", "left"),
+	Right:   keymap.Hidden("right"),
+	ZoomIn:  keymap.New("↑↓", "zoom", "up"),
+	ZoomOut: keymap.Hidden("down"),
+	More:    keymap.New("+/-", "beat sensitivity", "+", "="),
+	Less:    keymap.Hidden("-"),
+	Common:  keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Pattern     int     `json:"pattern"`
+	Mirror      bool    `json:"mirror"`
+	Layers      int     `json:"layers"`
+	Smooth      bool    `json:"smooth"`
+	Auto        bool    `json:"auto"`
+	Sensitivity float64 `json:"sensitivity"`
+}
+
+// Under manual control the arrows turn by rotateStep and zoom by zoomStep
+// at a time, between minZoom and maxZoom.
+const (
+	rotateStep = math.Pi / 36
+	zoomStep   = 1.1
+	minZoom    = 0.2
+	maxZoom    = 5.0
+)
+
+// textureCols is the most columns a PNG texture is scaled down to.
+const textureCols = 48
+
+func initialModel() model {
+	texture, err := sprite.Parse(invaderSprite)
+	if err != nil {
+		panic(err)
+	}
+	p := prefs{Layers: 1, Auto: true, Sensitivity: 1}
+	settings.Load("rotozoom", &p)
+	return model{
+		width:       80,
+		height:      24,
+		zoom:        1.0,
+		pattern:     min(max(p.Pattern, 0), len(patterns)-1),
+		anim:        engine.New(engine.SharedFPS),
+		texture:     texture,
+		textureName: patterns[spriteTile].name,
+		mirror:      p.Mirror,
+		layers:      min(max(p.Layers, 1), len(layers)),
+		smooth:      p.Smooth,
+		auto:        p.Auto,
+		sensitivity: common.Clamp(p.Sensitivity, minSensitivity, maxSensitivity),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+// NewWithTexture returns the demo spinning the texture in the file at path,
+// sprite text or a PNG, in place of the invaders.
+func NewWithTexture(path string) (tea.Model, error) {
+	m := initialModel()
+	if err := m.loadTexture(path); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NewWithMusic returns the demo's model looping the WAV, Ogg Vorbis, MOD or
+// XM file at path and spinning in time with it, and if texture is not
+// empty, spinning that file as NewWithTexture does. Stop the player when
+// the demo ends.
+func NewWithMusic(path, texture string) (tea.Model, *audio.Player, error) {
+	m := initialModel()
+	if texture != "" {
+		if err := m.loadTexture(texture); err != nil {
+			return nil, nil, err
+		}
+	}
+	player, err := audio.PlayFile(path, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.player, m.song = player, filepath.Base(path)
+	return m, player, nil
+}
+
+// loadTexture loads the sprite text or PNG at path in place of the invaders
+// and picks it.
+func (m *model) loadTexture(path string) error {
+	texture, err := sprite.LoadFile(path, textureCols)
+	if err != nil {
+		return err
+	}
+	m.texture = texture
+	if name := sprite.Name(path); name != "" {
+		m.textureName = name
+	}
+	m.pattern = spriteTile
+	return nil
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "04-rotozoom",
+		Heading:  "🌀 Rotozoom",
+		Summary:  "Rotating and zooming patterns with 5 different styles",
+		Section:  registry.Demoscene,
+		Keywords: []string{"demoscene", "rotate", "zoom", "texture", "sprite"},
+		Manual:   doc,
+		Build:    New,
+		Opts:     []tea.ProgramOption{tea.WithMouseAllMotion()},
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "rotozoom", prefs{
+		Pattern:     m.pattern,
+		Mirror:      m.mirror,
+		Layers:      m.layers,
+		Smooth:      m.smooth,
+		Auto:        m.auto,
+		Sensitivity: m.sensitivity,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	if m.player != nil {
+		return tea.Batch(m.anim.Tick(), m.player.Listen())
+	}
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.1 * m.anim.Delta()
+			m.kick *= math.Pow(kickDecay, m.anim.Delta())
+			if m.auto {
+				m.rotation += 0.02 * m.anim.Delta() * (1 + kickSpin*m.kicked())
+				m.zoom = 1.0 + math.Sin(m.time*0.3)*0.8
+				m.offsetX = math.Sin(m.time*0.15) * 20
+				m.offsetY = math.Cos(m.time*0.2) * 15
+			}
+		}
+		return m, cmd
+
+	case tea.MouseMsg:
+		return m.mouse(msg), nil
+
+	case audio.RowMsg:
+		// Kick hard on the first row of each bar, softly on the other
+		// beats that strike a note
+		switch {
+		case msg.Row%16 == 0:
+			m.kickBy(1)
+		case msg.Row%4 == 0 && len(msg.Notes) > 0:
+			m.kickBy(0.5)
+		}
+		m.rows = true
+		return m, m.player.Listen()
+
+	case audio.BeatMsg:
+		if !m.rows {
+			m.kickBy(msg.Strength - 1)
+		}
+		return m, m.player.Listen()
+
+	case audio.EnergyMsg:
+		return m, m.player.Listen()
+
+	case audio.DoneMsg:
+		m.player = nil
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.time = 0
+			m.anim.Reset()
+			m.rotation = 0
+			m.zoom = 1.0
+			m.offsetX = 0
+			m.offsetY = 0
+		case key.Matches(msg, patternKey()):
+			m.pattern = int(msg.String()[0] - '1')
+		case key.Matches(msg, keys.Auto):
+			m.auto = !m.auto
+		case !m.auto && key.Matches(msg, keys.Left):
+			m.rotation -= rotateStep
+		case !m.auto && key.Matches(msg, keys.Right):
+			m.rotation += rotateStep
+		case !m.auto && key.Matches(msg, keys.ZoomIn):
+			m.zoom = math.Min(m.zoom*zoomStep, maxZoom)
+		case !m.auto && key.Matches(msg, keys.ZoomOut):
+			m.zoom = math.Max(m.zoom/zoomStep, minZoom)
+		case key.Matches(msg, keys.Layers):
+			m.layers = m.layers%len(layers) + 1
+		case m.player != nil && key.Matches(msg, keys.More):
+			m.sensitivity = math.Min(m.sensitivity+sensitivityStep, maxSensitivity)
+		case m.player != nil && key.Matches(msg, keys.Less):
+			m.sensitivity = math.Max(m.sensitivity-sensitivityStep, minSensitivity)
+		case key.Matches(msg, keys.Smooth):
+			m.smooth = !m.smooth
+		case (m.pattern == spriteTile || m.layers == len(layers)) && key.Matches(msg, keys.Mirror):
+			m.mirror = !m.mirror
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	k := keys
+	k.Pattern = patternKey()
+	// The arrows steer under manual control only
+	if !m.auto {
+		k.Auto.SetHelp("a", "auto")
+	}
+	k.Left.SetEnabled(!m.auto)
+	k.ZoomIn.SetEnabled(!m.auto)
+	// Sensitivity only matters with music; without it + and - are left
+	// to change the frame rate
+	k.More.SetEnabled(m.player != nil)
+	k.Less.SetEnabled(m.player != nil)
+	// The nearest layer is the sprite too
+	k.Mirror.SetEnabled(m.pattern == spriteTile || m.layers == len(layers))
+	return keymap.Of(k)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#FF8000")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🌀 Rotozoom Effect")
+	if m.player != nil {
+		title += lipgloss.NewStyle().Faint(true).Render(fmt.Sprintf("  ♪ %s, sensitivity %.2f", m.song, m.sensitivity))
+	}
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Orange)
+	pattern := patterns[m.pattern].name
+	if m.pattern == spriteTile {
+		pattern = m.textureName
+		if m.mirror {
+			pattern += ", mirrored"
+		}
+	}
+	if m.smooth {
+		pattern += ", smoothed"
+	}
+	status := statusStyle.Render(fmt.Sprintf(
+		"Pattern: %s | Layers: %d | Rotation: %.1f° | Zoom: %.2fx | %s",
+		pattern, m.layers, m.rotation*180/math.Pi, m.zoom,
+		m.state(),
+	))
+
+	// Render rotozoom
+	lines := m.renderRotozoom()
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s",
+		title, status, strings.Join(lines, "\n"), help)
+}
+
+func (m model) renderRotozoom() []string {
+	lines := make([]string, m.height)
+	centerX := float64(m.width) / 2
+	centerY := float64(m.height) / 2
+
+	// Precompute each layer's rotation matrix
+	placements := m.placements()
+
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		for x := 0; x < m.width; x++ {
+			// Transform screen coordinates to texture coordinates
+			screenX := float64(x) - centerX
+			screenY := (float64(y) - centerY) * 2 // Adjust for character aspect ratio
+
+			// Sample the layers from the front, down to the first that
+			// is not a hole here
+			for i := len(placements) - 1; i >= 0; i-- {
+				char, style := m.sample(placements[i], screenX, screenY)
+				if char != " " || i == 0 {
+					line.WriteString(style.Render(char))
+					break
+				}
+			}
+		}
+		lines[y] = line.String()
+	}
+
+	return lines
+}
+
+func (m model) samplePattern(pattern int, x, y float64) (string, canvas.Style) {
+	if pattern == spriteTile {
+		// Sprites bring their own backgrounds
+		return m.spritePattern(x, y)
+	}
+	char, color := patterns[pattern].Sample(x, y, m.anim.Elapsed())
+	return string(char), canvas.Style{Fg: color}
+}
+
+func (m model) spritePattern(x, y float64) (string, canvas.Style) {
+	// Texture y runs at twice the row rate to correct the aspect ratio
+	frame := m.texture.At(m.anim.Elapsed())
+	tx, ty := int(math.Floor(x)), int(math.Floor(y/2))
+	cell := frame.Wrap(tx, ty)
+	if m.mirror {
+		cell = frame.Mirror(tx, ty)
+	}
+	if cell.Rune == 0 || cell.Rune == canvas.Continued {
+		return " ", canvas.Style{Fg: lipgloss.Color("#000000")}
+	}
+	return string(cell.Rune), cell.Style
+}
+
+// mouse pans the texture while the left button is held, taking over from
+// the automatic motion, so the point under the pointer moves with it.
+func (m model) mouse(msg tea.MouseMsg) model {
+	switch {
+	case msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+		m.dragging, m.auto = true, false
+	case msg.Action == tea.MouseActionRelease:
+		m.dragging = false
+	case msg.Action == tea.MouseActionMotion && m.dragging:
+		// Undo the rotation and zoom on the step the pointer took, rows
+		// doubled as on screen
+		dx, dy := float64(msg.X-m.dragX), float64(msg.Y-m.dragY)*2
+		cos, sin := math.Cos(m.rotation), math.Sin(m.rotation)
+		m.offsetX -= (dx*cos + dy*sin) / m.zoom
+		m.offsetY -= (-dx*sin + dy*cos) / m.zoom
+	}
+	m.dragX, m.dragY = msg.X, msg.Y
+	return m
+}
+
+// state is the end of the status line.
+func (m model) state() string {
+	switch {
+	case m.anim.Paused():
+		return "⏸ Paused"
+	case m.dragging:
+		return "✋ Panning"
+	case !m.auto:
+		return "🕹 Manual"
+	}
+	return "🌀 Rotating"
+}
@@ -0,0 +1,115 @@
+package rotozoom
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// Looking up the one texel under the middle of each cell makes edges jump a
+// whole cell at a time as the texture turns, and zoomed out, detail finer
+// than a cell flickers in and out from frame to frame. Smooth sampling looks
+// up the four texels around the point instead and blends them by how near
+// the point is to each: their colors are mixed, and the glyph is the one of
+// theirs whose weight best matches how much of the cell the blend covers.
+
+// density is how much of a cell a glyph fills, from nothing to all of it.
+var density = map[string]float64{
+	" ": 0,
+	"·": 0.1, "∘": 0.2, "─": 0.3, "│": 0.3, "▪": 0.35, "○": 0.4, "◇": 0.5,
+	"●": 0.7, "◆": 0.8,
+	"░": 0.25, "▒": 0.5, "▓": 0.75, "█": 1,
+	"▀": 0.5, "▄": 0.5, "▌": 0.5, "▐": 0.5,
+	"▘": 0.25, "▝": 0.25, "▖": 0.25, "▗": 0.25,
+	"▛": 0.75, "▜": 0.75, "▙": 0.75, "▟": 0.75, "▚": 0.5, "▞": 0.5,
+}
+
+// glyphDensity is the glyph's density, or half for any not listed.
+func glyphDensity(char string) float64 {
+	if d, ok := density[char]; ok {
+		return d
+	}
+	return 0.5
+}
+
+// texelHeight is the height of a texel of the pattern in texture units; the
+// sprite's rows are two high to make up for the shape of a cell.
+func texelHeight(pattern int) float64 {
+	if pattern == spriteTile {
+		return 2
+	}
+	return 1
+}
+
+// smoothPattern samples the pattern at (x, y) blended from the texels around
+// it.
+func (m model) smoothPattern(pattern int, x, y float64) (string, canvas.Style) {
+	// The texel centers either side of the point, and how far along it is
+	h := texelHeight(pattern)
+	x0, y0 := math.Floor(x-0.5), math.Floor(y/h-0.5)
+	fx, fy := x-0.5-x0, y/h-0.5-y0
+
+	var chars [4]string
+	var styles [4]canvas.Style
+	weights := [4]float64{(1 - fx) * (1 - fy), fx * (1 - fy), (1 - fx) * fy, fx * fy}
+	for i := range weights {
+		tx, ty := x0+float64(i%2)+0.5, (y0+float64(i/2)+0.5)*h
+		chars[i], styles[i] = m.samplePattern(pattern, tx, ty)
+	}
+
+	// The glyph nearest the blend's coverage, preferring the heavier of
+	// two that are as near
+	coverage := 0.0
+	for i, w := range weights {
+		coverage += w * glyphDensity(chars[i])
+	}
+	best := 0
+	for i := range chars {
+		d, b := math.Abs(glyphDensity(chars[i])-coverage), math.Abs(glyphDensity(chars[best])-coverage)
+		if d < b || d == b && weights[i] > weights[best] {
+			best = i
+		}
+	}
+	if chars[best] == " " {
+		return " ", styles[best]
+	}
+
+	// Blank texels have no ink to mix, and texels with no background
+	// leave it to the others
+	style := styles[best]
+	style.Fg = blend(weights, func(i int) lipgloss.Color {
+		if chars[i] == " " {
+			return ""
+		}
+		return styles[i].Fg
+	})
+	style.Bg = blend(weights, func(i int) lipgloss.Color { return styles[i].Bg })
+	return chars[best], style
+}
+
+// blend mixes the colors color gives for the four texels by their weights,
+// skipping any that are empty.
+func blend(weights [4]float64, color func(i int) lipgloss.Color) lipgloss.Color {
+	var r, g, b, total float64
+	for i, w := range weights {
+		c := color(i)
+		if c == "" {
+			continue
+		}
+		rgb := common.ParseHex(string(c))
+		r += w * float64(rgb.R)
+		g += w * float64(rgb.G)
+		b += w * float64(rgb.B)
+		total += w
+	}
+	if total == 0 {
+		return ""
+	}
+	return common.RGB{
+		R: uint8(math.Round(r / total)),
+		G: uint8(math.Round(g / total)),
+		B: uint8(math.Round(b / total)),
+	}.Color()
+}
@@ -0,0 +1,177 @@
+package scroller
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/compose"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// Behind the text go the backgrounds of a cracktro, each a layer that can
+// be shown or hidden on its own: stars drifting left in three planes, a
+// grid running to the horizon and copper bars, the bands of color an Amiga
+// made by changing the background color as the beam went down the screen.
+
+// Layer names, bottom to top.
+const (
+	starsLayer = "stars"
+	gridLayer  = "grid"
+	barsLayer  = "bars"
+	textLayer  = "text"
+)
+
+// backgrounds are the layers the keys show and hide, in status line order.
+var backgrounds = []string{starsLayer, barsLayer, gridLayer}
+
+const (
+	// numStars are spread over the screen, whatever its size.
+	numStars = 150
+
+	// numBars is how many copper bars there are, each barHeight rows from
+	// edge to edge.
+	numBars   = 4
+	barHeight = 5
+
+	// gridSpacing is the columns between the grid's lines on the bottom
+	// row, and gridSpeed how many of its cross lines roll past a second.
+	gridSpacing = 8.0
+	gridSpeed   = 1.5
+)
+
+// star is a point of the starfield, placed as a fraction of the screen.
+type star struct {
+	x, y  float64
+	plane int // 0 is the farthest and slowest
+}
+
+// The planes of stars, from the back, drift this many columns a second and
+// are drawn with these characters and colors.
+var (
+	starSpeeds = []float64{3, 7, 14}
+	starRunes  = []rune{'·', '∙', '•'}
+	starColors = []string{"#505060", "#9090A0", "#E0E0FF"}
+)
+
+// barColors are the colors of the copper bars at their brightest.
+var barColors = []string{"#FF2060", "#FFA020", "#20E0FF", "#A040FF"}
+
+// newStars scatters the starfield.
+func newStars() []star {
+	stars := make([]star, numStars)
+	for i := range stars {
+		stars[i] = star{x: rng.Float64(), y: rng.Float64(), plane: i % len(starSpeeds)}
+	}
+	return stars
+}
+
+// newLayers stacks the backgrounds and the logo, hidden, under the text.
+func newLayers() *compose.Stack[*model] {
+	layers := compose.New[*model]()
+	layers.Add(starsLayer, 0, compose.Func[*model]((*model).renderStars))
+	layers.Add(gridLayer, 10, compose.Func[*model]((*model).renderGrid))
+	layers.Add(barsLayer, 20, compose.Func[*model]((*model).renderBars))
+	layers.Add(logoLayer, 50, compose.Func[*model]((*model).renderLogo))
+	layers.Add(textLayer, 100, compose.Func[*model]((*model).renderText))
+	for _, name := range backgrounds {
+		layers.SetVisible(name, false)
+	}
+	layers.SetVisible(logoLayer, false)
+	return layers
+}
+
+// renderStars draws the starfield, each plane drifting left at its speed.
+func (m *model) renderStars(c *canvas.Canvas) {
+	t := m.anim.Elapsed()
+	w, h := float64(c.Width()), float64(c.Height())
+	for _, s := range m.stars {
+		x := math.Mod(s.x*w-t*starSpeeds[s.plane], w)
+		if x < 0 {
+			x += w
+		}
+		c.Set(int(x), int(s.y*h), starRunes[s.plane], canvas.Style{Fg: lipgloss.Color(starColors[s.plane])})
+	}
+}
+
+// renderBars draws the copper bars bobbing up and down the screen, each a
+// band of its color shading from dark at the edges to white hot in the
+// middle. They are drawn as backgrounds, so the stars and grid show through
+// them and the text keeps them behind it.
+func (m *model) renderBars(c *canvas.Canvas) {
+	t := m.anim.Elapsed()
+	h := float64(c.Height())
+	for i := 0; i < numBars; i++ {
+		center := h/2 + math.Sin(t*1.3+float64(i)*0.8)*(h/2-barHeight/2)
+		base := common.ParseHex(barColors[i%len(barColors)])
+		for row := 0; row < barHeight; row++ {
+			y := int(center) - barHeight/2 + row
+			// 0 at the edges of the bar, 1 in its middle
+			lit := 1 - math.Abs(float64(row)-barHeight/2)/(barHeight/2+1)
+			color := common.LerpRGB(common.RGB{}, base, lit)
+			if lit > 0.9 {
+				color = common.LerpRGB(color, common.RGB{R: 255, G: 255, B: 255}, 0.5)
+			}
+			for x := 0; x < c.Width(); x++ {
+				cell := c.Get(x, y)
+				cell.Style.Bg = color.Color()
+				c.Set(x, y, cell.Rune, cell.Style)
+			}
+		}
+	}
+}
+
+// renderGrid draws a floor of grid lines over the bottom third of the
+// screen, running out to the horizon and rolling towards the viewer.
+func (m *model) renderGrid(c *canvas.Canvas) {
+	t := m.anim.Elapsed()
+	horizon := c.Height() * 2 / 3
+	rows := c.Height() - horizon
+	if rows < 2 {
+		return
+	}
+	cx := float64(c.Width()) / 2
+	// The lines to the vanishing point are gridSpacing columns apart on
+	// the bottom row, and as many as reach the sides there
+	spacing := gridSpacing / float64(rows)
+	lines := int(cx/gridSpacing) + 1
+	for y := horizon; y < c.Height(); y++ {
+		// A row d rows below the horizon is rows/d away, so one at the
+		// bottom is 1 away and the horizon is as far as the floor goes
+		d := float64(y - horizon + 1)
+		near := d / float64(rows)
+		color := common.LerpRGB(common.ParseHex("#301040"), common.ParseHex("#FF40C0"), near).Color()
+		// A cross line wherever one of the floor's, a unit apart and
+		// rolling closer, lies between this row and the next
+		far, nearer := float64(rows)/d+t*gridSpeed, float64(rows)/(d+1)+t*gridSpeed
+		if y == horizon || math.Floor(far) != math.Floor(nearer) {
+			for x := 0; x < c.Width(); x++ {
+				c.Set(x, y, '─', canvas.Style{Fg: color})
+			}
+			continue
+		}
+		for k := -lines; k <= lines; k++ {
+			x := int(math.Round(cx + float64(k)*spacing*d))
+			r := '│'
+			switch {
+			case k < 0:
+				r = '╱'
+			case k > 0:
+				r = '╲'
+			}
+			c.Set(x, y, r, canvas.Style{Fg: color})
+		}
+	}
+}
+
+// renderText draws the scroll text, and the tickers, over whatever is
+// behind it. Over a copper bar the letters keep the bar's color behind them.
+func (m *model) renderText(c *canvas.Canvas) {
+	m.renderTickers(c)
+	m.text.Draw(c, m.height/2, func(x, y, index int) (rune, canvas.Style) {
+		ch, style := m.getStyledCharacter(x, y, index)
+		style.Bg = c.Get(x, y).Style.Bg
+		return ch, style
+	})
+}
@@ -0,0 +1,79 @@
+package scroller
+
+import (
+	_ "embed"
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/scrolltext"
+	"github.com/yourusername/bubbletea-showcase/common/sprite"
+)
+
+// Over the scroller bounces a logo, DYCP style: "different Y character
+// position", each character of it riding its own point of a sine, so the
+// logo ripples as it bounces, while the whole of it sways from side to
+// side. A character is scrolltext.Advance columns, a letter of the
+// built-in logo.
+// Cells of the logo without colors of their own take the color mode's,
+// cycling along it.
+
+//go:embed logo.txt
+var logoSprite string
+
+// logoLayer sits over the backgrounds and under the text.
+const logoLayer = "logo"
+
+const (
+	// logoBounce is how many rows the columns rise and fall, at most.
+	logoBounce = 2
+	// logoRipple is how far along the sine each character is from the
+	// last, in radians.
+	logoRipple = 0.6
+	// logoSway is the fraction of the room either side the logo sways
+	// through.
+	logoSway = 0.6
+)
+
+// logoCols is the most columns a PNG logo is scaled down to.
+const logoCols = 60
+
+// loadLogo reads the logo, a sprite file or PNG, in place of the built-in
+// one.
+func (m *model) loadLogo(path string) error {
+	logo, err := sprite.LoadFile(path, logoCols)
+	if err != nil {
+		return err
+	}
+	m.logo = logo
+	return nil
+}
+
+// renderLogo draws the logo bouncing over the top half of the screen.
+func (m *model) renderLogo(c *canvas.Canvas) {
+	t := m.anim.Elapsed()
+	frame := m.logo.At(t)
+	top := max(c.Height()/4-frame.Height/2, 0)
+	bounce := float64(min(logoBounce, top))
+	room := float64(max(c.Width()-frame.Width, 0)) / 2
+	left := int(room + math.Sin(t*0.6)*room*logoSway)
+	for col := 0; col < frame.Width; col++ {
+		x := left + col
+		char := col / scrolltext.Advance
+		dy := int(math.Round(math.Sin(t*3+float64(char)*logoRipple) * bounce))
+		for row := 0; row < frame.Height; row++ {
+			cell := frame.At(col, row)
+			y := top + row + dy
+			if cell.Rune == 0 || !c.InBounds(x, y) {
+				continue
+			}
+			style := cell.Style
+			if style.Fg == "" && style.Bg == "" {
+				style.Fg = m.getColorFromIntensity(math.Mod(float64(col)/float64(frame.Width)+t*0.5, 1))
+			}
+			if style.Bg == "" {
+				style.Bg = c.Get(x, y).Style.Bg
+			}
+			c.Set(x, y, cell.Rune, style)
+		}
+	}
+}
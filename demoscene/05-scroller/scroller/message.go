@@ -0,0 +1,173 @@
+package scroller
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/scrolltext"
+)
+
+// The message can be given on the command line, as text or as a file, and
+// typed in while the demo runs. A file is read again whenever it changes,
+// so the greetings can be edited in another window and come round a moment
+// after they are saved.
+
+// fileCheck is how often the message file is looked at for changes.
+const fileCheck = time.Second
+
+// messageFile is the file the message is read from, as of when it was last
+// read.
+type messageFile struct {
+	path string
+	mod  time.Time
+}
+
+// fileMsg is the message file as it was when checked. text is only read
+// when mod shows it has changed.
+type fileMsg struct {
+	text string
+	mod  time.Time
+}
+
+// readMessage reads the message from the file at path.
+func readMessage(path string) (string, time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return joinLines(string(data)), info.ModTime(), nil
+}
+
+// joinLines makes one line of a file's lines, leaving out blank ones, with
+// " * " between them as between greetings.
+func joinLines(text string) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " * ")
+}
+
+// watch checks the message file again after a while. A file that cannot be
+// read for the moment, as while an editor saves it, is taken as unchanged.
+func (f messageFile) watch() tea.Cmd {
+	return tea.Tick(fileCheck, func(time.Time) tea.Msg {
+		info, err := os.Stat(f.path)
+		if err != nil || info.ModTime().Equal(f.mod) {
+			return fileMsg{mod: f.mod}
+		}
+		text, mod, err := readMessage(f.path)
+		if err != nil {
+			return fileMsg{mod: f.mod}
+		}
+		return fileMsg{text: text, mod: mod}
+	})
+}
+
+// setMessage scrolls text from the right edge, or the greetings if it is
+// empty.
+func (m *model) setMessage(text string) {
+	m.text.Message = scrolltext.Greetings
+	if text = strings.TrimSpace(text); text != "" {
+		m.text.Message = scrolltext.Normalize(text)
+	}
+	m.text.Pos = -float64(m.width)
+}
+
+// control acts on the control codes the scroller leaves to the demo:
+// {color:name} switches to the color mode or palette of that name, or its
+// first word, and {flash} flashes the text white as on a beat.
+func (m *model) control(codes []scrolltext.Code) {
+	for _, c := range codes {
+		switch c.Name {
+		case "color":
+			for i, mode := range m.modes {
+				first, _, _ := strings.Cut(mode.name, " ")
+				if strings.EqualFold(c.Arg, mode.name) || strings.EqualFold(c.Arg, first) {
+					m.colorMode = i
+					break
+				}
+			}
+		case "flash":
+			m.flash = 1
+		}
+	}
+}
+
+type textKeyMap struct {
+	Apply  key.Binding
+	Cancel key.Binding
+	Taken  key.Binding
+	Quit   key.Binding
+	Help   key.Binding
+}
+
+// While the message is typed, + and - are text rather than the frame rate,
+// and help is on F1.
+var textKeys = textKeyMap{
+	Apply:  keymap.New("enter", "scroll it"),
+	Cancel: keymap.New("esc", "cancel"),
+	Taken:  keymap.Hidden("+", "-"),
+	Quit:   keymap.New("ctrl+c", "quit"),
+	Help:   keymap.New("F1", "help", "f1"),
+}
+
+// openText starts typing a new message, from the one scrolling.
+func (m model) openText() model {
+	in := textinput.New()
+	in.Prompt = "Message: "
+	in.CharLimit = 500
+	in.Width = max(20, m.width-lipgloss.Width(in.Prompt)-1)
+	in.Cursor.SetMode(cursor.CursorStatic)
+	in.SetValue(strings.TrimSuffix(m.text.Message, " * "))
+	in.CursorEnd()
+	in.Focus()
+	m.typing = &in
+	return m
+}
+
+// textKey handles keys while the message is typed.
+func (m model) textKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, textKeys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, textKeys.Cancel):
+		m.typing = nil
+		return m, nil
+	case key.Matches(msg, textKeys.Apply):
+		// A typed message is kept for next time
+		m.message = strings.TrimSpace(m.typing.Value())
+		m.setMessage(m.message)
+		m.typing = nil
+		return m, nil
+	}
+	in, cmd := m.typing.Update(msg)
+	m.typing = &in
+	return m, cmd
+}
+
+// textHint is the status line while the message is typed. It names any
+// characters typed that the bitmap font has no letter for.
+func (m model) textHint() string {
+	hint := "Type the message to scroll"
+	if missing := scrolltext.Missing(m.typing.Value()); len(missing) > 0 && m.text.Font == nil {
+		return lipgloss.NewStyle().Foreground(common.Yellow).
+			Render(fmt.Sprintf("%s; %q will show as boxes", hint, string(missing)))
+	}
+	return lipgloss.NewStyle().Foreground(common.Cyan).Render(hint)
+}
@@ -0,0 +1,574 @@
+// Package scroller is a demoscene text scroller with bitmap fonts and effects.
+package scroller
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/audio"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/compose"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/font"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/palette"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/scrolltext"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+	"github.com/yourusername/bubbletea-showcase/common/sprite"
+)
+
+//go:embed doc.md
+var doc string
+
+// Color mode configuration  
+type colorMode struct {
+	name   string
+	colors []string
+}
+
+type model struct {
+	// Display properties
+	width  int
+	height int
+	grid   *canvas.Canvas // Grid-based rendering for performance
+	
+	// Animation state
+	anim       engine.Animator
+	text       scrolltext.Scroller
+	
+	// Content and configuration
+	font       int
+	figlets    []*font.Font // fonts that can stand in for the bitmap
+	figlet     int          // into figlets, or -1 for the bitmap font
+	colorMode  int
+	modes      []colorMode
+
+	// Music, when playing, flashes the text on the beat
+	player *audio.Player
+	song   string
+	flash  float64
+	rows   bool // the music is a tracker module, timed by its rows
+
+	// message is the typed message, kept between runs, or "" for the
+	// greetings. A message from the command line scrolls in its place
+	// without replacing it.
+	message string
+	file    *messageFile     // the file the message is read from, if any
+	typing  *textinput.Model // the message input, while it is open
+
+	// Backgrounds behind the text
+	layers *compose.Stack[*model]
+	stars  []star
+	logo   *sprite.Animation
+
+	// lines is how many scrollers are shown: the main one and lines-1 of
+	// the tickers
+	lines   int
+	tickers []ticker
+}
+
+type keyMap struct {
+	Font    key.Binding
+	FIGlet  key.Binding
+	Color   key.Binding
+	Cycle   key.Binding
+	Faster  key.Binding
+	Slower  key.Binding
+	Flatter key.Binding
+	Wavier  key.Binding
+	Edit    key.Binding
+	Stars   key.Binding
+	Bars    key.Binding
+	Grid    key.Binding
+	Lines   key.Binding
+	Logo    key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Font:    keymap.New("1-3", "fonts", "1", "2", "3"),
+	FIGlet:  keymap.New("f", "FIGlet fonts"),
+	Color:   keymap.New("4-7", "colors", "4", "5", "6", "7"),
+	Cycle:   keymap.New("c", "cycle palettes"),
+	Faster:  keymap.New("↑↓", "speed", "up"),
+	Slower:  keymap.Hidden("down"),
+	Flatter: keymap.New("←→", "wave", "left"),
+	Wavier:  keymap.Hidden("right"),
+	Edit:    keymap.New("e", "edit message"),
+	Stars:   keymap.New("s", "stars"),
+	Bars:    keymap.New("b", "copper bars"),
+	Grid:    keymap.New("g", "grid"),
+	Lines:   keymap.New("t", "tickers"),
+	Logo:    keymap.New("l", "logo"),
+	Common:  keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Font       int     `json:"font"`
+	FIGlet     string  `json:"figlet"` // the font's name, or "" for the bitmap
+	ColorMode  int     `json:"colorMode"`
+	Speed      float64 `json:"speed"`
+	WaveHeight float64 `json:"waveHeight"`
+	// Message is the text, without the " * " that closes the loop
+	Message string `json:"message"`
+	// The backgrounds shown
+	Stars bool `json:"stars"`
+	Bars  bool `json:"bars"`
+	Grid  bool `json:"grid"`
+	// Lines is how many scrollers are shown, 1 to 3
+	Lines int  `json:"lines"`
+	Logo  bool `json:"logo"`
+}
+
+func initialModel() model {
+	logo, err := sprite.Parse(logoSprite)
+	if err != nil {
+		panic(err)
+	}
+	m := model{
+		width:      80,
+		height:     24,
+		anim:       engine.New(engine.SharedFPS),
+		text:       scrolltext.New(scrolltext.Greetings),
+		font:       0,
+		colorMode:  0,
+		logo:       logo,
+		modes: []colorMode{
+			{name: "Rainbow Wave", colors: []string{"#FF0000", "#FF8000", "#FFFF00", "#00FF00", "#0080FF", "#8000FF"}},
+			{name: "Fire", colors: []string{"#FF0000", "#FF4000", "#FF8000", "#FFFF00"}},
+			{name: "Matrix", colors: []string{"#004000", "#008000", "#00C000", "#00FF00"}},
+			{name: "Plasma", colors: []string{"#FF0080", "#8000FF", "#0080FF", "#00FF80", "#80FF00"}},
+		},
+	}
+	// Registry palettes follow the built-in modes and are reached with c
+	palettes, _ := palette.All()
+	for _, p := range palettes {
+		m.modes = append(m.modes, colorMode{name: p.Name, colors: p.Colors})
+	}
+	m.grid = canvas.New(m.width, m.height)
+	m.layers = newLayers()
+	m.stars = newStars()
+	m.tickers = newTickers()
+	// The built-in block font is the bitmap font over again
+	for _, name := range font.Names() {
+		if f, err := font.Builtin(name); err == nil && name != "block" {
+			m.figlets = append(m.figlets, f)
+		}
+	}
+	m.setFIGlet(-1)
+
+	p := prefs{Speed: 1.0, WaveHeight: m.text.WaveHeight, Lines: 1}
+	settings.Load("scroller", &p)
+	m.font = min(max(p.Font, 0), 2)
+	if p.ColorMode >= 0 && p.ColorMode < len(m.modes) {
+		m.colorMode = p.ColorMode
+	}
+	m.anim.SetSpeed(common.Clamp(p.Speed, 0.1, 4.0))
+	m.text.WaveHeight = common.Clamp(p.WaveHeight, 0.0, 8.0)
+	for i, f := range m.figlets {
+		if f.Name == p.FIGlet {
+			m.setFIGlet(i)
+		}
+	}
+	if p.Message != "" {
+		m.message = p.Message
+		m.text.Message = scrolltext.Normalize(p.Message)
+	}
+	m.layers.SetVisible(starsLayer, p.Stars)
+	m.layers.SetVisible(barsLayer, p.Bars)
+	m.layers.SetVisible(gridLayer, p.Grid)
+	m.lines = min(max(p.Lines, 1), maxTickers+1)
+	m.layers.SetVisible(logoLayer, p.Logo)
+	return m
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+// The demo needs a terminal at least minWidth by minHeight; below that it
+// shows a notice instead.
+const minWidth, minHeight = 60, 16
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:       "05-scroller",
+		Heading:   "📜 Scroller",
+		Summary:   "Demoscene text scroller with bitmap fonts and effects",
+		Section:   registry.Demoscene,
+		Keywords:  []string{"demoscene", "text", "font", "music"},
+		MinWidth:  minWidth,
+		MinHeight: minHeight,
+		Manual:    doc,
+		Build:     New,
+	})
+}
+
+// NewWithMusic returns the demo's model looping the WAV, Ogg Vorbis, MOD or
+// XM file at path and flashing the text in time. Stop the player when the
+// demo ends.
+func NewWithMusic(path string) (tea.Model, *audio.Player, error) {
+	return NewWithOptions(Options{Music: path})
+}
+
+// Options are what the command line can change.
+type Options struct {
+	// Music is a WAV, Ogg Vorbis, MOD or XM file to loop, flashing the
+	// text in time.
+	Music string
+	// Text is a message to scroll in place of the greetings.
+	Text string
+	// TextFile is a file to read the message from, a greeting to a line,
+	// and to read again whenever it changes. It overrides Text.
+	TextFile string
+	// Font is a FIGlet or TheDraw font file to draw the text in.
+	Font string
+	// Logo is a sprite file or PNG to bounce over the text in place of the
+	// built-in logo, which it shows.
+	Logo string
+	// Tickers are messages for the tickers, the bottom one first, each
+	// shown whatever the number of lines kept from last time.
+	Tickers []string
+}
+
+// NewWithOptions returns the demo's model set up as the options say. If
+// music is playing, the player is returned too; stop it when the demo ends.
+func NewWithOptions(o Options) (tea.Model, *audio.Player, error) {
+	m := initialModel()
+	if o.Font != "" {
+		f, err := font.Load(o.Font)
+		if err != nil {
+			return nil, nil, err
+		}
+		m.figlets = append(m.figlets, f)
+		m.setFIGlet(len(m.figlets) - 1)
+	}
+	if o.Text != "" {
+		m.setMessage(o.Text)
+	}
+	if o.TextFile != "" {
+		text, mod, err := readMessage(o.TextFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		m.setMessage(text)
+		m.file = &messageFile{path: o.TextFile, mod: mod}
+	}
+	if o.Logo != "" {
+		if err := m.loadLogo(o.Logo); err != nil {
+			return nil, nil, err
+		}
+		m.layers.SetVisible(logoLayer, true)
+	}
+	if len(o.Tickers) > maxTickers {
+		return nil, nil, fmt.Errorf("at most %d tickers", maxTickers)
+	}
+	for i, text := range o.Tickers {
+		m.setTickerMessage(i, text)
+	}
+	m.lines = max(m.lines, len(o.Tickers)+1)
+	if o.Music == "" {
+		return m, nil, nil
+	}
+	player, err := audio.PlayFile(o.Music, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.player, m.song = player, filepath.Base(o.Music)
+	return m, player, nil
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	p := prefs{Font: m.font, ColorMode: m.colorMode, Speed: m.anim.Speed(), WaveHeight: m.text.WaveHeight,
+		Message: m.message, Stars: m.layers.Visible(starsLayer), Bars: m.layers.Visible(barsLayer),
+		Grid: m.layers.Visible(gridLayer), Lines: m.lines, Logo: m.layers.Visible(logoLayer)}
+	if m.text.Font != nil {
+		p.FIGlet = m.text.Font.Name
+	}
+	return "scroller", p
+}
+
+func (m model) Init() tea.Cmd {
+	cmds := []tea.Cmd{m.anim.Tick()}
+	if m.player != nil {
+		cmds = append(cmds, m.player.Listen())
+	}
+	if m.file != nil {
+		cmds = append(cmds, m.file.watch())
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.grid.Resize(m.width, m.height)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.flash *= math.Pow(0.8, m.anim.Delta())
+			m.control(m.text.Update(m.anim.Delta(), m.width))
+			for i := range m.tickers {
+				m.control(m.tickers[i].text.Update(m.anim.Delta()*m.tickers[i].speed, m.width))
+			}
+		}
+		return m, cmd
+
+	case audio.RowMsg:
+		// Flash hard on the first row of each bar, softly on the other
+		// beats that strike a note
+		switch {
+		case msg.Row%16 == 0:
+			m.flash = 1
+		case msg.Row%4 == 0 && len(msg.Notes) > 0:
+			m.flash = math.Max(m.flash, 0.5)
+		}
+		m.rows = true
+		return m, m.player.Listen()
+
+	case audio.BeatMsg:
+		if !m.rows {
+			m.flash = math.Min(1, msg.Strength/2)
+		}
+		return m, m.player.Listen()
+
+	case audio.EnergyMsg:
+		return m, m.player.Listen()
+
+	case audio.DoneMsg:
+		m.player = nil
+		return m, nil
+
+	case fileMsg:
+		if !msg.mod.Equal(m.file.mod) {
+			m.setMessage(msg.text)
+			m.file = &messageFile{path: m.file.path, mod: msg.mod}
+		}
+		return m, m.file.watch()
+
+	case tea.KeyMsg:
+		if m.typing != nil {
+			return m.textKey(msg)
+		}
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.anim.Reset()
+			m.text.Reset(m.width)
+			for i := range m.tickers {
+				m.tickers[i].text.Reset(m.width)
+			}
+		case key.Matches(msg, keys.Font):
+			newFont := int(msg.String()[0] - '1')
+			if newFont >= 0 && newFont < 3 {
+				m.font = newFont
+			}
+		case key.Matches(msg, keys.FIGlet):
+			m.setFIGlet((m.figlet+2)%(len(m.figlets)+1) - 1)
+		case key.Matches(msg, keys.Color):
+			newMode := int(msg.String()[0] - '4')
+			if newMode < len(m.modes) {
+				m.colorMode = newMode
+			}
+		case key.Matches(msg, keys.Cycle):
+			m.colorMode = (m.colorMode + 1) % len(m.modes)
+		case key.Matches(msg, keys.Faster):
+			m.anim.SetSpeed(common.Clamp(m.anim.Speed()+0.2, 0.1, 4.0))
+		case key.Matches(msg, keys.Slower):
+			m.anim.SetSpeed(common.Clamp(m.anim.Speed()-0.2, 0.1, 4.0))
+		case key.Matches(msg, keys.Flatter):
+			m.text.WaveHeight = common.Clamp(m.text.WaveHeight-0.5, 0.0, 8.0)
+		case key.Matches(msg, keys.Wavier):
+			m.text.WaveHeight = common.Clamp(m.text.WaveHeight+0.5, 0.0, 8.0)
+		case key.Matches(msg, keys.Edit):
+			m = m.openText()
+		case key.Matches(msg, keys.Stars):
+			m.layers.Toggle(starsLayer)
+		case key.Matches(msg, keys.Bars):
+			m.layers.Toggle(barsLayer)
+		case key.Matches(msg, keys.Grid):
+			m.layers.Toggle(gridLayer)
+		case key.Matches(msg, keys.Lines):
+			m.lines = m.lines%(maxTickers+1) + 1
+		case key.Matches(msg, keys.Logo):
+			m.layers.Toggle(logoLayer)
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper. The message input has keys of its
+// own.
+func (m model) KeyMap() keymap.Map {
+	if m.typing != nil {
+		return keymap.Of(textKeys)
+	}
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#00FF80")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("📜 Demoscene Scroller")
+	if m.player != nil {
+		title += lipgloss.NewStyle().Faint(true).Render("  ♪ " + m.song)
+	}
+
+	// Status with enhanced information
+	statusStyle := lipgloss.NewStyle().Foreground(common.Green)
+	fonts := []string{"Block", "Outline", "Dotted"}
+	fontName := fonts[m.font]
+	if m.text.Font != nil {
+		fontName = m.text.Font.Name
+	}
+	status := statusStyle.Render(fmt.Sprintf(
+		"Font: %s | Color: %s | Speed: %.1f | Wave: %.1f | %s",
+		fontName, m.modes[m.colorMode].name, m.anim.Speed(), m.text.WaveHeight,
+		map[bool]string{true: "⏸ PAUSED", false: "📜 SCROLLING"}[m.anim.Paused()],
+	) + m.linesStatus() + m.backgroundStatus())
+
+	// Check minimum size requirements
+	if m.width < minWidth || m.height+4 < minHeight {
+		errorStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF0000")).
+			Bold(true)
+		
+		sizeError := errorStyle.Render(fmt.Sprintf(
+			"Terminal too small!\nMinimum size: %dx%d\nCurrent size: %dx%d\n\nPlease resize your terminal window.",
+			minWidth, minHeight, m.width, m.height+4,
+		))
+		
+		helpStyle := lipgloss.NewStyle().Faint(true)
+		help := helpStyle.Render(keymap.Short(keys.Quit))
+
+		return lipgloss.JoinVertical(lipgloss.Left, title, status, "", sizeError, help)
+	}
+
+	// Render the complete scroller using grid-based approach
+	scene := m.renderCompleteScroller()
+
+	// Enhanced help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	gap := ""
+	if m.typing != nil {
+		status = m.textHint()
+		gap = m.typing.View()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, status, gap, scene, help)
+}
+
+// setFIGlet draws the text in the i'th of the fonts, or the bitmap font for
+// -1.
+func (m *model) setFIGlet(i int) {
+	m.figlet = i
+	m.text.Font = nil
+	if i >= 0 {
+		m.text.Font = m.figlets[i]
+	}
+}
+
+// Grid-based rendering for optimal performance: the backgrounds that are
+// shown, then the text over them
+func (m model) renderCompleteScroller() string {
+	return m.layers.Render(m.grid, &m)
+}
+
+// linesStatus counts the scrollers, for the status line, when there is
+// more than the one.
+func (m model) linesStatus() string {
+	if m.lines == 1 {
+		return ""
+	}
+	return fmt.Sprintf(" | Lines: %d", m.lines)
+}
+
+// backgroundStatus names the backgrounds shown, and the logo if it is,
+// for the status line.
+func (m model) backgroundStatus() string {
+	logo := ""
+	if m.layers.Visible(logoLayer) {
+		logo = " | Logo"
+	}
+	var shown []string
+	for _, name := range backgrounds {
+		if m.layers.Visible(name) {
+			shown = append(shown, name)
+		}
+	}
+	if len(shown) == 0 {
+		return logo
+	}
+	return logo + " | Background: " + strings.Join(shown, ", ")
+}
+
+// Get styled character and color based on current configuration
+func (m model) getStyledCharacter(x, y, charIndex int) (rune, canvas.Style) {
+	// Character selection based on font
+	var char rune
+	switch m.font {
+	case 0: char = '█' // Block
+	case 1: char = '▓' // Outline
+	case 2: char = '●' // Dotted
+	default: char = '█'
+	}
+	
+	// Color calculation based on mode
+	var colorIntensity float64
+	switch m.colorMode {
+	case 0: // Rainbow Wave
+		colorIntensity = math.Mod(float64(x+charIndex*20)*0.05 + m.text.Time, 1.0)
+	case 1: // Fire
+		colorIntensity = (math.Sin(float64(x)*0.1 + m.text.Time*2) + 1) / 2
+	case 2: // Matrix
+		colorIntensity = (math.Sin(float64(y)*0.2 + m.text.Time*3) + 1) / 2
+	case 3: // Plasma
+		plasma := math.Sin(float64(x)*0.1) + math.Sin(float64(y)*0.15) + math.Sin(m.text.Time*2)
+		colorIntensity = (plasma + 3) / 6
+	default: // Registry palettes sweep like the rainbow wave
+		colorIntensity = math.Mod(float64(x+charIndex*20)*0.05 + m.text.Time, 1.0)
+	}
+	
+	color := m.getColorFromIntensity(colorIntensity)
+	if m.flash > 0.05 && !engine.ReducedMotion() {
+		color = common.LerpRGB(common.ParseHex(string(color)), common.ParseHex("#FFFFFF"), m.flash).Color()
+	}
+	return char, canvas.Style{Fg: color}
+}
+
+// Get color from intensity using current color mode, blended rather than
+// stepped when motion is reduced
+func (m model) getColorFromIntensity(intensity float64) lipgloss.Color {
+	colors := m.modes[m.colorMode].colors
+	if engine.ReducedMotion() {
+		return common.Sample(colors, intensity)
+	}
+	index := common.Clamp(intensity * float64(len(colors)-1), 0, float64(len(colors)-1))
+	return lipgloss.Color(colors[int(index)])
+}
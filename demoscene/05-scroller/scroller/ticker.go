@@ -0,0 +1,73 @@
+package scroller
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/font"
+	"github.com/yourusername/bubbletea-showcase/common/scrolltext"
+)
+
+// Besides the main scroller there can be up to two tickers, lines of their
+// own with their own message, font, speed and wave: a small one along the
+// bottom and another across the top.
+
+// maxTickers is how many tickers there can be.
+const maxTickers = 2
+
+// ticker is a scroller running alongside the main one.
+type ticker struct {
+	text  scrolltext.Scroller
+	speed float64 // how fast it scrolls, against the main scroller
+	top   bool    // across the top, or else along the bottom
+}
+
+// tickerMessages are what the tickers scroll unless given messages of
+// their own.
+var tickerMessages = [maxTickers]string{
+	"bubble tea showcase * a little ticker along the bottom * press t for another line",
+	"THE SCENE LIVES ON * CODE * GRAPHICS * MUSIC",
+}
+
+// newTickers returns the tickers: the bottom one small, in the mini font,
+// fast and flat, and the top one in the bitmap font, slow, on a low wave
+// half a turn behind the main one's.
+func newTickers() []ticker {
+	bottom := ticker{text: scrolltext.New(scrolltext.Normalize(tickerMessages[0])), speed: 1.6}
+	bottom.text.WaveHeight = 0
+	if f, err := font.Builtin("mini"); err == nil {
+		bottom.text.Font = f
+	}
+	top := ticker{text: scrolltext.New(scrolltext.Normalize(tickerMessages[1])), speed: 0.6, top: true}
+	top.text.WaveHeight = 2
+	top.text.Phase = math.Pi
+	return []ticker{bottom, top}
+}
+
+// centerY is the row the ticker's middle runs along on a canvas height
+// rows tall, with room for its wave between it and the edge.
+func (t ticker) centerY(height int) int {
+	half := t.text.Height()/2 + int(math.Ceil(t.text.WaveHeight))
+	if t.top {
+		return half
+	}
+	return height - 1 - half
+}
+
+// setTickerMessage scrolls text on the i'th ticker, from the right edge.
+func (m *model) setTickerMessage(i int, text string) {
+	m.tickers[i].text.Message = scrolltext.Normalize(text)
+	m.tickers[i].text.Pos = -float64(m.width)
+}
+
+// renderTickers draws the tickers that are shown, colored like the main
+// scroller.
+func (m *model) renderTickers(c *canvas.Canvas) {
+	for _, t := range m.tickers[:m.lines-1] {
+		t.text.Draw(c, t.centerY(c.Height()), func(x, y, index int) (rune, canvas.Style) {
+			ch, style := m.getStyledCharacter(x, y, index)
+			style.Bg = c.Get(x, y).Style.Bg
+			return ch, style
+		})
+	}
+}
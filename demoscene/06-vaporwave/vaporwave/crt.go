@@ -0,0 +1,176 @@
+package vaporwave
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// Over the finished scene can go four effects of an old television or a
+// worn tape, each on its own key. They are the top layers of the stack, so
+// they work on the scene as composed, reading the cells below them back
+// and changing them: scan lines dim every other row under a brighter band
+// rolling down the screen; tears throw a few rows sideways now and then;
+// the color offset takes each cell's red from its left neighbor and its
+// blue from its right, as if the beams had slipped; and noise speckles the
+// picture, thickest along a tracking band near the bottom.
+
+// The overlay layers, applied in this order.
+const (
+	scanlinesLayer = "scanlines"
+	tearsLayer     = "tears"
+	chromaLayer    = "chroma"
+	noiseLayer     = "noise"
+)
+
+// overlays are the layers the keys show and hide, in status line order.
+var overlays = []string{scanlinesLayer, tearsLayer, chromaLayer, noiseLayer}
+
+// overlayNames name the overlays for the status line.
+var overlayNames = map[string]string{
+	scanlinesLayer: "scan lines",
+	tearsLayer:     "tears",
+	chromaLayer:    "color offset",
+	noiseLayer:     "noise",
+}
+
+// tint mixes a cell's color towards another, leaving cells without a color
+// of their own, or with one that is not a hex color, alone.
+func tint(color lipgloss.Color, to common.RGB, amount float64) lipgloss.Color {
+	if !strings.HasPrefix(string(color), "#") {
+		return color
+	}
+	return common.LerpRGB(common.ParseHex(string(color)), to, amount).Color()
+}
+
+// renderScanlines darkens every other row and lightens a band of two rows
+// that rolls down the screen, standing still when motion is reduced.
+func (m *model) renderScanlines(c *canvas.Canvas) {
+	band := -1
+	if !engine.ReducedMotion() && c.Height() > 0 {
+		band = int(m.time*6) % c.Height()
+	}
+	white := common.RGB{R: 255, G: 255, B: 255}
+	for y := 0; y < c.Height(); y++ {
+		for x := 0; x < c.Width(); x++ {
+			cell := c.Get(x, y)
+			switch {
+			case y == band || y == band+1:
+				cell.Style.Fg = tint(cell.Style.Fg, white, 0.3)
+				cell.Style.Bg = tint(cell.Style.Bg, white, 0.15)
+			case y%2 == 1:
+				cell.Style.Fg = tint(cell.Style.Fg, common.RGB{}, 0.35)
+				cell.Style.Bg = tint(cell.Style.Bg, common.RGB{}, 0.35)
+			default:
+				continue
+			}
+			c.Set(x, y, cell.Rune, cell.Style)
+		}
+	}
+}
+
+// renderTears now and then throws up to three rows sideways, wrapping what
+// falls off one edge round to the other. A tear lasts an eighth of a unit
+// of time; with motion reduced there are none.
+func (m *model) renderTears(c *canvas.Canvas) {
+	if engine.ReducedMotion() || c.Height() == 0 {
+		return
+	}
+	slot := int(m.time * 8)
+	if hash(slot) > 0.3 {
+		return
+	}
+	width := c.Width()
+	row := make([]canvas.Cell, width)
+	for i := 0; i < 1+int(hash(slot+1)*3); i++ {
+		y := int(hash(slot*7+i) * float64(c.Height()))
+		shift := 2 + int(hash(slot*13+i)*8)
+		if hash(slot*17+i) < 0.5 {
+			shift = -shift
+		}
+		for x := range row {
+			row[x] = c.Get(x, y)
+		}
+		for x := range row {
+			cell := row[((x-shift)%width+width)%width]
+			if cell.Rune == canvas.Continued {
+				cell.Rune = ' '
+			}
+			c.Set(x, y, cell.Rune, cell.Style)
+		}
+	}
+}
+
+// renderChroma splits each cell's color across its neighbors: its red is
+// the red of the cell to its left and its blue the blue of the cell to its
+// right.
+func (m *model) renderChroma(c *canvas.Canvas) {
+	row := make([]canvas.Cell, c.Width())
+	for y := 0; y < c.Height(); y++ {
+		for x := range row {
+			row[x] = c.Get(x, y)
+		}
+		for x, cell := range row {
+			if !strings.HasPrefix(string(cell.Style.Fg), "#") {
+				continue
+			}
+			rgb := common.ParseHex(string(cell.Style.Fg))
+			if left := row[max(x-1, 0)].Style.Fg; strings.HasPrefix(string(left), "#") {
+				rgb.R = common.ParseHex(string(left)).R
+			}
+			if right := row[min(x+1, len(row)-1)].Style.Fg; strings.HasPrefix(string(right), "#") {
+				rgb.B = common.ParseHex(string(right)).B
+			}
+			cell.Style.Fg = rgb.Color()
+			c.Set(x, y, cell.Rune, cell.Style)
+		}
+	}
+}
+
+// renderNoise speckles the picture with grey grain that changes every
+// frame, and runs a thicker band of it near the bottom, the tape's
+// tracking, slowly up the screen.
+func (m *model) renderNoise(c *canvas.Canvas) {
+	frame := int(m.time * 20)
+	if engine.ReducedMotion() {
+		frame = 0
+	}
+	height := c.Height()
+	tracking := height - 3 - int(m.time*2)%max(height/4, 1)
+	if engine.ReducedMotion() {
+		tracking = height - 3
+	}
+	grain := []rune("·░▒")
+	for y := 0; y < height; y++ {
+		density := 0.02
+		if y == tracking || y == tracking+1 {
+			density = 0.35
+		}
+		for x := 0; x < c.Width(); x++ {
+			n := hash((frame*height+y)*c.Width() + x)
+			if n >= density {
+				continue
+			}
+			grey := uint8(120 + n/density*120)
+			g := grain[int(n/density*float64(len(grain)))]
+			c.Set(x, y, g, canvas.Style{Fg: common.RGB{R: grey, G: grey, B: grey}.Color(), Bg: c.Get(x, y).Style.Bg})
+		}
+	}
+}
+
+// overlayStatus names the overlays shown, for the status line.
+func (m model) overlayStatus() string {
+	var shown []string
+	for _, name := range overlays {
+		if m.layers.Visible(name) {
+			shown = append(shown, overlayNames[name])
+		}
+	}
+	if len(shown) == 0 {
+		return ""
+	}
+	return " | FX: " + strings.Join(shown, ", ")
+}
@@ -0,0 +1,163 @@
+package vaporwave
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// With the day running, the sun sets below the grid's horizon, the sky
+// goes through the reds of dusk to night, stars come out, and the sun
+// rises again. The clock runs from 0 to 1 over a day and starts at the
+// scene as it always was, the sun at its height in the evening sky.
+
+// dayLengths are the seconds a whole day takes at each setting of the day
+// key, the first leaving the clock stopped.
+var dayLengths = []float64{0, 180, 60, 20}
+
+// dayNames name the settings, for the status line.
+var dayNames = []string{"", "slow", "medium", "fast"}
+
+const numStars = 60
+
+// nightSky is the color the sky darkens to, top and bottom.
+var nightSky = []string{"#02010A", "#0A0A2A", "#1A1040"}
+
+// duskColor is the red the sky near the horizon takes as the sun goes down.
+const duskColor = "#FF3010"
+
+// skyStar is a star, placed as a fraction of the sky.
+type skyStar struct {
+	x, y float64
+	// fade is how dark the sky must be before it comes out
+	fade  float64
+	phase float64
+}
+
+// generateStars scatters the night's stars.
+func (m *model) generateStars() {
+	m.stars = make([]skyStar, numStars)
+	for i := range m.stars {
+		m.stars[i] = skyStar{
+			x: rng.Float64(), y: rng.Float64(),
+			fade:  0.2 + rng.Float64()*0.7,
+			phase: rng.Float64() * 2 * math.Pi,
+		}
+	}
+}
+
+// advanceDay moves the clock on by the frame's time.
+func (m *model) advanceDay() {
+	if length := dayLengths[m.day]; length > 0 {
+		seconds := m.anim.Delta() / engine.DefaultFPS
+		m.clock = math.Mod(m.clock+seconds/length, 1)
+	}
+}
+
+// sunHeight is how high the sun is, 1 at its height and -1 at midnight.
+func (m model) sunHeight() float64 {
+	return math.Cos(2 * math.Pi * m.clock)
+}
+
+// darkness is how far the sky has gone to night, 0 by day and 1 once the
+// sun is well below the horizon.
+func (m model) darkness() float64 {
+	return common.Clamp((0.1-m.sunHeight())/0.7, 0, 1)
+}
+
+// dusk is how red the sky is, strongest as the sun touches the horizon.
+func (m model) dusk() float64 {
+	return common.Clamp(1-math.Abs(m.sunHeight()+0.05)*3, 0, 1)
+}
+
+// sunDrop is how many rows the sun has sunk from its height: at midnight
+// it is far enough below the horizon that not even its glow shows.
+func (m model) sunDrop(radius float64) int {
+	low := float64(m.height/3-m.height/4) + (radius+6)/1.6 + 1
+	return int((1 - m.sunHeight()) / 2 * low)
+}
+
+// horizon is the first row of the grid, bobbing while driving.
+func (m model) horizon() int {
+	return m.height/3 + m.bob()
+}
+
+// skyTint colors the sky for the time of day: red towards the horizon at
+// dusk and dark at night. depth is how far down the sky the color is,
+// from 0 at the top to 1 at the horizon.
+func (m model) skyTint(color lipgloss.Color, depth float64) lipgloss.Color {
+	dusk, dark := m.dusk()*depth, m.darkness()
+	if dusk == 0 && dark == 0 {
+		return color
+	}
+	c := common.LerpRGB(common.ParseHex(string(color)), common.ParseHex(duskColor), dusk*0.6)
+	night := common.ParseHex(string(common.Sample(nightSky, depth)))
+	return common.LerpRGB(c, night, dark*0.9).Color()
+}
+
+// sunTint reddens the sun as it sinks.
+func (m model) sunTint(color lipgloss.Color) lipgloss.Color {
+	low := common.Clamp(1-m.sunHeight(), 0, 1)
+	if low == 0 {
+		return color
+	}
+	return common.LerpRGB(common.ParseHex(string(color)), common.ParseHex(duskColor), low*0.5).Color()
+}
+
+// renderStars draws the stars that the dark has brought out, twinkling
+// unless motion is reduced.
+func (m *model) renderStars(c *canvas.Canvas) {
+	dark := m.darkness()
+	if dark == 0 {
+		return
+	}
+	sky := m.horizon()
+	for _, s := range m.stars {
+		if s.fade > dark {
+			continue
+		}
+		// Each comes up to full brightness as the sky darkens past it
+		bright := common.Clamp((dark-s.fade)/0.2, 0, 1)
+		if !engine.ReducedMotion() {
+			bright *= 0.75 + 0.25*math.Sin(m.time*4+s.phase)
+		}
+		x, y := int(s.x*float64(m.width)), int(s.y*float64(sky))
+		char := "·"
+		if bright > 0.8 {
+			char = "✦"
+		}
+		color := common.LerpRGB(common.ParseHex(nightSky[1]), common.RGB{R: 255, G: 255, B: 255}, bright)
+		setChar(c, x, y, char, color.Color())
+	}
+}
+
+// dayStatus is the time of day and how fast it runs, for the status line,
+// while the day runs.
+func (m model) dayStatus() string {
+	if m.day == 0 {
+		return ""
+	}
+	return " | " + m.timeOfDay() + " (" + dayNames[m.day] + ")"
+}
+
+// timeOfDay names the time for the status line.
+func (m model) timeOfDay() string {
+	h := m.sunHeight()
+	rising := m.clock > 0.5
+	switch {
+	case h > 0.2 && rising:
+		return "☀ Morning"
+	case h > 0.2:
+		return "☀ Evening"
+	case h > -0.4 && rising:
+		return "🌅 Sunrise"
+	case h > -0.4:
+		return "🌇 Sunset"
+	default:
+		return "🌙 Night"
+	}
+}
@@ -0,0 +1,95 @@
+package vaporwave
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// Driving, the camera travels forward over the grid instead of watching it
+// roll by: the lines across it come up out of the horizon and speed up as
+// they near, as a road's markings do, and the horizon bobs with the bumps.
+
+// driveSpeeds are the grid units the camera travels a second in each gear
+// of the drive key, the first parked.
+var driveSpeeds = []float64{0, 3, 8, 16}
+
+// driveNames name the gears, for the status line.
+var driveNames = []string{"", "cruise", "fast", "turbo"}
+
+const (
+	// driveView is how far off the floor is on the first row below the
+	// horizon, in grid units; row depth rows down it is driveView / depth.
+	driveView = 30.0
+	// driveLens is how many columns a grid unit spans one unit away.
+	driveLens = 8.0
+	// driveSpacing is the grid units between lines.
+	driveSpacing = 1.8
+	// driveBob is how many rows the horizon bobs up and down.
+	driveBob = 1
+)
+
+// advanceDrive moves the camera on by the frame's time.
+func (m *model) advanceDrive() {
+	seconds := m.anim.Delta() / engine.DefaultFPS
+	m.distance += driveSpeeds[m.gear] * seconds
+}
+
+// bob is how many rows the bumps of the road have moved the horizon.
+func (m model) bob() int {
+	if m.gear == 0 || engine.ReducedMotion() {
+		return 0
+	}
+	return int(math.Round(math.Sin(m.distance*0.35) * driveBob))
+}
+
+// onLine reports whether a span of the floor from a to b crosses a grid
+// line, and whether that line is a major one, every fourth.
+func onLine(a, b float64) (on, major bool) {
+	line := math.Floor(b / driveSpacing)
+	if math.Floor(a/driveSpacing) == line {
+		return false, false
+	}
+	return true, int(line)%4 == 0
+}
+
+// renderRoad draws the grid as the camera drives over it, in true
+// perspective: a cell on row depth looks at the floor driveView / depth
+// away, and covers what lies between its edges there.
+func (m *model) renderRoad(c *canvas.Canvas) {
+	horizon := m.horizon()
+	for y := horizon; y < m.height; y++ {
+		depth := float64(y - horizon + 1)
+		far, near := driveView/(depth-0.5), driveView/(depth+0.5)
+		z := driveView / depth
+		for x := 0; x < m.width; x++ {
+			left := (float64(x) - float64(m.width)/2) * z / driveLens
+			right := left + z/driveLens
+			lineX, majorX := onLine(left, right)
+			lineZ, majorZ := onLine(near+m.distance, far+m.distance)
+			if !lineX && !lineZ {
+				continue
+			}
+			intensity := (1.0 / (z*0.06 + 1)) * m.gridIntensity * m.gridPulse()
+			if majorX || majorZ {
+				intensity *= 2.0
+			}
+			if lineX && lineZ {
+				intensity *= 1.6
+			}
+			intensity = common.Clamp(intensity, 0, 1.5)
+			char := m.getEnhancedGridChar(lineX, lineZ, majorX, majorZ, intensity)
+			setChar(c, x, y, char, m.getGridColor(intensity))
+		}
+	}
+}
+
+// driveStatus is the gear, for the status line, while driving.
+func (m model) driveStatus() string {
+	if m.gear == 0 {
+		return ""
+	}
+	return " | 🚗 " + driveNames[m.gear]
+}
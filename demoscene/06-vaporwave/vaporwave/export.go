@@ -0,0 +1,98 @@
+package vaporwave
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/raster"
+	"github.com/yourusername/bubbletea-showcase/common/screenshot"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// The scene can be saved as a PNG, for a wallpaper: just the scene, without
+// the title, status or help, in full color whatever the terminal shows,
+// each cell drawn with the bundled 7×13 bitmap font and blown up exportScale
+// times. It goes where screenshots go.
+
+// exportScale is how many pixels across and down each pixel of a cell
+// becomes.
+const exportScale = 2
+
+// exportColor is the color a cell's color is drawn in, or def for a cell
+// without one.
+func exportColor(c lipgloss.Color, def color.RGBA) color.RGBA {
+	var rgb common.RGB
+	if s := string(c); strings.HasPrefix(s, "#") {
+		rgb = common.ParseHex(s)
+	} else if i, err := strconv.Atoi(s); err == nil {
+		rgb = termcolor.ANSI(i)
+	} else {
+		return def
+	}
+	return color.RGBA{R: rgb.R, G: rgb.G, B: rgb.B, A: 0xFF}
+}
+
+// exportScreen lays the cells of c out as a screen to rasterize.
+func exportScreen(c *canvas.Canvas) *raster.Screen {
+	s := &raster.Screen{Cols: c.Width(), Rows: c.Height(), Cells: make([]raster.Cell, c.Width()*c.Height())}
+	for y := 0; y < c.Height(); y++ {
+		for x := 0; x < c.Width(); x++ {
+			cell := c.Get(x, y)
+			r := cell.Rune
+			if r == canvas.Continued {
+				r = 0
+			}
+			s.Cells[y*s.Cols+x] = raster.Cell{
+				Rune: r,
+				Fg:   exportColor(cell.Style.Fg, raster.DefaultFg),
+				Bg:   exportColor(cell.Style.Bg, raster.DefaultBg),
+			}
+		}
+	}
+	return s
+}
+
+// scaleImage blows img up n times, each pixel becoming an n by n square.
+func scaleImage(img *image.RGBA, n int) *image.RGBA {
+	b := img.Bounds()
+	out := image.NewRGBA(image.Rect(0, 0, b.Dx()*n, b.Dy()*n))
+	for y := 0; y < b.Dy()*n; y++ {
+		for x := 0; x < b.Dx()*n; x++ {
+			out.SetRGBA(x, y, img.RGBAAt(b.Min.X+x/n, b.Min.Y+y/n))
+		}
+	}
+	return out
+}
+
+// exportPNG draws the scene as it stands and writes it to a PNG named for
+// the time, returning the file's path.
+func (m *model) exportPNG() (string, error) {
+	m.grid.Clear()
+	m.layers.Draw(m.grid, m)
+	img := scaleImage(exportScreen(m.grid).Image(), exportScale)
+
+	dir := screenshot.Dir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("vaporwave-%s.png", time.Now().Format("20060102-150405")))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
@@ -0,0 +1,60 @@
+package vaporwave
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+)
+
+// With music playing the sun and grid move to it rather than to the clock:
+// the sun swells with the music's loudness and the grid glows brighter as
+// it gets louder, and on top of that both flash on the beat. Without music
+// the sun breathes on two sines and the grid holds steady.
+
+const (
+	// levelSmoothing is how much of the gap to a new loudness the level
+	// closes at each reading, so the sun swells rather than jitters.
+	levelSmoothing = 0.4
+
+	// sunSwell is how much larger than usual the sun grows at full
+	// loudness, and gridSwell how much brighter the grid glows.
+	sunSwell  = 0.6
+	gridSwell = 0.8
+)
+
+// hear takes a new reading of the music's loudness, from 0 to 1.
+func (m *model) hear(level float64) {
+	m.level += (level - m.level) * levelSmoothing
+}
+
+// musicLevel is how loud the music is, or -1 without music or with
+// motion reduced, when the synthetic pulse stands in.
+func (m model) musicLevel() float64 {
+	if m.player == nil || engine.ReducedMotion() {
+		return -1
+	}
+	return m.level
+}
+
+// sunScale is what the pulse multiplies the sun's radius by.
+func (m model) sunScale() float64 {
+	pulse := 1.0
+	if m.sunPulse {
+		if level := m.musicLevel(); level >= 0 {
+			pulse = 1 - sunSwell/2 + sunSwell*level
+		} else {
+			pulse = 1.0 + math.Sin(m.time*2.5)*0.4 + math.Sin(m.time*4)*0.15
+		}
+	}
+	return pulse + m.beatFlash()*0.5
+}
+
+// gridPulse is what the music multiplies the grid's brightness by: 1
+// without it.
+func (m model) gridPulse() float64 {
+	pulse := 1.0
+	if level := m.musicLevel(); level >= 0 {
+		pulse = 1 - gridSwell/2 + gridSwell*level
+	}
+	return pulse * (1 + m.beatFlash())
+}
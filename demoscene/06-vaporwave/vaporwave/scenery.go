@@ -0,0 +1,168 @@
+package vaporwave
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/noise"
+)
+
+// Between the sun and the viewer can stand three rows of scenery, each on
+// its own key: a mountain range on the horizon, a city skyline in front of
+// it and palm trees close by. They drift sideways, the nearer the faster,
+// so they pass one another as a camera panning along the grid would see
+// them, and take their colors from the mode.
+
+// The scenery layers, bottom to top. Mountains and city stand behind the
+// grid's top edge, over the sun; the palms stand on the grid.
+const (
+	mountainsLayer = "mountains"
+	cityLayer      = "city"
+	palmsLayer     = "palms"
+)
+
+// scenery are the layers the keys show and hide, in status line order.
+var scenery = []string{palmsLayer, mountainsLayer, cityLayer}
+
+// How many columns each row of scenery drifts for each unit of the
+// scene's time.
+const (
+	mountainDrift = 2.0
+	cityDrift     = 5.0
+	palmDrift     = 14.0
+)
+
+// palmArt is a palm tree, its trunk leaning.
+const palmArt = `
+ ▄▀▀▀▄ ▄▀▀▀▄
+▀  ▄▀▀█▀▀▄  ▀
+  ▀   █   ▀
+      █
+     █
+     █
+    ▄█▄`
+
+// palmSpacing is the columns between palms, give or take some.
+const palmSpacing = 38
+
+// hash is a repeatable value from 0 to 1 for an integer, to place and size
+// the buildings and palms the same way each time round.
+func hash(i int) float64 {
+	x := uint32(i)*2654435761 + 0x9E3779B9
+	x ^= x >> 15
+	x *= 0x85EBCA6B
+	x ^= x >> 13
+	return float64(x%10007) / 10007
+}
+
+// darken mixes a color of the mode towards black.
+func darken(hex string, amount float64) lipgloss.Color {
+	return common.LerpRGB(common.ParseHex(hex), common.RGB{}, amount).Color()
+}
+
+// renderMountains draws a ridge of fBm peaks standing on the horizon,
+// dark, with a neon line along the ridge.
+func (m *model) renderMountains(c *canvas.Canvas) {
+	mode := m.modes[m.mode]
+	horizon := m.horizon()
+	peak := float64(horizon) * 0.5
+	body := darken(mode.gridGrad[len(mode.gridGrad)-1], 0.6)
+	ridge := lipgloss.Color(mode.gridGrad[0])
+	shift := m.time * mountainDrift
+	for x := 0; x < m.width; x++ {
+		n := noise.FBM1(noise.Simplex1, (float64(x)+shift)*0.04, 3, 2.0, 0.5)
+		height := peak * (0.45 + 0.4*n)
+		top := float64(horizon) - height
+		for y := int(math.Ceil(top)); y < horizon; y++ {
+			setChar(c, x, y, "█", body)
+		}
+		// The ridge takes the top cell, or its lower half when the peak
+		// reaches only halfway into it
+		y := int(math.Floor(top))
+		if top-float64(y) < 0.5 {
+			c.Set(x, y, '▀', canvas.Style{Fg: ridge, Bg: body})
+		} else {
+			setChar(c, x, y, "▄", ridge)
+		}
+	}
+}
+
+// renderCity draws a skyline of blocks of different widths and heights,
+// their windows lit in the sun's colors.
+func (m *model) renderCity(c *canvas.Canvas) {
+	mode := m.modes[m.mode]
+	horizon := m.horizon()
+	tallest := max(float64(horizon)*0.4, 2)
+	body := darken(mode.skyGrad[len(mode.skyGrad)-1], 0.7)
+	shift := int(m.time * cityDrift)
+	// Buildings are laid out along the street from column 0, each found by
+	// walking from the one before; only those on screen are drawn
+	x := 0
+	for i := 0; x-shift < m.width; i++ {
+		width := 3 + int(hash(i)*5)
+		gap := int(hash(i+1000) * 3)
+		left := x - shift
+		x += width + gap
+		if left+width < 0 {
+			continue
+		}
+		height := 2 + int(hash(i+2000)*(tallest-2))
+		for row := 0; row < height; row++ {
+			y := horizon - 1 - row
+			for col := 0; col < width; col++ {
+				// Windows on every other row and column, some of them lit
+				if row%2 == 1 && col%2 == 1 && col < width-1 && row < height-1 &&
+					hash(i*97+row*13+col) > 0.45 {
+					lit := mode.sunColor[int(hash(i+row+col)*float64(len(mode.sunColor)))]
+					c.Set(left+col, y, '▪', canvas.Style{Fg: lipgloss.Color(lit), Bg: body})
+					continue
+				}
+				setChar(c, left+col, y, "█", body)
+			}
+		}
+	}
+}
+
+// renderPalms draws silhouettes of palm trees standing on the grid near
+// the horizon, spaced unevenly.
+func (m *model) renderPalms(c *canvas.Canvas) {
+	mode := m.modes[m.mode]
+	color := darken(mode.skyGrad[0], 0.8)
+	art := strings.Split(strings.TrimPrefix(palmArt, "\n"), "\n")
+	base := m.horizon() + 2
+	shift := m.time * palmDrift
+	first := int(math.Floor(shift / palmSpacing))
+	for i := first - 1; ; i++ {
+		left := int(float64(i)*palmSpacing+hash(i)*palmSpacing/2-shift) - 6
+		if left >= m.width {
+			break
+		}
+		top := base - len(art) + int(hash(i+500)*3)
+		for row, line := range art {
+			x := left
+			for _, r := range line {
+				if r != ' ' {
+					setChar(c, x, top+row, string(r), color)
+				}
+				x++
+			}
+		}
+	}
+}
+
+// sceneryStatus names the scenery shown, for the status line.
+func (m model) sceneryStatus() string {
+	var shown []string
+	for _, name := range scenery {
+		if m.layers.Visible(name) {
+			shown = append(shown, name)
+		}
+	}
+	if len(shown) == 0 {
+		return ""
+	}
+	return " | Scenery: " + strings.Join(shown, ", ")
+}
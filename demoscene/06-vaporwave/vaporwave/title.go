@@ -0,0 +1,182 @@
+package vaporwave
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/font"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+)
+
+// A title can float over the horizon in large letters lit like neon tube:
+// a bright core in the grid's color with a dim halo round it, tinting the
+// cells behind and about the letters and fading with distance, flickering
+// now and then as a worn tube does. It is given with --title or typed in
+// while the demo runs; an empty title takes it away.
+
+// titleLayer sits over the shapes, under the particles and overlays.
+const titleLayer = "title"
+
+// titleFonts are the built-in fonts the title is drawn in, the first that
+// fits across the screen.
+var titleFonts = []string{"block", "mini"}
+
+// titleHalo is how many columns the halo reaches out from the letters; it
+// reaches half as many rows.
+const titleHalo = 2
+
+// setTitle sets the title and lays it out in the largest font that fits.
+func (m *model) setTitle(text string) {
+	m.title = strings.TrimSpace(text)
+	m.titleFont = nil
+	if m.title == "" {
+		return
+	}
+	for _, name := range titleFonts {
+		f, err := font.Builtin(name)
+		if err != nil {
+			continue
+		}
+		m.titleFont = f
+		if f.Width(m.title)+2*titleHalo <= m.width {
+			return
+		}
+	}
+}
+
+// flicker is how lit the tube is: now and then it dips for a moment, and
+// with motion reduced it holds steady.
+func (m model) flicker() float64 {
+	if engine.ReducedMotion() {
+		return 1
+	}
+	if slot := int(m.time * 12); hash(slot+9000) < 0.04 {
+		return 0.35
+	}
+	return 1
+}
+
+// renderTitle draws the title centered above the horizon, bobbing gently,
+// its halo first, as the background of the cells it reaches, and the
+// letters over it.
+func (m *model) renderTitle(c *canvas.Canvas) {
+	if m.titleFont == nil {
+		return
+	}
+	rows, _ := m.titleFont.Layout(m.title)
+	if len(rows) == 0 {
+		return
+	}
+	width, height := len(rows[0]), len(rows)
+	left := (c.Width() - width) / 2
+	top := max(m.horizon()-height-2, 1)
+	if !engine.ReducedMotion() {
+		top += int(math.Round(math.Sin(m.time*1.5) * 0.6))
+	}
+
+	mode := m.modes[m.mode]
+	lit := m.flicker()
+	white := common.RGB{R: 255, G: 255, B: 255}
+	neon := common.ParseHex(mode.gridGrad[0])
+	core := common.LerpRGB(common.RGB{}, common.LerpRGB(neon, white, 0.5), lit).Color()
+	lit *= 1 + m.beatFlash()*0.5
+
+	solid := func(x, y int) bool {
+		return y >= 0 && y < height && x >= 0 && x < width && rows[y][x].Rune != ' '
+	}
+	for y := -titleHalo / 2; y < height+titleHalo/2; y++ {
+		for x := -titleHalo; x < width+titleHalo; x++ {
+			// The halo is brighter the nearer it is to a letter
+			near := math.Inf(1)
+			for dy := -titleHalo / 2; dy <= titleHalo/2; dy++ {
+				for dx := -titleHalo; dx <= titleHalo; dx++ {
+					if solid(x+dx, y+dy) {
+						near = math.Min(near, math.Hypot(float64(dx), float64(dy)*2))
+					}
+				}
+			}
+			if math.IsInf(near, 1) {
+				continue
+			}
+			glow := common.Clamp((1-near/(titleHalo+1))*lit, 0, 1)
+			cx, cy := left+x, top+y
+			if c.InBounds(cx, cy) {
+				cell := c.Get(cx, cy)
+				cell.Style.Bg = common.LerpRGB(common.RGB{}, neon, glow*0.45).Color()
+				c.Set(cx, cy, cell.Rune, cell.Style)
+			}
+		}
+	}
+	for y, row := range rows {
+		for x, cell := range row {
+			if cell.Rune == ' ' || !c.InBounds(left+x, top+y) {
+				continue
+			}
+			c.Set(left+x, top+y, cell.Rune, canvas.Style{Fg: core, Bg: c.Get(left+x, top+y).Style.Bg})
+		}
+	}
+}
+
+type titleKeyMap struct {
+	Apply  key.Binding
+	Cancel key.Binding
+	Taken  key.Binding
+	Quit   key.Binding
+	Help   key.Binding
+}
+
+// While the title is typed, + and - are text rather than the frame rate,
+// and help is on F1.
+var titleKeys = titleKeyMap{
+	Apply:  keymap.New("enter", "show it"),
+	Cancel: keymap.New("esc", "cancel"),
+	Taken:  keymap.Hidden("+", "-"),
+	Quit:   keymap.New("ctrl+c", "quit"),
+	Help:   keymap.New("F1", "help", "f1"),
+}
+
+// openTitle starts typing a new title, from the one shown.
+func (m model) openTitle() model {
+	in := textinput.New()
+	in.Prompt = "Title: "
+	in.CharLimit = 40
+	in.Width = max(20, m.width-lipgloss.Width(in.Prompt)-1)
+	in.Cursor.SetMode(cursor.CursorStatic)
+	in.SetValue(m.title)
+	in.CursorEnd()
+	in.Focus()
+	m.typing = &in
+	return m
+}
+
+// titleKey handles keys while the title is typed.
+func (m model) titleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, titleKeys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, titleKeys.Cancel):
+		m.typing = nil
+		return m, nil
+	case key.Matches(msg, titleKeys.Apply):
+		m.setTitle(m.typing.Value())
+		m.typing = nil
+		return m, nil
+	}
+	in, cmd := m.typing.Update(msg)
+	m.typing = &in
+	return m, cmd
+}
+
+// titleHint is the status line while the title is typed.
+func (m model) titleHint() string {
+	return lipgloss.NewStyle().Foreground(common.Cyan).
+		Render("Type a title to float over the horizon, or nothing for none")
+}
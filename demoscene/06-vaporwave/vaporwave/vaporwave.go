@@ -0,0 +1,1026 @@
+// Package vaporwave is a retro synthwave landscape with a neon grid and
+// floating shapes.
+package vaporwave
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/audio"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/compose"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/font"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/noise"
+	"github.com/yourusername/bubbletea-showcase/common/palette"
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+// Floating shape for visual interest
+type floatingShape struct {
+	x, y     float64
+	vx, vy   float64
+	size     float64
+	rotation float64
+	rotSpeed float64
+	shape    string
+	color    lipgloss.Color
+	age      float64
+}
+
+// Color mode configuration
+type colorMode struct {
+	name     string
+	skyGrad  []string
+	sunColor []string
+	gridGrad []string
+	fogColor string
+}
+
+type model struct {
+	// Display properties
+	width  int
+	height int
+	grid   *canvas.Canvas // Grid-based rendering for performance
+	layers *compose.Stack[*model]
+	
+	// Animation state
+	anim engine.Animator
+	time float64
+	
+	// Scene elements
+	shapes    []floatingShape
+	particles *particles.System
+	stars     []skyStar
+
+	// The time of day, from 0 to 1, and the setting of dayLengths it
+	// runs at
+	clock float64
+	day   int
+
+	// Driving, how far the camera has come over the grid and the gear it
+	// is in
+	distance float64
+	gear     int
+
+	// The title over the horizon, the font it fits in, and its input
+	// while it is typed
+	title     string
+	titleFont *font.Font
+	typing    *textinput.Model
+
+	// notice is what the last export said, shown until the next key
+	notice string
+	
+	// Configuration
+	mode         int
+	modes        []colorMode
+	gridIntensity float64
+	sunPulse     bool
+
+	// Music, when playing, swells the sun and grid with its loudness and
+	// flashes them on the beat
+	player *audio.Player
+	song   string
+	flash  float64
+	level  float64 // the music's loudness, smoothed
+	rows   bool    // the music is a tracker module, timed by its rows
+}
+
+type keyMap struct {
+	Mode      key.Binding
+	Cycle     key.Binding
+	Faster    key.Binding
+	Slower    key.Binding
+	Fainter   key.Binding
+	Brighter  key.Binding
+	Shapes    key.Binding
+	Fog       key.Binding
+	Pulse     key.Binding
+	Day       key.Binding
+	Palms     key.Binding
+	Mountains key.Binding
+	City      key.Binding
+	Drive     key.Binding
+	Scanlines key.Binding
+	Tears     key.Binding
+	Chroma    key.Binding
+	Noise     key.Binding
+	Title     key.Binding
+	Export    key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Mode:      keymap.New("1-4", "modes", "1", "2", "3", "4"),
+	Cycle:     keymap.New("c", "cycle palettes"),
+	Faster:    keymap.New("↑↓", "speed", "up"),
+	Slower:    keymap.Hidden("down"),
+	Fainter:   keymap.New("←→", "grid", "left"),
+	Brighter:  keymap.Hidden("right"),
+	Shapes:    keymap.New("s", "shapes"),
+	Fog:       keymap.New("f", "fog"),
+	Pulse:     keymap.New("p", "pulse"),
+	Day:       keymap.New("d", "day/night"),
+	Palms:     keymap.New("t", "palm trees"),
+	Mountains: keymap.New("m", "mountains"),
+	City:      keymap.New("b", "city"),
+	Drive:     keymap.New("v", "drive"),
+	Scanlines: keymap.New("l", "scan lines"),
+	Tears:     keymap.New("g", "glitch"),
+	Chroma:    keymap.New("o", "color offset"),
+	Noise:     keymap.New("n", "noise"),
+	Title:     keymap.New("e", "edit title"),
+	Export:    keymap.New("x", "export PNG"),
+	Common:    keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Mode          int     `json:"mode"`
+	Speed         float64 `json:"speed"`
+	GridIntensity float64 `json:"gridIntensity"`
+	Shapes        bool    `json:"shapes"`
+	Fog           bool    `json:"fog"`
+	SunPulse      bool    `json:"sunPulse"`
+	Day           int     `json:"day"`
+	Palms         bool    `json:"palms"`
+	Mountains     bool    `json:"mountains"`
+	City          bool    `json:"city"`
+	Drive         int     `json:"drive"`
+	Scanlines     bool    `json:"scanlines"`
+	Tears         bool    `json:"tears"`
+	Chroma        bool    `json:"chroma"`
+	Noise         bool    `json:"noise"`
+	Title         string  `json:"title"`
+}
+
+func initialModel() model {
+	m := model{
+		width:         80,
+		height:        24,
+		anim:          engine.New(engine.SharedFPS),
+		mode:          0,
+		gridIntensity: 1.2,
+		sunPulse:      true,
+		modes: []colorMode{
+			{
+				name:     "Classic Vaporwave",
+				skyGrad:  []string{"#FF1493", "#FF69B4", "#DA70D6", "#9370DB", "#8A2BE2", "#4B0082"},
+				sunColor: []string{"#FFD700", "#FFA500", "#FF8C00", "#FF4500"},
+				gridGrad: []string{"#FF1493", "#DA70D6", "#9370DB", "#663399", "#4B0082"},
+				fogColor: "#FF69B4",
+			},
+			{
+				name:     "Miami Vice",
+				skyGrad:  []string{"#FF6EC7", "#FF8A80", "#FFB74D", "#4FC3F7", "#29B6F6", "#0277BD"},
+				sunColor: []string{"#FFD54F", "#FF8A65", "#FF7043", "#E91E63"},
+				gridGrad: []string{"#FF6EC7", "#AB47BC", "#7E57C2", "#5E35B1"},
+				fogColor: "#FF6EC7",
+			},
+			{
+				name:     "Outrun",
+				skyGrad:  []string{"#FF073A", "#FF6B35", "#F7931E", "#FFD23F", "#06FFA5", "#4ECDC4"},
+				sunColor: []string{"#FFD23F", "#F7931E", "#FF6B35", "#FF073A"},
+				gridGrad: []string{"#06FFA5", "#4ECDC4", "#45B7D1", "#96CEB4"},
+				fogColor: "#06FFA5",
+			},
+			{
+				name:     "Synthwave",
+				skyGrad:  []string{"#FF0099", "#FF6600", "#FFFF00", "#00FFFF", "#9900FF", "#000033"},
+				sunColor: []string{"#FFFF00", "#FF6600", "#FF0099", "#9900FF"},
+				gridGrad: []string{"#00FFFF", "#00CCFF", "#0099FF", "#0066FF"},
+				fogColor: "#FF0099",
+			},
+		},
+	}
+	// Registry palettes follow the built-in modes and are reached with c
+	palettes, _ := palette.All()
+	for _, p := range palettes {
+		m.modes = append(m.modes, paletteMode(p))
+	}
+	m.grid = canvas.New(m.width, m.height)
+	m.particles = particles.New(30)
+	m.particles.Decay = 0.02
+
+	// Scene layers, bottom to top
+	m.layers = compose.New[*model]()
+	m.layers.Add("sky", 0, compose.Func[*model]((*model).renderSky))
+	m.layers.Add("stars", 5, compose.Func[*model]((*model).renderStars))
+	m.layers.Add("sun", 10, compose.Func[*model]((*model).renderSun))
+	m.layers.Add(mountainsLayer, 12, compose.Func[*model]((*model).renderMountains))
+	m.layers.Add(cityLayer, 14, compose.Func[*model]((*model).renderCity))
+	m.layers.Add("grid", 20, compose.Func[*model]((*model).renderPerspectiveGrid))
+	m.layers.Add(palmsLayer, 25, compose.Func[*model]((*model).renderPalms))
+	m.layers.Add("shapes", 30, compose.Func[*model]((*model).renderFloatingShapes))
+	m.layers.Add(titleLayer, 35, compose.Func[*model]((*model).renderTitle))
+	m.layers.Add("fog", 40, compose.Func[*model]((*model).renderParticles))
+	m.layers.Add(scanlinesLayer, 90, compose.Func[*model]((*model).renderScanlines))
+	m.layers.Add(tearsLayer, 91, compose.Func[*model]((*model).renderTears))
+	m.layers.Add(chromaLayer, 92, compose.Func[*model]((*model).renderChroma))
+	m.layers.Add(noiseLayer, 93, compose.Func[*model]((*model).renderNoise))
+
+	p := prefs{Speed: 1.0, GridIntensity: m.gridIntensity, Shapes: true, Fog: true, SunPulse: m.sunPulse}
+	settings.Load("vaporwave", &p)
+	if p.Mode >= 0 && p.Mode < len(m.modes) {
+		m.mode = p.Mode
+	}
+	m.anim.SetSpeed(common.Clamp(p.Speed, 0.1, 3.0))
+	m.gridIntensity = common.Clamp(p.GridIntensity, 0.2, 2.0)
+	m.layers.SetVisible("shapes", p.Shapes)
+	m.layers.SetVisible("fog", p.Fog)
+	m.layers.SetVisible(palmsLayer, p.Palms)
+	m.layers.SetVisible(mountainsLayer, p.Mountains)
+	m.layers.SetVisible(cityLayer, p.City)
+	m.layers.SetVisible(scanlinesLayer, p.Scanlines)
+	m.layers.SetVisible(tearsLayer, p.Tears)
+	m.layers.SetVisible(chromaLayer, p.Chroma)
+	m.layers.SetVisible(noiseLayer, p.Noise)
+	m.sunPulse = p.SunPulse
+	if p.Day >= 0 && p.Day < len(dayLengths) {
+		m.day = p.Day
+	}
+	if p.Drive >= 0 && p.Drive < len(driveSpeeds) {
+		m.gear = p.Drive
+	}
+	m.setTitle(p.Title)
+
+	m.generateShapes()
+	m.generateStars()
+	return m
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+// The demo needs a terminal at least minWidth by minHeight; below that it
+// shows a notice instead.
+const minWidth, minHeight = 60, 20
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:       "06-vaporwave",
+		Heading:   "🌆 Vaporwave",
+		Summary:   "Retro synthwave landscape with neon grid and floating shapes",
+		Section:   registry.Demoscene,
+		Keywords:  []string{"demoscene", "synthwave", "outrun", "retro", "grid", "music"},
+		MinWidth:  minWidth,
+		MinHeight: minHeight,
+		Manual:    doc,
+		Build:     New,
+	})
+}
+
+// NewWithMusic returns the demo's model looping the WAV, Ogg Vorbis, MOD or
+// XM file at path and pulsing the scene to it. Stop the player when the
+// demo ends.
+func NewWithMusic(path string) (tea.Model, *audio.Player, error) {
+	return NewWithOptions(Options{Music: path})
+}
+
+// Options are what the command line can change.
+type Options struct {
+	// Music is a WAV, Ogg Vorbis, MOD or XM file to loop, pulsing the
+	// scene to it.
+	Music string
+	// Title is text to float over the horizon in neon letters, in place
+	// of the one kept from last time.
+	Title string
+}
+
+// NewWithOptions returns the demo's model set up as the options say. If
+// music is playing, the player is returned too; stop it when the demo ends.
+func NewWithOptions(o Options) (tea.Model, *audio.Player, error) {
+	m := initialModel()
+	if o.Title != "" {
+		m.setTitle(o.Title)
+	}
+	if o.Music == "" {
+		return m, nil, nil
+	}
+	player, err := audio.PlayFile(o.Music, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.player, m.song = player, filepath.Base(o.Music)
+	return m, player, nil
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "vaporwave", prefs{
+		Mode:          m.mode,
+		Speed:         m.anim.Speed(),
+		GridIntensity: m.gridIntensity,
+		Shapes:        m.layers.Visible("shapes"),
+		Fog:           m.layers.Visible("fog"),
+		SunPulse:      m.sunPulse,
+		Day:           m.day,
+		Palms:         m.layers.Visible(palmsLayer),
+		Mountains:     m.layers.Visible(mountainsLayer),
+		City:          m.layers.Visible(cityLayer),
+		Drive:         m.gear,
+		Scanlines:     m.layers.Visible(scanlinesLayer),
+		Tears:         m.layers.Visible(tearsLayer),
+		Chroma:        m.layers.Visible(chromaLayer),
+		Noise:         m.layers.Visible(noiseLayer),
+		Title:         m.title,
+	}
+}
+
+// paletteMode spreads a single registry gradient across the scene: the sky
+// runs through it in order while the sun and grid start from the bright end.
+func paletteMode(p palette.Palette) colorMode {
+	bright := make([]string, len(p.Colors))
+	for i, c := range p.Colors {
+		bright[len(p.Colors)-1-i] = c
+	}
+	return colorMode{
+		name:     p.Name,
+		skyGrad:  p.Colors,
+		sunColor: bright,
+		gridGrad: bright,
+		fogColor: bright[0],
+	}
+}
+
+// Generate floating shapes with aesthetic vaporwave elements
+func (m *model) generateShapes() {
+	// More vaporwave aesthetic shapes
+	shapes := []string{"◆", "◇", "▲", "△", "●", "○", "■", "□", "★", "☆", "♦", "◈", "▼", "▽", "◉", "◎"}
+	m.shapes = make([]floatingShape, 12) // More shapes for richer visuals
+	
+	for i := range m.shapes {
+		// Create depth layers - some shapes in foreground, some in background
+		layer := rng.Float64()
+		var yRange, speed, sizeRange float64
+		
+		if layer < 0.3 { // Background layer
+			yRange = float64(m.height) * 0.7
+			speed = 0.2
+			sizeRange = 0.5
+		} else if layer < 0.7 { // Mid layer  
+			yRange = float64(m.height) * 0.5
+			speed = 0.4
+			sizeRange = 1.0
+		} else { // Foreground layer
+			yRange = float64(m.height) * 0.3
+			speed = 0.6
+			sizeRange = 1.5
+		}
+		
+		m.shapes[i] = floatingShape{
+			x:        rng.Float64() * float64(m.width),
+			y:        rng.Float64() * yRange,
+			vx:       (rng.Float64() - 0.5) * speed,
+			vy:       (rng.Float64() - 0.5) * speed * 0.3,
+			size:     0.5 + rng.Float64()*sizeRange,
+			rotation: rng.Float64() * math.Pi * 2,
+			rotSpeed: (rng.Float64() - 0.5) * 0.05,
+			shape:    shapes[rng.Intn(len(shapes))],
+			color:    lipgloss.Color(m.modes[m.mode].skyGrad[rng.Intn(len(m.modes[m.mode].skyGrad))]),
+		}
+	}
+}
+
+// Emit atmospheric particles with multiple types
+func (m *model) emitParticles() {
+	if rng.Float64() >= 0.4 {
+		return
+	}
+	mode := m.modes[m.mode]
+	w, h := float64(m.width), float64(m.height)
+
+	var e particles.Emitter
+	switch particleType := rng.Float64(); {
+	case particleType < 0.6: // Floating sparkles throughout the sky
+		e = particles.Emitter{
+			X: w / 2, Y: h / 4, SpreadX: w, SpreadY: h / 2,
+			JitterVX: 0.3, JitterVY: 0.2,
+			Runes:  []rune("·•◦∘˙⋅∙"),
+			Colors: []lipgloss.Color{lipgloss.Color(mode.fogColor)},
+		}
+	case particleType < 0.8: // Rising stars
+		e = particles.Emitter{
+			X: w / 2, Y: h, SpreadX: w,
+			JitterVX: 0.1, VY: -0.25, JitterVY: 0.3,
+			Life:   1.5,
+			Runes:  []rune("✦✧⋆✶✷✸"),
+			Colors: colorList(mode.skyGrad),
+		}
+	default: // Drifting glows, longer lived
+		e = particles.Emitter{
+			X: w / 2, Y: h / 3, SpreadX: w, SpreadY: h * 2 / 3,
+			JitterVX: 0.15, JitterVY: 0.1,
+			Life:   2.0,
+			Runes:  []rune("◉◎○●◯"),
+			Colors: colorList(mode.sunColor),
+		}
+	}
+	e.Emit(m.particles, 1)
+}
+
+func colorList(hex []string) []lipgloss.Color {
+	colors := make([]lipgloss.Color, len(hex))
+	for i, c := range hex {
+		colors[i] = lipgloss.Color(c)
+	}
+	return colors
+}
+
+func (m model) Init() tea.Cmd {
+	if m.player != nil {
+		return tea.Batch(m.anim.Tick(), m.player.Listen())
+	}
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.grid.Resize(m.width, m.height)
+		m.generateShapes()
+		m.setTitle(m.title)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.05 * m.anim.Delta()
+			m.flash *= math.Pow(0.85, m.anim.Delta())
+			m.advanceDay()
+			m.advanceDrive()
+			m.updateScene()
+		}
+		return m, cmd
+
+	case audio.RowMsg:
+		// Flash on the first row of each bar and send up a burst of
+		// sparkles at the start of every pattern
+		if msg.Row%16 == 0 {
+			m.flash = 1
+		} else if msg.Row%4 == 0 && len(msg.Notes) > 0 {
+			m.flash = math.Max(m.flash, 0.4)
+		}
+		if msg.Row == 0 && m.layers.Visible("fog") {
+			for i := 0; i < 6; i++ {
+				m.emitParticles()
+			}
+		}
+		m.rows = true
+		return m, m.player.Listen()
+
+	case audio.BeatMsg:
+		if !m.rows {
+			m.flash = math.Min(1, msg.Strength/2)
+		}
+		return m, m.player.Listen()
+
+	case audio.EnergyMsg:
+		m.hear(msg.Level)
+		return m, m.player.Listen()
+
+	case audio.DoneMsg:
+		m.player = nil
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.typing != nil {
+			return m.titleKey(msg)
+		}
+		m.notice = ""
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.time = 0
+			m.clock = 0
+			m.distance = 0
+			m.anim.Reset()
+			m.generateShapes()
+			m.particles.Clear()
+		case key.Matches(msg, keys.Mode):
+			oldMode := m.mode
+			m.mode = int(msg.String()[0] - '1')
+			if m.mode != oldMode {
+				m.generateShapes() // Regenerate with new colors
+			}
+		case key.Matches(msg, keys.Cycle):
+			m.mode = (m.mode + 1) % len(m.modes)
+			m.generateShapes()
+		case key.Matches(msg, keys.Shapes):
+			m.layers.Toggle("shapes")
+		case key.Matches(msg, keys.Fog):
+			m.layers.Toggle("fog")
+		case key.Matches(msg, keys.Pulse):
+			m.sunPulse = !m.sunPulse
+		case key.Matches(msg, keys.Palms):
+			m.layers.Toggle(palmsLayer)
+		case key.Matches(msg, keys.Mountains):
+			m.layers.Toggle(mountainsLayer)
+		case key.Matches(msg, keys.City):
+			m.layers.Toggle(cityLayer)
+		case key.Matches(msg, keys.Export):
+			if path, err := m.exportPNG(); err != nil {
+				m.notice = "Export failed: " + err.Error()
+			} else {
+				m.notice = "Saved " + path
+			}
+		case key.Matches(msg, keys.Title):
+			m = m.openTitle()
+		case key.Matches(msg, keys.Scanlines):
+			m.layers.Toggle(scanlinesLayer)
+		case key.Matches(msg, keys.Tears):
+			m.layers.Toggle(tearsLayer)
+		case key.Matches(msg, keys.Chroma):
+			m.layers.Toggle(chromaLayer)
+		case key.Matches(msg, keys.Noise):
+			m.layers.Toggle(noiseLayer)
+		case key.Matches(msg, keys.Drive):
+			m.gear = (m.gear + 1) % len(driveSpeeds)
+		case key.Matches(msg, keys.Day):
+			// Stopping the day brings back the evening sun
+			m.day = (m.day + 1) % len(dayLengths)
+			if m.day == 0 {
+				m.clock = 0
+			}
+		case key.Matches(msg, keys.Faster):
+			m.anim.SetSpeed(common.Clamp(m.anim.Speed()+0.2, 0.1, 3.0))
+		case key.Matches(msg, keys.Slower):
+			m.anim.SetSpeed(common.Clamp(m.anim.Speed()-0.2, 0.1, 3.0))
+		case key.Matches(msg, keys.Fainter):
+			m.gridIntensity = common.Clamp(m.gridIntensity-0.2, 0.2, 2.0)
+		case key.Matches(msg, keys.Brighter):
+			m.gridIntensity = common.Clamp(m.gridIntensity+0.2, 0.2, 2.0)
+		}
+	}
+
+	return m, nil
+}
+
+// Update all scene elements using proper physics
+func (m *model) updateScene() {
+	dt := m.anim.Delta()
+
+	// Update floating shapes with physics
+	for i := range m.shapes {
+		s := &m.shapes[i]
+		s.x += s.vx * dt
+		s.y += s.vy * dt
+		s.rotation += s.rotSpeed * dt
+		s.age += 0.01
+		
+		// Gentle floating motion
+		s.y += math.Sin(s.age*2 + float64(i)) * 0.1
+		
+		// Wrap around screen boundaries
+		if s.x < 0 {
+			s.x = float64(m.width)
+		} else if s.x > float64(m.width) {
+			s.x = 0
+		}
+		if s.y < 0 {
+			s.y = float64(m.height / 3)
+		} else if s.y > float64(m.height/3) {
+			s.y = 0
+		}
+	}
+	
+	// Emit and update particles
+	if m.layers.Visible("fog") {
+		m.emitParticles()
+		
+		m.particles.Update(dt)
+		// Keep alive particles within bounds
+		m.particles.Retain(func(p *particles.Particle) bool {
+			return p.X >= 0 && p.X < float64(m.width) && p.Y >= 0 && p.Y < float64(m.height)
+		})
+	}
+}
+
+// KeyMap implements engine.KeyMapper. The title input has keys of its
+// own.
+func (m model) KeyMap() keymap.Map {
+	if m.typing != nil {
+		return keymap.Of(titleKeys)
+	}
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color(m.modes[m.mode].skyGrad[0])).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🌆 " + m.modes[m.mode].name)
+	if m.player != nil {
+		title += lipgloss.NewStyle().Faint(true).Render("  ♪ " + m.song)
+	}
+
+	// Status with more information, or what the last export said
+	state := map[bool]string{true: "⏸ PAUSED", false: "▶ FLOWING"}[m.anim.Paused()]
+	if m.notice != "" {
+		state = m.notice
+	}
+	statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.modes[m.mode].fogColor))
+	status := statusStyle.Render(fmt.Sprintf(
+		"Speed: %.1f | Grid: %.1f | Shapes: %s | Fog: %s | Pulse: %s | %s",
+		m.anim.Speed(), m.gridIntensity,
+		map[bool]string{true: "ON", false: "OFF"}[m.layers.Visible("shapes")],
+		map[bool]string{true: "ON", false: "OFF"}[m.layers.Visible("fog")],
+		map[bool]string{true: "ON", false: "OFF"}[m.sunPulse],
+		state,
+	) + m.sceneryStatus() + m.dayStatus() + m.driveStatus() + m.overlayStatus())
+
+	// Check minimum size requirements
+	if m.width < minWidth || m.height+4 < minHeight {
+		errorStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF0000")).
+			Bold(true)
+		
+		sizeError := errorStyle.Render(fmt.Sprintf(
+			"Terminal too small!\nMinimum size: %dx%d\nCurrent size: %dx%d\n\nPlease resize your terminal window.",
+			minWidth, minHeight, m.width, m.height+4,
+		))
+		
+		helpStyle := lipgloss.NewStyle().Faint(true)
+		help := helpStyle.Render(keymap.Short(keys.Quit))
+
+		return lipgloss.JoinVertical(lipgloss.Left, title, status, "", sizeError, help)
+	}
+
+	// Draw the scene layers, restyling only the rows that changed
+	scene := m.layers.Render(m.grid, &m)
+
+	// Enhanced help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	gap := ""
+	if m.typing != nil {
+		status = m.titleHint()
+		gap = m.typing.View()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, status, gap, scene, help)
+}
+
+// Render sky gradient with enhanced atmospheric effects
+func (m *model) renderSky(c *canvas.Canvas) {
+	skyHeight := m.horizon()
+	if skyHeight < 1 {
+		skyHeight = 1
+	}
+	
+	for y := 0; y < skyHeight; y++ {
+		intensity := float64(y) / float64(skyHeight)
+		
+		for x := 0; x < m.width; x++ {
+			// Drifting fBm clouds for atmospheric texture
+			totalNoise := noise.FBM3(noise.Simplex3,
+				float64(x)*0.04+m.time*0.15, float64(y)*0.12, m.time*0.05,
+				3, 2.0, 0.5) * 0.25
+			adjustedIntensity := common.Clamp(intensity + totalNoise, 0, 1)
+			
+			// Create atmospheric layers
+			char := m.getEnhancedGradientChar(adjustedIntensity, x, y)
+			color := m.skyTint(m.getSkyColor(adjustedIntensity), float64(y)/float64(skyHeight))
+			
+			// Add subtle atmospheric effects
+			if adjustedIntensity > 0.7 && math.Sin(float64(x)*0.2 + m.time*2) > 0.8 {
+				// Wispy cloud effects
+				char = "░"
+			} else if y < skyHeight/4 && math.Sin(float64(x)*0.3 + m.time*0.8) > 0.9 {
+				// High altitude shimmer
+				char = "·"
+			}
+			
+			setChar(c, x, y, char, color)
+		}
+	}
+}
+
+// Render sun with enhanced dramatic effects
+func (m *model) renderSun(c *canvas.Canvas) {
+	sunCenterX := m.width / 2
+	baseRadius := 5.0
+	
+	// Enhanced pulsing effect, following the music when there is some
+	sunRadius := baseRadius * m.sunScale()
+	sunCenterY := m.height/4 + m.bob() + m.sunDrop(sunRadius)
+
+	// While the day runs the sun sets behind the horizon
+	bottom := m.height / 2
+	if m.day > 0 {
+		bottom = m.horizon()
+	}
+	for y := 0; y < bottom; y++ {
+		for x := 0; x < m.width; x++ {
+			dx := float64(x - sunCenterX)
+			dy := float64(y - sunCenterY) * 1.6 // Character aspect ratio adjustment
+			distance := math.Sqrt(dx*dx + dy*dy)
+			angle := math.Atan2(dy, dx)
+			
+			if distance < sunRadius-2 {
+				// Sun core with varying intensity
+				coreIntensity := 1.0 - (distance/(sunRadius-2))*0.3
+				coreChar := "●"
+				if distance < sunRadius-3 {
+					coreChar = "◉"
+				}
+				setChar(c, x, y, coreChar, m.getSunColor(coreIntensity))
+			} else if distance < sunRadius {
+				// Sun edge with animated glow
+				edgeIntensity := 0.6 + math.Sin(m.time*3 + distance)*0.3
+				glowChar := "◎"
+				if math.Sin(m.time*2 + distance) > 0.5 {
+					glowChar = "○"
+				}
+				setChar(c, x, y, glowChar, m.getSunColor(edgeIntensity))
+			} else if distance < sunRadius+3 {
+				// Enhanced ray system
+				rayIntensity := (sunRadius + 3 - distance) / 3
+				rayPattern := int((angle + math.Pi) * 16 / (2 * math.Pi)) // More rays
+				timeOffset := m.time*3 + float64(rayPattern)*0.5
+				
+				if math.Sin(timeOffset) > 0.3 {
+					rayChar := "─"
+					if rayPattern%2 == 0 {
+						rayChar = "━"
+					}
+					if rayPattern%4 == 0 {
+						rayChar = "═"
+					}
+					intensity := rayIntensity * (0.5 + math.Sin(timeOffset)*0.5)
+					setChar(c, x, y, rayChar, m.getSunColor(intensity))
+				}
+			} else if distance < sunRadius+6 {
+				// Extended glow with scan lines for retro effect
+				glowIntensity := (sunRadius + 6 - distance) / 6 * 0.3
+				if engine.ReducedMotion() || y%2 == int(m.time*10)%2 { // Moving scan lines
+					setChar(c, x, y, "▒", m.getSunColor(glowIntensity))
+				}
+			}
+		}
+	}
+}
+
+// Render perspective grid with enhanced dramatic effects
+func (m *model) renderPerspectiveGrid(c *canvas.Canvas) {
+	if m.gear > 0 {
+		m.renderRoad(c)
+		return
+	}
+	gridStart := m.height / 3
+	
+	for y := gridStart; y < m.height; y++ {
+		depth := float64(y - gridStart + 1)
+		if depth <= 0 {
+			depth = 1
+		}
+		
+		// Enhanced perspective with dramatic scaling
+		scale := 25.0 / (depth * 1.2)
+		offset := m.time * m.anim.Speed() * scale * 1.5
+		
+		// Add horizontal scan line effect
+		scanLineIntensity := 0.0
+		if !engine.ReducedMotion() {
+			scanLineIntensity = math.Sin(float64(y)*0.5 + m.time*8) * 0.1
+		}
+		
+		for x := 0; x < m.width; x++ {
+			gridX := (float64(x) - float64(m.width)/2) / scale
+			gridZ := depth + offset
+			
+			// Enhanced multi-layered wave effects
+			wave1 := math.Sin(gridX*0.4 + gridZ*0.25 + m.time*1.2) * 3.0 / depth
+			wave2 := math.Sin(gridX*0.2 + gridZ*0.15 + m.time*0.8) * 1.5 / depth
+			wave3 := math.Sin(gridX*0.1 + gridZ*0.05 + m.time*2.0) * 0.8 / depth
+			waveOffset := wave1 + wave2 + wave3
+			
+			// Enhanced grid line detection
+			gridSpacing := 1.8
+			lineThickness := (0.12/scale) * m.gridIntensity
+			
+			isGridLineX := math.Abs(math.Mod(gridX+0.5, gridSpacing)-gridSpacing/2) < lineThickness
+			isGridLineZ := math.Abs(math.Mod(gridZ+0.5, gridSpacing)-gridSpacing/2) < lineThickness
+			
+			if isGridLineX || isGridLineZ {
+				// Distance-based intensity with enhanced falloff
+				intensity := (1.0 / (depth*0.08 + 1)) * m.gridIntensity * m.gridPulse()
+				
+				// Major grid line emphasis (every 4th line)
+				majorLineX := math.Abs(math.Mod(gridX+0.5, gridSpacing*4)-gridSpacing*2) < lineThickness*2
+				majorLineZ := math.Abs(math.Mod(gridZ+0.5, gridSpacing*4)-gridSpacing*2) < lineThickness*2
+				
+				if majorLineX || majorLineZ {
+					intensity *= 2.0
+				}
+				
+				// Grid intersection with extra emphasis
+				if isGridLineX && isGridLineZ {
+					intensity *= 1.6
+					if majorLineX && majorLineZ {
+						intensity *= 1.4 // Super bright intersections
+					}
+				}
+				
+				// Enhanced glow and wave effects
+				glowIntensity := intensity + math.Sin(waveOffset+m.time*2.5)*0.5 + scanLineIntensity
+				glowIntensity = common.Clamp(glowIntensity, 0, 1.5)
+				
+				// More varied characters based on intensity and position
+				char := m.getEnhancedGridChar(isGridLineX, isGridLineZ, majorLineX, majorLineZ, glowIntensity)
+				setChar(c, x, y, char, m.getGridColor(glowIntensity))
+			} else if !engine.ReducedMotion() && math.Sin(float64(y)*0.3 + m.time*5) > 0.95 {
+				// Occasional scan line artifacts for retro CRT effect
+				setChar(c, x, y, "▁", m.getGridColor(0.2))
+			}
+		}
+	}
+}
+
+// Render floating shapes with physics
+func (m *model) renderFloatingShapes(c *canvas.Canvas) {
+	for _, shape := range m.shapes {
+		x, y := int(shape.x), int(shape.y)
+		if x >= 0 && x < m.width && y >= 0 && y < m.height {
+			// Apply rotation effect through character variation
+			rotatedShape := shape.shape
+			if int(shape.rotation*4) % 2 == 1 {
+				// Simple rotation simulation
+				switch shape.shape {
+				case "◆": rotatedShape = "◇"
+				case "▲": rotatedShape = "▼"
+				case "■": rotatedShape = "▪"
+				}
+			}
+			
+			// Age-based transparency effect
+			alpha := 0.7 + math.Sin(shape.age*2)*0.3
+			color := shape.color
+			if alpha < 0.5 {
+				color = lipgloss.Color("#666666") // Fade effect
+			}
+			
+			setChar(c, x, y, rotatedShape, color)
+		}
+	}
+}
+
+// Render atmospheric particles
+func (m *model) renderParticles(c *canvas.Canvas) {
+	for _, particle := range m.particles.Particles() {
+		x, y := int(particle.X), int(particle.Y)
+		if x >= 0 && x < m.width && y >= 0 && y < m.height {
+			// Life-based alpha blending, as a blink unless motion is reduced
+			if particle.Life > 0.5 || engine.ReducedMotion() || int(m.anim.Frame()*3) % 2 == 0 {
+				setChar(c, x, y, string(particle.Rune), particle.Color)
+			}
+		}
+	}
+}
+
+// Helper functions for color and character selection
+func (m model) getSkyColor(intensity float64) lipgloss.Color {
+	return shade(m.modes[m.mode].skyGrad, intensity)
+}
+
+func (m model) getSunColor(intensity float64) lipgloss.Color {
+	return m.sunTint(shade(m.modes[m.mode].sunColor, intensity))
+}
+
+func (m model) getGridColor(intensity float64) lipgloss.Color {
+	return shade(m.modes[m.mode].gridGrad, intensity)
+}
+
+// shade picks a color from a gradient by intensity. The stepped look
+// flickers as intensities cross a step, so with reduced motion the colors
+// are blended instead.
+func shade(colors []string, intensity float64) lipgloss.Color {
+	if engine.ReducedMotion() {
+		return common.Sample(colors, intensity)
+	}
+	index := common.Clamp(intensity * float64(len(colors)-1), 0, float64(len(colors)-1))
+	return lipgloss.Color(colors[int(index)])
+}
+
+// beatFlash is how much the music brightens the scene, which reduced
+// motion turns off.
+func (m model) beatFlash() float64 {
+	if engine.ReducedMotion() {
+		return 0
+	}
+	return m.flash
+}
+
+func (m model) getGradientChar(intensity float64) string {
+	chars := []string{"▓", "▒", "░", " "}
+	index := int(intensity * float64(len(chars)-1))
+	if index >= len(chars) {
+		index = len(chars) - 1
+	}
+	return chars[index]
+}
+
+func (m model) getEnhancedGradientChar(intensity float64, x, y int) string {
+	// More varied atmospheric characters
+	if intensity < 0.1 {
+		return "█"
+	} else if intensity < 0.25 {
+		return "▓"
+	} else if intensity < 0.45 {
+		return "▒"
+	} else if intensity < 0.65 {
+		return "░"
+	} else if intensity < 0.85 {
+		// Add some variation for atmospheric effect
+		if (x+y)%3 == 0 {
+			return "·"
+		}
+		return " "
+	} else {
+		return " "
+	}
+}
+
+func (m model) getGridChar(isLineX, isLineZ bool, intensity float64) string {
+	if isLineX && isLineZ {
+		return "+"
+	} else if isLineX {
+		return "|"
+	} else if isLineZ {
+		return "-"
+	}
+	
+	// Intensity-based characters
+	chars := []string{"░", "▒", "▓", "█"}
+	index := int(intensity * float64(len(chars)-1))
+	if index >= len(chars) {
+		index = len(chars) - 1
+	}
+	return chars[index]
+}
+
+func (m model) getEnhancedGridChar(isLineX, isLineZ, majorLineX, majorLineZ bool, intensity float64) string {
+	if isLineX && isLineZ {
+		if majorLineX && majorLineZ {
+			return "╬" // Major intersection
+		}
+		if majorLineX || majorLineZ {
+			return "┼" // Semi-major intersection
+		}
+		return "+" // Regular intersection
+	} else if isLineX {
+		if majorLineX {
+			return "┃" // Major vertical
+		}
+		return "|" // Regular vertical
+	} else if isLineZ {
+		if majorLineZ {
+			return "━" // Major horizontal  
+		}
+		return "─" // Regular horizontal
+	}
+	
+	// Enhanced intensity-based characters
+	if intensity > 1.2 {
+		return "█"
+	} else if intensity > 0.9 {
+		return "▓"
+	} else if intensity > 0.6 {
+		return "▒"
+	} else if intensity > 0.3 {
+		return "░"
+	} else {
+		return "·"
+	}
+}
+
+// setChar draws a single character onto the canvas.
+func setChar(c *canvas.Canvas, x, y int, char string, color lipgloss.Color) {
+	c.SetString(x, y, char, canvas.Style{Fg: color})
+}
@@ -0,0 +1,158 @@
+package waveanimation
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+)
+
+// A boat, a duck and a buoy can ride the sea. Each sits on the water line
+// under its middle, so it rises and falls with the waves, leans with the
+// slope of the water beneath it, and slides down that slope as it drifts,
+// turning back when it reaches an end of the sea. Out of sight, off the
+// window, it just drifts.
+
+// floatKind is a kind of thing that floats: its picture, bottom row on the
+// water, facing right, and the colors of its characters.
+type floatKind struct {
+	art    []string
+	colors map[rune]lipgloss.Color
+}
+
+// white is the white of sails and buoy stripes.
+const white = lipgloss.Color("#F5F5F5")
+
+var (
+	boat = floatKind{
+		art: []string{
+			"  ▕◣ ",
+			"▜▄▄▄▛",
+		},
+		colors: map[rune]lipgloss.Color{'▕': white, '◣': white, '▜': "#8B5A2B", '▄': "#8B5A2B", '▛': "#8B5A2B"},
+	}
+	duck = floatKind{
+		art: []string{
+			"  ●▶",
+			"◥██◤",
+		},
+		colors: map[rune]lipgloss.Color{'●': common.Yellow, '▶': common.Orange, '◥': common.Yellow, '█': common.Yellow, '◤': common.Yellow},
+	}
+	buoy = floatKind{
+		art: []string{
+			" ● ",
+			"▐█▌",
+		},
+		colors: map[rune]lipgloss.Color{'●': common.Red, '▐': white, '█': common.Red, '▌': white},
+	}
+)
+
+// mirrored are the characters that change when a picture is turned to face
+// left.
+var mirrored = map[rune]rune{
+	'▶': '◀', '◥': '◤', '◤': '◥', '◣': '◢', '▕': '▏',
+	'▜': '▛', '▛': '▜', '▐': '▌', '▌': '▐',
+}
+
+const (
+	// slide is how hard the slope of the water pushes a floater down it.
+	slide = 0.04
+	// settle is how much of its difference from its drift a floater's
+	// speed keeps each frame.
+	settle = 0.95
+	// maxLean is the most rows a floater's ends rise or fall by.
+	maxLean = 1
+)
+
+// floater is something floating: where its left edge is along the sea, how
+// fast it is moving, and how fast it drifts when the water is flat.
+type floater struct {
+	kind  *floatKind
+	x, vx float64
+	drift float64
+}
+
+// width is how many columns the floater takes.
+func (f floater) width() int {
+	return len([]rune(f.kind.art[len(f.kind.art)-1]))
+}
+
+func newFloaters() []floater {
+	return []floater{
+		{kind: &boat, x: 10, drift: 0.3, vx: 0.3},
+		{kind: &duck, x: 40, drift: -0.2, vx: -0.2},
+		{kind: &buoy, x: 62, drift: 0.05, vx: 0.05},
+	}
+}
+
+// waterTop is the row, with its fraction, of the top of the solid surface
+// at column x, on a screen of rows rows.
+func (m model) waterTop(x, rows int) float64 {
+	x = min(max(x, 0), m.width-1)
+	return (0.5 - m.level(x)/2 - surfaceBand) * float64(rows-1)
+}
+
+// slopeAt is how many rows the water falls from column x to the next.
+func (m model) slopeAt(x, rows int) float64 {
+	return (m.waterTop(x+1, rows) - m.waterTop(x-1, rows)) / 2
+}
+
+// moveFloaters drifts the floaters on for a frame, sliding them down the
+// water and turning them back at the ends of the sea.
+func (m *model) moveFloaters() {
+	dt := m.anim.Delta()
+	rows := m.grid.Height()
+	for i := range m.floaters {
+		f := &m.floaters[i]
+		if middle := int(f.x-m.pan) + f.width()/2; middle >= 0 && middle < m.width {
+			f.vx += m.slopeAt(middle, rows) * slide * dt
+		}
+		f.vx = f.drift + (f.vx-f.drift)*math.Pow(settle, dt)
+		f.x += f.vx * dt
+		right := float64(m.seaWidth() - f.width())
+		switch {
+		case f.x < 0:
+			f.x, f.vx, f.drift = 0, math.Abs(f.vx), math.Abs(f.drift)
+		case f.x > right:
+			f.x, f.vx, f.drift = math.Max(right, 0), -math.Abs(f.vx), -math.Abs(f.drift)
+		}
+	}
+}
+
+// drawFloaters draws each floater on the water under its middle, facing the
+// way it drifts and leaning with the slope.
+func (m model) drawFloaters(c *canvas.Canvas) {
+	rows := c.Height()
+	for _, f := range m.floaters {
+		left, width := int(math.Floor(f.x-m.pan)), f.width()
+		if left+width <= 0 || left >= m.width {
+			continue
+		}
+		middle := left + width/2
+		base := int(math.Ceil(m.waterTop(middle, rows)))
+		slope := m.slopeAt(middle, rows)
+		for r, line := range f.kind.art {
+			art := []rune(line)
+			for col := range art {
+				orig := art[col]
+				if f.drift < 0 {
+					orig = art[len(art)-1-col]
+				}
+				if orig == ' ' {
+					continue
+				}
+				ch := orig
+				if mc, ok := mirrored[ch]; ok && f.drift < 0 {
+					ch = mc
+				}
+				lean := math.Round(slope * (float64(col) - float64(width-1)/2))
+				lean = common.Clamp(lean, -maxLean, maxLean)
+				y := base - (len(f.kind.art) - 1 - r) + int(lean)
+				if c.InBounds(left+col, y) {
+					c.Set(left+col, y, ch, canvas.Style{Fg: f.kind.colors[orig]})
+				}
+			}
+		}
+	}
+}
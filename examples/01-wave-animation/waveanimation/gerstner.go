@@ -0,0 +1,102 @@
+package waveanimation
+
+import "math"
+
+// Besides plain sines, the sea can be drawn with Gerstner waves, the
+// trochoids real water makes: each bit of the surface goes round a circle
+// rather than just up and down, so it bunches up under the crests, which
+// come to points, and spreads out along the troughs, which flatten. The
+// wind sets how steep the waves are and how tall.
+
+const (
+	// windStep is how much each press of the wind keys changes it.
+	windStep = 0.1
+	// maxSteep is how near to a cusp the crests come in the strongest wind.
+	maxSteep = 0.9
+	// gerstnerSamples is how many points of the surface are followed for
+	// each column.
+	gerstnerSamples = 4
+	// markSpeed is how many columns a second the marks in the water run
+	// along with the sines.
+	markSpeed = 10
+)
+
+// lift is how much the wind scales the waves' heights: not at all in the
+// middling wind the seas are made for.
+func (m model) lift() float64 {
+	return 0.6 + 0.8*m.wind
+}
+
+// sea is the height of the sea at each column of the window, 0.5 when it
+// is flat, and where each column's bit of water belongs, which the marks in
+// the water follow.
+func (m model) sea() (heights, marks []float64) {
+	if m.gerstner {
+		return m.gerstnerSea()
+	}
+	heights = make([]float64, m.width)
+	marks = make([]float64, m.width)
+	lift := m.lift()
+	for x := range heights {
+		normalizedX := (float64(x) + m.pan) / float64(max(m.width-1, 1))
+		heights[x] = 0.5
+		for _, w := range m.waves {
+			heights[x] += w.at(normalizedX, m.time) * lift
+		}
+		marks[x] = float64(x) + m.pan + m.time*markSpeed
+	}
+	return heights, marks
+}
+
+// gerstnerSea follows points along the surface from a little off either
+// edge, moving each one round its circle for every wave, and reads the
+// height at each column off the line through them. A pulse only lifts the
+// water.
+func (m model) gerstnerSea() (heights, marks []float64) {
+	heights = make([]float64, m.width)
+	marks = make([]float64, m.width)
+	span := float64(max(m.width-1, 1))
+	lift := m.lift()
+	steep := maxSteep * m.wind / float64(max(len(m.waves), 1))
+	left := m.pan / span
+
+	// The wave positions of the points, from a quarter of a screen either
+	// side of the window, and where they have moved to
+	n := int(1.5*span*gerstnerSamples) + 1
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range xs {
+		a := left - 0.25 + 1.5*float64(i)/float64(n-1)
+		x, y := a, 0.5
+		for _, w := range m.waves {
+			if w.width > 0 {
+				y += w.at(a, m.time) * lift
+				continue
+			}
+			theta := 2*math.Pi*(w.frequency*a+w.speed*m.time) + w.phase
+			k := 2 * math.Pi * w.frequency
+			y += w.amplitude * lift * math.Sin(theta)
+			if k > 0 {
+				x += steep / k * math.Cos(theta)
+			}
+		}
+		xs[i], ys[i] = x, y
+	}
+
+	// Steepness below a cusp keeps the points in order across the screen
+	i := 0
+	for col := range heights {
+		x := left + float64(col)/span
+		for i < n-2 && xs[i+1] < x {
+			i++
+		}
+		t := 0.0
+		if d := xs[i+1] - xs[i]; d > 0 {
+			t = math.Max(0, math.Min(1, (x-xs[i])/d))
+		}
+		heights[col] = ys[i] + (ys[i+1]-ys[i])*t
+		a := left - 0.25 + 1.5*(float64(i)+t)/float64(n-1)
+		marks[col] = (a + 0.25) * span
+	}
+	return heights, marks
+}
@@ -0,0 +1,110 @@
+package waveanimation
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// The number keys set the sea: four named scenes, and a fifth that makes up
+// a new one each time it is pressed.
+
+// preset is a sea: its waves, the colors of its surface from trough to
+// crest, and the color of the water below.
+type preset struct {
+	name    string
+	waves   []wave
+	surface []string
+	water   lipgloss.Color
+}
+
+// maxWaves is the most waves a sea can have.
+const maxWaves = 5
+
+var presets = []preset{
+	{
+		name: "Calm Lake",
+		waves: []wave{
+			{amplitude: 0.04, frequency: 1.5, phase: 0, speed: 0.04, color: common.Cyan},
+			{amplitude: 0.02, frequency: 3.2, phase: math.Pi / 4, speed: 0.07, color: common.Blue},
+		},
+		surface: []string{"#2E8B8B", "#48B8B0", "#7FDBD4", "#C8F5F0"},
+		water:   lipgloss.Color("#1F6F6F"),
+	},
+	{
+		name: "Choppy Sea",
+		waves: []wave{
+			{amplitude: 0.12, frequency: 2.0, phase: 0, speed: 0.12, color: common.Blue},
+			{amplitude: 0.08, frequency: 4.5, phase: math.Pi / 3, speed: -0.18, color: common.Cyan},
+			{amplitude: 0.05, frequency: 7.0, phase: math.Pi / 2, speed: 0.25, color: common.Purple},
+		},
+		surface: common.GradientBlue,
+		water:   common.Blue,
+	},
+	{
+		name: "Storm",
+		waves: []wave{
+			{amplitude: 0.3, frequency: 0.8, phase: 0, speed: 0.15, color: common.Blue},
+			{amplitude: 0.15, frequency: 2.3, phase: math.Pi / 5, speed: 0.22, color: common.Cyan},
+			{amplitude: 0.08, frequency: 5.5, phase: math.Pi / 2, speed: -0.3, color: common.Purple},
+			{amplitude: 0.05, frequency: 11, phase: math.Pi, speed: 0.45, color: common.Blue},
+		},
+		surface: []string{"#2B3440", "#4A5866", "#7D8B99", "#E0E6EC"},
+		water:   lipgloss.Color("#3A4654"),
+	},
+	{
+		name: "Tsunami Pulse",
+		waves: []wave{
+			{amplitude: 0.03, frequency: 2.5, phase: 0, speed: 0.05, color: common.Cyan},
+			{amplitude: 0.55, width: 0.08, phase: 0, speed: 0.06, color: common.Blue},
+		},
+		surface: common.GradientBlue,
+		water:   common.Blue,
+	},
+}
+
+// randomSea makes up a sea whose waves belong together: one roughness sets
+// how tall the longest wave is and how many shorter ones ride on it, each
+// shorter wave is smaller in proportion, and each moves at the speed deep
+// water gives a wave of its length, the square root of its frequency.
+func randomSea() preset {
+	rough := rng.Float64()
+	base := 0.5 + rng.Float64()*1.5
+	p := preset{
+		name:    "Random Sea",
+		surface: common.GradientBlue,
+		water:   common.Blue,
+	}
+	if rough > 0.7 {
+		p.surface, p.water = presets[2].surface, presets[2].water
+	}
+	amplitude := 0.04 + rough*0.26
+	frequency := base
+	for i := 0; i < 2+int(rough*3); i++ {
+		speed := 0.06 * math.Sqrt(frequency)
+		if rng.Float64() < 0.25 {
+			speed = -speed
+		}
+		p.waves = append(p.waves, wave{
+			amplitude: amplitude,
+			frequency: frequency,
+			phase:     rng.Float64() * 2 * math.Pi,
+			speed:     speed,
+			color:     lipgloss.Color(common.GradientBlue[rng.Intn(len(common.GradientBlue))]),
+		})
+		amplitude *= 0.4 + rng.Float64()*0.2
+		frequency *= 1.8 + rng.Float64()*0.8
+	}
+	return p
+}
+
+// usePreset sets the sea to p, starting its clock over.
+func (m *model) usePreset(p preset) {
+	m.scene = p.name
+	m.waves = append([]wave(nil), p.waves...)
+	m.surface, m.water = p.surface, p.water
+	m.time = 0
+	m.anim.Reset()
+}
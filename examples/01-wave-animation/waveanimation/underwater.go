@@ -0,0 +1,118 @@
+package waveanimation
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// Below the surface the water darkens with depth, and now and then a fish
+// swims across from one side to the other while bubbles rise from the
+// bottom, swaying, and burst at the surface. Fish and bubbles are particles
+// of the shared engine; a fish is drawn three characters long, facing the
+// way it swims.
+
+// abyss is the color the water darkens towards at the bottom of the screen.
+var abyss = common.RGB{R: 2, G: 8, B: 26}
+
+const (
+	// shallowDark and deepDark are how far the water is darkened towards
+	// the abyss just under the surface and at the bottom.
+	shallowDark = 0.35
+	deepDark    = 0.85
+
+	// fishRune marks a particle as a fish rather than a bubble.
+	fishRune = '>'
+	// fishRate and bubbleRate are how many fish and bubbles set off each
+	// frame.
+	fishRate   = 0.005
+	bubbleRate = 0.05
+)
+
+var (
+	fishColors   = []lipgloss.Color{common.Orange, common.Yellow, "#FF6F91", "#9BE15D"}
+	bubbleColors = []lipgloss.Color{"#CFEFFF", "#9FD8F0"}
+	bubbleRunes  = []rune{'°', '∘', 'o'}
+)
+
+// depthColor is the water's color at depth, from 0 just under the surface
+// to 1 at the bottom.
+func depthColor(water common.RGB, depth float64) lipgloss.Color {
+	return common.LerpRGB(water, abyss, common.Lerp(shallowDark, deepDark, depth)).Color()
+}
+
+// newSwimmers returns an empty system for the fish and bubbles.
+func newSwimmers() *particles.System {
+	return particles.New(40)
+}
+
+// moveSwimmers sets fish and bubbles off, moves them on for a frame, keeps
+// fish under the water and bursts bubbles that reach it.
+func (m *model) moveSwimmers() {
+	dt := m.anim.Delta()
+	rows := float64(m.grid.Height())
+	width := float64(m.width)
+
+	side, heading := -3.0, 1.0
+	if rng.Float64() < 0.5 {
+		side, heading = width+2, -1
+	}
+	fish := particles.Emitter{
+		X: side, Y: rows * 0.8, SpreadY: rows * 0.3,
+		VX: heading * 0.15, JitterVX: 0.1,
+		Runes: []rune{fishRune}, Colors: fishColors,
+		Rate: fishRate,
+	}
+	fish.Update(m.swimmers, dt)
+	bubbles := particles.Emitter{
+		X: width / 2, SpreadX: width, Y: rows - 1,
+		VY: -0.12, JitterVY: 0.06,
+		Size: 1, JitterSize: 2,
+		Runes: bubbleRunes, Colors: bubbleColors,
+		Rate: bubbleRate,
+	}
+	bubbles.Update(m.swimmers, dt)
+
+	m.swimmers.Update(dt)
+	heights, _ := m.sea()
+	// below is the first row under the solid surface at column x
+	below := func(x float64) float64 {
+		h := heights[min(max(int(x), 0), m.width-1)]
+		return (0.5-h/2+surfaceBand)*(rows-1) + 1
+	}
+	m.swimmers.Retain(func(p *particles.Particle) bool {
+		if p.Rune != fishRune {
+			return p.Y >= below(p.X) && p.X >= 0 && p.X < width
+		}
+		p.Y = math.Min(math.Max(p.Y, below(p.X+1)), rows-1)
+		return p.X > -4 && p.X < width+4
+	})
+}
+
+// drawSwimmers draws the fish and bubbles, the bubbles swaying from side to
+// side as they rise.
+func (m model) drawSwimmers(c *canvas.Canvas) {
+	for _, p := range m.swimmers.Particles() {
+		if p.Rune != fishRune {
+			sway := int(math.Round(math.Sin(m.time*6 + p.Size*3)))
+			if x := int(p.X) + sway; c.InBounds(x, int(p.Y)) {
+				c.Set(x, int(p.Y), p.Rune, canvas.Style{Fg: p.Color})
+			}
+			continue
+		}
+		body := "><>"
+		if p.VX < 0 {
+			body = "<><"
+		}
+		for i, r := range body {
+			x := int(math.Floor(p.X)) + i
+			if c.InBounds(x, int(p.Y)) {
+				c.Set(x, int(p.Y), r, canvas.Style{Fg: p.Color})
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package waveanimation
+
+import (
+	"math"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+)
+
+// The sea is wider than the screen, which is a window onto it: the arrow
+// keys pan the window along, or it can drift on its own from one end of the
+// sea to the other and back. The waves, the boats and the fish belong to
+// the sea, so they stay where they are as the window moves over them.
+
+const (
+	// seaScreens is how many screens wide the sea is.
+	seaScreens = 4
+	// panSteps is how many presses of an arrow key pan a screen.
+	panSteps = 8
+	// driftSpeed is how many columns a frame the window drifts.
+	driftSpeed = 0.4
+	// panBar is how many characters wide the window's place is shown.
+	panBar = 12
+)
+
+// seaWidth is how many columns wide the sea is.
+func (m model) seaWidth() int {
+	return seaScreens * m.width
+}
+
+// maxPan is the furthest the window's left edge can be from the sea's.
+func (m model) maxPan() float64 {
+	return float64(m.seaWidth() - m.width)
+}
+
+// panBy moves the window d columns along the sea, as far as its ends, and
+// the fish and bubbles back by as much, as they are in the sea.
+func (m *model) panBy(d float64) {
+	pan := math.Min(math.Max(m.pan+d, 0), m.maxPan())
+	moved := pan - m.pan
+	m.pan = pan
+	m.swimmers.Retain(func(p *particles.Particle) bool {
+		p.X -= moved
+		return true
+	})
+}
+
+// drift moves the window on for a frame, turning back at the ends of the
+// sea.
+func (m *model) drift() {
+	if m.driftDir == 0 {
+		m.driftDir = 1
+	}
+	m.panBy(m.driftDir * driftSpeed * m.anim.Delta())
+	if (m.driftDir > 0 && m.pan >= m.maxPan()) || (m.driftDir < 0 && m.pan <= 0) {
+		m.driftDir = -m.driftDir
+	}
+}
+
+// panStatus shows where the window is along the sea.
+func (m model) panStatus() string {
+	cells := []rune(strings.Repeat("─", panBar))
+	at := 0
+	if m.maxPan() > 0 {
+		at = int(math.Round(m.pan / m.maxPan() * float64(panBar-1)))
+	}
+	cells[at] = '█'
+	return "◀" + string(cells) + "▶"
+}
@@ -0,0 +1,310 @@
+// Package waveanimation is smooth sine wave animations in several layers.
+package waveanimation
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	width      int
+	height     int
+	time       float64
+	waves      []wave
+	showHelp   bool
+	anim       engine.Animator
+	grid       *canvas.Canvas
+
+	// The sea's name, if it is a preset, and its colors
+	scene   string
+	surface []string
+	water   lipgloss.Color
+
+	// Boats and the like riding the waves, when shown
+	floaters []floater
+	floating bool
+
+	// Fish and bubbles under the surface, when shown
+	swimmers *particles.System
+	swimming bool
+
+	// Gerstner waves in place of sines, and the wind, from 0 to 1
+	gerstner bool
+	wind     float64
+
+	// Where the window's left edge is along the sea, in columns, and
+	// whether and which way it drifts on its own
+	pan      float64
+	drifting bool
+	driftDir float64
+}
+
+type wave struct {
+	amplitude  float64
+	frequency  float64
+	phase      float64
+	speed      float64
+	color      lipgloss.Color
+	// width, when set, makes the wave a single pulse that wide, as a
+	// fraction of the screen, in place of a sine
+	width float64
+}
+
+// surfaceBand is how far above and below the water line, as a fraction of
+// the screen's height, the surface is drawn solid.
+const surfaceBand = 0.05
+
+// level is the height of the sea at column x, 0.5 when it is flat.
+func (m model) level(x int) float64 {
+	heights, _ := m.sea()
+	return heights[x]
+}
+
+// at is the wave's height at x, in screens from the left of the sea, at
+// time t. A pulse runs the length of the sea from just off one end to just
+// off the other, and comes round again.
+func (w wave) at(x, t float64) float64 {
+	if w.width > 0 {
+		pos := math.Mod(w.speed*t/seaScreens+w.phase/(2*math.Pi), 1)*(seaScreens+6*w.width) - 3*w.width
+		d := (x - pos) / w.width
+		return w.amplitude * math.Exp(-d*d)
+	}
+	return w.amplitude * math.Sin(2*math.Pi*(w.frequency*x+w.speed*t)+w.phase)
+}
+
+type keyMap struct {
+	Scene      key.Binding
+	Floaters   key.Binding
+	Fish       key.Binding
+	PanLeft    key.Binding
+	PanRight   key.Binding
+	Drift      key.Binding
+	Gerstner   key.Binding
+	Wind       key.Binding
+	WindUp     key.Binding
+	ToggleHelp key.Binding
+	Add        key.Binding
+	Remove     key.Binding
+	Reset      key.Binding
+	Quit       key.Binding
+	Help       key.Binding
+}
+
+var keys = keyMap{
+	Scene:      keymap.New("1-5", "seas", "1", "2", "3", "4", "5"),
+	Floaters:   keymap.New("o", "boats"),
+	Fish:       keymap.New("f", "fish"),
+	PanLeft:    keymap.New("←→", "pan", "left"),
+	PanRight:   keymap.Hidden("right"),
+	Drift:      keymap.New("a", "drift"),
+	Gerstner:   keymap.New("g", "gerstner"),
+	Wind:       keymap.New("w/W", "wind", "w"),
+	WindUp:     keymap.Hidden("W"),
+	ToggleHelp: keymap.New("h", "hide help"),
+	Add:        keymap.New("space", "add wave", " "),
+	Remove:     keymap.New("backspace", "remove"),
+	Reset:      keymap.Reset(),
+	Quit:       keymap.Quit(),
+	Help:       keymap.Help(),
+}
+
+func initialModel() model {
+	return model{
+		width:    80,
+		height:   24,
+		time:     0,
+		showHelp: true,
+		anim:     engine.New(engine.SharedFPS),
+		surface:  common.GradientBlue,
+		water:    common.Blue,
+		grid:     canvas.New(80, 20),
+		floaters: newFloaters(),
+		swimmers: newSwimmers(),
+		wind:     0.5,
+		waves: []wave{
+			{amplitude: 0.3, frequency: 0.05, phase: 0, speed: 0.05, color: common.Blue},
+			{amplitude: 0.2, frequency: 0.08, phase: math.Pi/3, speed: 0.08, color: common.Cyan},
+			{amplitude: 0.25, frequency: 0.03, phase: math.Pi/2, speed: 0.03, color: common.Purple},
+		},
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "01-wave-animation",
+		Heading:  "🌊 Wave Animation",
+		Summary:  "Smooth sine wave animations with multiple layers",
+		Section:  registry.Examples,
+		Keywords: []string{"sine", "ocean", "water"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.grid.Resize(m.width, max(m.height-4, 2))
+		m.pan = math.Min(m.pan, m.maxPan())
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.05 * m.anim.Delta()
+			if m.drifting {
+				m.drift()
+			}
+			if m.floating {
+				m.moveFloaters()
+			}
+			if m.swimming {
+				m.moveSwimmers()
+			}
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.ToggleHelp):
+			m.showHelp = !m.showHelp
+		case key.Matches(msg, keys.Reset):
+			m.time = 0
+			m.anim.Reset()
+		case key.Matches(msg, keys.Scene):
+			if i := int(msg.String()[0] - '1'); i < len(presets) {
+				m.usePreset(presets[i])
+			} else {
+				m.usePreset(randomSea())
+			}
+		case key.Matches(msg, keys.Floaters):
+			m.floating = !m.floating
+		case key.Matches(msg, keys.Fish):
+			m.swimming = !m.swimming
+			m.swimmers.Clear()
+		case key.Matches(msg, keys.PanLeft):
+			m.panBy(-float64(m.width) / panSteps)
+		case key.Matches(msg, keys.PanRight):
+			m.panBy(float64(m.width) / panSteps)
+		case key.Matches(msg, keys.Drift):
+			m.drifting = !m.drifting
+		case key.Matches(msg, keys.Gerstner):
+			m.gerstner = !m.gerstner
+		case key.Matches(msg, keys.Wind):
+			m.wind = math.Max(math.Round((m.wind-windStep)*10)/10, 0)
+		case key.Matches(msg, keys.WindUp):
+			m.wind = math.Min(math.Round((m.wind+windStep)*10)/10, 1)
+		case key.Matches(msg, keys.Add):
+			if len(m.waves) < maxWaves {
+				m.waves = append(m.waves, wave{
+					amplitude: 0.1 + math.Mod(m.time, 0.3),
+					frequency: 0.02 + math.Mod(m.time, 0.08),
+					phase:     m.time,
+					speed:     0.02 + math.Mod(m.time, 0.06),
+					color:     lipgloss.Color(common.GradientBlue[int(m.time)%len(common.GradientBlue)]),
+				})
+			}
+		case key.Matches(msg, keys.Remove):
+			if len(m.waves) > 1 {
+				m.waves = m.waves[:len(m.waves)-1]
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	c := m.grid
+	c.Clear()
+	rows := c.Height()
+	heights, marks := m.sea()
+	water := common.ParseHex(string(m.water))
+	for y := 0; y < rows; y++ {
+		normalizedY := float64(y) / float64(rows-1)
+		
+		for x := 0; x < m.width; x++ {
+			height := heights[x]
+			
+			if math.Abs(normalizedY-(0.5-height/2)) < surfaceBand {
+				c.Set(x, y, '█', canvas.Style{Fg: common.Sample(m.surface, (height+1)/2)})
+			} else if normalizedY > (0.5 - height/2) {
+				waterChar := '░'
+				if math.Mod(marks[x], 3) < 1 {
+					waterChar = '▒'
+				}
+				c.Set(x, y, waterChar, canvas.Style{Fg: depthColor(water, normalizedY)})
+			}
+		}
+	}
+	if m.swimming {
+		m.drawSwimmers(c)
+	}
+	if m.floating {
+		m.drawFloaters(c)
+	}
+	
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Blue).
+		Padding(0, 1)
+	
+	title := titleStyle.Render("🌊 Wave Animation")
+	
+	help := ""
+	if m.showHelp {
+		helpStyle := lipgloss.NewStyle().Faint(true)
+		help = helpStyle.Render("\n" + m.KeyMap().String())
+	} else {
+		show := keys.ToggleHelp
+		show.SetHelp("h", "show help")
+		help = "\n" + keymap.Short(show)
+	}
+	
+	countStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := fmt.Sprintf("Waves: %d", len(m.waves))
+	if m.scene != "" {
+		status += " | " + m.scene
+	}
+	if m.gerstner {
+		status += " | Gerstner"
+	}
+	status += fmt.Sprintf(" | Wind: %.0f%%", m.wind*100)
+	status += " | " + m.panStatus()
+	count := countStyle.Render(status)
+	
+	return fmt.Sprintf("%s  %s\n\n%s%s", title, count, c.Render(), help)
+}
@@ -0,0 +1,51 @@
+package particlesystem
+
+import (
+	"math"
+
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+)
+
+// With collisions on, the edges of the screen are walls: a spark that hits
+// one bounces back, losing some of its speed, and one that comes to rest
+// on the floor, the edge gravity pulls towards, slides to a stop there and
+// fades out faster.
+
+const (
+	// restitution is the fraction of its speed a spark keeps bouncing off
+	// a wall.
+	restitution = 0.6
+	// friction is the fraction of its speed along the floor a spark keeps
+	// each time it touches it.
+	friction = 0.8
+	// restSpeed is the speed below which a spark bouncing off the floor
+	// stays on it.
+	restSpeed = 0.3
+	// restDecay is the life a spark resting on the floor loses a frame, on
+	// top of the system's decay.
+	restDecay = 0.03
+)
+
+// collide bounces the sparks off the edges of the canvas, w by h cells.
+func (m model) collide(w, h int, dt float64) {
+	right, bottom := float64(w-1), float64(h-1)
+	m.particles.Retain(func(p *particles.Particle) bool {
+		if p.X < 0 || p.X > right {
+			p.X = math.Min(math.Max(p.X, 0), right)
+			p.VX = -p.VX * restitution
+		}
+		floor := (p.Y > bottom && m.gravity.Y > 0) || (p.Y < 0 && m.gravity.Y < 0)
+		if p.Y < 0 || p.Y > bottom {
+			p.Y = math.Min(math.Max(p.Y, 0), bottom)
+			p.VY = -p.VY * restitution
+		}
+		if floor {
+			p.VX *= friction
+			if math.Abs(p.VY) < restSpeed {
+				p.VY = 0
+				p.Life -= restDecay * dt
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,56 @@
+package particlesystem
+
+import (
+	"math"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+// The fountain follows the pointer, and a left click throws a burst of
+// sparks out in a ring from where it lands.
+
+const (
+	// maxParticles is how many sparks the system holds, room for the
+	// fountain and a few bursts at once.
+	maxParticles = 300
+	// defaultBurst, burstStep and maxBurst are how many sparks a click
+	// throws to begin with, how many each press of b or B changes that by,
+	// and the most.
+	defaultBurst = 30
+	burstStep    = 10
+	maxBurst     = 150
+	// burstSpeed is the fastest a burst throws a spark, in cells a frame.
+	burstSpeed = 3.0
+)
+
+// fieldTop is the screen row the sparks' canvas starts on.
+const fieldTop = 3
+
+// mouse moves the fountain to the pointer and bursts on a left click.
+func (m model) mouse(msg tea.MouseMsg) model {
+	x := math.Min(math.Max(float64(msg.X), 0), float64(m.width-1))
+	y := math.Min(math.Max(float64(msg.Y-fieldTop), 0), float64(m.height-fieldTop-1))
+	m.emitter.X, m.emitter.Y = x, y
+	if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
+		m.explode(x, y)
+	}
+	return m
+}
+
+// explode throws a burst of sparks out from x, y in every direction, rows
+// counting double so the ring is round.
+func (m model) explode(x, y float64) {
+	for i := 0; i < m.burst; i++ {
+		p := m.particles.Spawn()
+		if p == nil {
+			return
+		}
+		angle := rng.Float64() * 2 * math.Pi
+		speed := burstSpeed * (0.3 + 0.7*rng.Float64())
+		p.X, p.Y = x, y
+		p.VX, p.VY = math.Cos(angle)*speed, math.Sin(angle)*speed/2
+		p.Rune = m.emitter.Runes[rng.Intn(len(m.emitter.Runes))]
+		p.Color = m.emitter.Colors[rng.Intn(len(m.emitter.Colors))]
+	}
+}
@@ -0,0 +1,203 @@
+// Package particlesystem is dynamic particle effects with physics simulation.
+package particlesystem
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	width     int
+	height    int
+	particles *particles.System
+	emitter   particles.Emitter
+	emitting  bool
+	gravity   *particles.Gravity
+	wind      *particles.Wind
+	anim      engine.Animator
+	burst     int
+	colliding bool
+}
+
+type keyMap struct {
+	Emit      key.Binding
+	Gravity   key.Binding
+	WindLeft  key.Binding
+	WindRight key.Binding
+	Burst     key.Binding
+	BurstUp   key.Binding
+	Collide   key.Binding
+	Reset     key.Binding
+	Quit      key.Binding
+	Help      key.Binding
+}
+
+var keys = keyMap{
+	Emit:      keymap.New("space", "toggle", " "),
+	Gravity:   keymap.New("g", "gravity flip"),
+	WindLeft:  keymap.New("←→", "wind", "left"),
+	WindRight: keymap.Hidden("right"),
+	Burst:     keymap.New("b/B", "burst size", "b"),
+	BurstUp:   keymap.Hidden("B"),
+	Collide:   keymap.New("c", "collisions"),
+	Reset:     keymap.Reset(),
+	Quit:      keymap.Quit(),
+	Help:      keymap.Help(),
+}
+
+func initialModel() model {
+	gravity := &particles.Gravity{Y: 0.1}
+	wind := &particles.Wind{}
+	system := particles.New(maxParticles)
+	system.Forces = []particles.Force{gravity, wind}
+	system.Decay = 0.02
+
+	return model{
+		width:     80,
+		height:    24,
+		particles: system,
+		emitter: particles.Emitter{
+			X:        40,
+			Y:        19,
+			JitterVX: 3,
+			VY:       -2,
+			JitterVY: 2,
+			Runes:    []rune("✦✧⋆◦•∘○◌"),
+			Colors:   []lipgloss.Color{common.Yellow, common.Orange, common.Red, common.Pink},
+			Rate:     3,
+		},
+		emitting:  true,
+		gravity:   gravity,
+		wind:      wind,
+		anim:      engine.New(engine.SharedFPS),
+		burst:     defaultBurst,
+		colliding: true,
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "02-particle-system",
+		Heading:  "✨ Particle System",
+		Summary:  "Dynamic particle effects with physics simulation",
+		Section:  registry.Examples,
+		Keywords: []string{"physics", "fireworks", "gravity"},
+		Manual:   doc,
+		Build:    New,
+		Opts:     []tea.ProgramOption{tea.WithMouseAllMotion()},
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.emitter.X = float64(m.width) / 2
+		m.emitter.Y = float64(m.height) - 5
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if !ok {
+			return m, cmd
+		}
+
+		dt := m.anim.Delta()
+		if m.emitting {
+			m.emitter.Update(m.particles, dt)
+		}
+		m.particles.Update(dt)
+		if m.colliding {
+			m.collide(m.width, m.height-fieldTop, dt)
+		}
+		m.particles.Retain(func(p *particles.Particle) bool {
+			return p.Y < float64(m.height) && p.X >= 0 && p.X < float64(m.width)
+		})
+		
+		return m, cmd
+
+	case tea.MouseMsg:
+		return m.mouse(msg), nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Emit):
+			m.emitting = !m.emitting
+		case key.Matches(msg, keys.Gravity):
+			m.gravity.Y = -m.gravity.Y
+		case key.Matches(msg, keys.WindLeft):
+			m.wind.Strength -= 0.05
+		case key.Matches(msg, keys.WindRight):
+			m.wind.Strength += 0.05
+		case key.Matches(msg, keys.Collide):
+			m.colliding = !m.colliding
+		case key.Matches(msg, keys.Burst):
+			m.burst = max(m.burst-burstStep, burstStep)
+		case key.Matches(msg, keys.BurstUp):
+			m.burst = min(m.burst+burstStep, maxBurst)
+		case key.Matches(msg, keys.Reset):
+			m.particles.Clear()
+			m.gravity.Y = 0.1
+			m.wind.Strength = 0
+			m.emitter.X = float64(m.width) / 2
+			m.emitter.Y = float64(m.height) - 5
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	c := canvas.New(m.width, m.height-3)
+	
+	m.particles.Draw(c)
+	
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Orange).
+		Padding(0, 1)
+	
+	title := titleStyle.Render("✨ Particle System")
+	
+	statusStyle := lipgloss.NewStyle().Foreground(common.Yellow)
+	status := statusStyle.Render(fmt.Sprintf("Particles: %d | Gravity: %.1f | Wind: %.1f | Burst: %d | Walls: %s | %s",
+		m.particles.Len(), m.gravity.Y, m.wind.Strength, m.burst,
+		map[bool]string{true: "on", false: "off"}[m.colliding],
+		map[bool]string{true: "Emitting", false: "Paused"}[m.emitting]))
+	
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+	
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s", title, status, c.Render(), help)
+}
@@ -0,0 +1,200 @@
+// Package loadingspinners is a collection of animated loading indicators.
+package loadingspinners
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type spinner struct {
+	name   string
+	frames []string
+	index  int
+	color  lipgloss.Color
+}
+
+type model struct {
+	spinners []spinner
+	anim     engine.Animator
+}
+
+type keyMap struct {
+	Quit key.Binding
+	Help key.Binding
+}
+
+var keys = keyMap{
+	Quit: keymap.Quit(),
+	Help: keymap.Help(),
+}
+
+func initialModel() model {
+	return model{
+		anim: engine.New(12.5), // 80ms per frame
+		spinners: []spinner{
+			{
+				name:   "Dots",
+				frames: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+				color:  common.Blue,
+			},
+			{
+				name:   "Line",
+				frames: []string{"-", "\\", "|", "/"},
+				color:  common.Green,
+			},
+			{
+				name:   "Circle",
+				frames: []string{"◐", "◓", "◑", "◒"},
+				color:  common.Yellow,
+			},
+			{
+				name:   "Square",
+				frames: []string{"◰", "◳", "◲", "◱"},
+				color:  common.Red,
+			},
+			{
+				name:   "Triangle",
+				frames: []string{"◢", "◣", "◤", "◥"},
+				color:  common.Purple,
+			},
+			{
+				name:   "Box",
+				frames: []string{"▖", "▘", "▝", "▗"},
+				color:  common.Cyan,
+			},
+			{
+				name:   "Arc",
+				frames: []string{"◜", "◠", "◝", "◞", "◡", "◟"},
+				color:  common.Orange,
+			},
+			{
+				name:   "Bounce",
+				frames: []string{"⠁", "⠂", "⠄", "⠂"},
+				color:  common.Pink,
+			},
+			{
+				name:   "Pulse",
+				frames: []string{"▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃"},
+				color:  common.Blue,
+			},
+			{
+				name:   "Points",
+				frames: []string{"∙∙∙", "●∙∙", "∙●∙", "∙∙●", "∙∙∙"},
+				color:  common.Green,
+			},
+		},
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "03-loading-spinners",
+		Heading:  "🔄 Loading Spinners",
+		Summary:  "Collection of various animated loading indicators",
+		Section:  registry.Examples,
+		Keywords: []string{"spinner", "progress", "ui"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			for i := range m.spinners {
+				if m.anim.Frame()%(i+1) == 0 {
+					m.spinners[i].index = (m.spinners[i].index + 1) % len(m.spinners[i].frames)
+				}
+			}
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Quit) {
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Green).
+		Padding(0, 1).
+		MarginBottom(1)
+	
+	spinnerStyle := lipgloss.NewStyle().
+		Width(18).
+		Height(5).
+		Padding(1, 2).
+		MarginRight(1).
+		MarginBottom(1).
+		BorderStyle(lipgloss.RoundedBorder()).
+		Align(lipgloss.Center)
+	
+	content := titleStyle.Render("🔄 Loading Spinners Gallery") + "\n\n"
+	
+	var rows []string
+	var currentRow []string
+	
+	for i, s := range m.spinners {
+		frame := s.frames[s.index]
+		
+		style := spinnerStyle.BorderForeground(s.color)
+		spinnerContent := lipgloss.NewStyle().
+			Foreground(s.color).
+			Bold(true).
+			Render(frame)
+		
+		name := lipgloss.NewStyle().
+			Foreground(s.color).
+			Faint(true).
+			Render(s.name)
+		
+		box := style.Render(fmt.Sprintf("%s\n\n%s", spinnerContent, name))
+		currentRow = append(currentRow, box)
+		
+		if (i+1)%4 == 0 || i == len(m.spinners)-1 {
+			row := lipgloss.JoinHorizontal(lipgloss.Top, currentRow...)
+			rows = append(rows, row)
+			currentRow = []string{}
+		}
+	}
+	
+	content += lipgloss.JoinVertical(lipgloss.Left, rows...)
+	
+	helpStyle := lipgloss.NewStyle().Faint(true).MarginTop(2)
+	content += "\n\n" + helpStyle.Render(m.KeyMap().String())
+	
+	return content
+}
@@ -0,0 +1,293 @@
+// Package progressanimations is progress bars animated in different styles.
+package progressanimations
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type progressBar struct {
+	name     string
+	progress float64
+	speed    float64
+	style    string
+}
+
+type model struct {
+	bars  []progressBar
+	width int
+	anim  engine.Animator
+}
+
+type keyMap struct {
+	keymap.Common
+}
+
+var keys = keyMap{
+	Common: keymap.Animated(),
+}
+
+func initialModel() model {
+	return model{
+		width: 40,
+		anim:  engine.New(engine.SharedFPS),
+		bars: []progressBar{
+			{name: "Classic", progress: 0, speed: 0.01, style: "classic"},
+			{name: "Smooth", progress: 0, speed: 0.015, style: "smooth"},
+			{name: "Gradient", progress: 0, speed: 0.012, style: "gradient"},
+			{name: "Pulse", progress: 0, speed: 0.018, style: "pulse"},
+			{name: "Wave", progress: 0, speed: 0.02, style: "wave"},
+			{name: "Blocks", progress: 0, speed: 0.008, style: "blocks"},
+		},
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "04-progress-animations",
+		Heading:  "📊 Progress Animations",
+		Summary:  "Different styles of animated progress bars",
+		Section:  registry.Examples,
+		Keywords: []string{"progress", "bar", "ui"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			for i := range m.bars {
+				m.bars[i].progress += m.bars[i].speed * m.anim.Delta()
+				if m.bars[i].progress > 1 {
+					m.bars[i].progress = 0
+				}
+			}
+		}
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		m.width = min(msg.Width-20, 60)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			for i := range m.bars {
+				m.bars[i].progress = 0
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) renderBar(bar progressBar) string {
+	filled := int(bar.progress * float64(m.width))
+	
+	switch bar.style {
+	case "classic":
+		return m.renderClassic(filled)
+	case "smooth":
+		return m.renderSmooth(filled, bar.progress)
+	case "gradient":
+		return m.renderGradient(filled)
+	case "pulse":
+		return m.renderPulse(filled, bar.progress)
+	case "wave":
+		return m.renderWave(filled, bar.progress)
+	case "blocks":
+		return m.renderBlocks(filled)
+	default:
+		return ""
+	}
+}
+
+func (m model) renderClassic(filled int) string {
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", m.width-filled)
+	return lipgloss.NewStyle().Foreground(common.Blue).Render(bar)
+}
+
+func (m model) renderSmooth(filled int, progress float64) string {
+	chars := []string{"░", "▒", "▓", "█"}
+	bar := strings.Builder{}
+	
+	for i := 0; i < m.width; i++ {
+		if i < filled {
+			bar.WriteString("█")
+		} else if i == filled {
+			subProgress := (progress * float64(m.width)) - float64(filled)
+			charIndex := int(subProgress * float64(len(chars)-1))
+			bar.WriteString(chars[charIndex])
+		} else {
+			bar.WriteString("░")
+		}
+	}
+	
+	return lipgloss.NewStyle().Foreground(common.Green).Render(bar.String())
+}
+
+func (m model) renderGradient(filled int) string {
+	bar := strings.Builder{}
+	gradient := common.GradientFire
+	
+	for i := 0; i < m.width; i++ {
+		colorIndex := int(float64(i) / float64(m.width) * float64(len(gradient)-1))
+		style := canvas.Style{Fg: lipgloss.Color(gradient[colorIndex])}
+		
+		if i < filled {
+			bar.WriteString(style.Render("█"))
+		} else {
+			style.Faint = true
+			bar.WriteString(style.Render("░"))
+		}
+	}
+	
+	return bar.String()
+}
+
+func (m model) renderPulse(filled int, progress float64) string {
+	bar := strings.Builder{}
+	pulseIntensity := (math.Sin(progress*math.Pi*2) + 1) / 2
+	
+	for i := 0; i < m.width; i++ {
+		if i < filled {
+			alpha := 0.5 + pulseIntensity*0.5
+			if alpha > 0.7 {
+				bar.WriteString(canvas.Style{Fg: common.Purple}.Render("█"))
+			} else {
+				bar.WriteString(canvas.Style{Fg: common.Purple, Faint: true}.Render("█"))
+			}
+		} else {
+			bar.WriteString("░")
+		}
+	}
+	
+	return bar.String()
+}
+
+func (m model) renderWave(filled int, progress float64) string {
+	bar := strings.Builder{}
+	waveChars := []string{"▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+	
+	for i := 0; i < m.width; i++ {
+		if i < filled {
+			waveHeight := (math.Sin(float64(i)*0.3 + progress*10) + 1) / 2
+			charIndex := int(waveHeight * float64(len(waveChars)-1))
+			bar.WriteString(canvas.Style{Fg: common.Cyan}.Render(waveChars[charIndex]))
+		} else {
+			bar.WriteString(" ")
+		}
+	}
+	
+	return bar.String()
+}
+
+func (m model) renderBlocks(filled int) string {
+	bar := strings.Builder{}
+	blockChars := []string{"▏", "▎", "▍", "▌", "▋", "▊", "▉", "█"}
+	
+	fullBlocks := filled / len(blockChars)
+	remainder := filled % len(blockChars)
+	
+	bar.WriteString(strings.Repeat("█", fullBlocks))
+	
+	if remainder > 0 && fullBlocks < m.width {
+		bar.WriteString(blockChars[remainder-1])
+	}
+	
+	empty := m.width - fullBlocks
+	if remainder > 0 {
+		empty--
+	}
+	bar.WriteString(strings.Repeat(" ", max(0, empty)))
+	
+	return lipgloss.NewStyle().Foreground(common.Orange).Render(bar.String())
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Purple).
+		Padding(0, 1)
+	
+	content := titleStyle.Render("📊 Progress Bar Animations") + "\n\n"
+	
+	nameStyle := lipgloss.NewStyle().
+		Width(10).
+		Foreground(common.Yellow)
+	
+	percentStyle := lipgloss.NewStyle().
+		Width(5).
+		Align(lipgloss.Right).
+		Foreground(common.Green)
+	
+	for _, bar := range m.bars {
+		name := nameStyle.Render(bar.name)
+		percent := percentStyle.Render(fmt.Sprintf("%3.0f%%", bar.progress*100))
+		barRender := m.renderBar(bar)
+		
+		content += fmt.Sprintf("%s %s %s\n\n", name, barRender, percent)
+	}
+	
+	statusStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := "▶ Playing"
+	if m.anim.Paused() {
+		status = "⏸ Paused"
+	}
+	content += statusStyle.Render(status) + "\n"
+	
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	content += helpStyle.Render(m.KeyMap().String())
+	
+	return content
+}
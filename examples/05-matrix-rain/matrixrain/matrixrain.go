@@ -0,0 +1,178 @@
+// Package matrixrain is the classic Matrix digital rain effect.
+package matrixrain
+
+import (
+	_ "embed"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+)
+
+//go:embed doc.md
+var doc string
+
+type column struct {
+	chars    []rune
+	position int
+	speed    int
+	length   int
+}
+
+type model struct {
+	width   int
+	height  int
+	columns []column
+	anim    engine.Animator
+}
+
+type keyMap struct {
+	Reset key.Binding
+	Quit  key.Binding
+	Help  key.Binding
+}
+
+var keys = keyMap{
+	Reset: keymap.New("r", "restart the rain"),
+	Quit:  keymap.Quit(),
+	Help:  keymap.Help(),
+}
+
+func initialModel() model {
+	return model{
+		width:   80,
+		height:  24,
+		columns: []column{},
+		anim:    engine.New(20), // 50ms per frame
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "05-matrix-rain",
+		Heading:  "💻 Matrix Rain",
+		Summary:  "The classic Matrix digital rain effect",
+		Section:  registry.Examples,
+		Keywords: []string{"code", "cyberpunk", "green"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m *model) initColumns() {
+	m.columns = make([]column, m.width)
+	chars := []rune("ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ0123456789")
+	
+	for i := range m.columns {
+		length := rng.Intn(m.height/2) + 5
+		col := column{
+			chars:    make([]rune, m.height),
+			position: -rng.Intn(m.height),
+			speed:    rng.Intn(3) + 1,
+			length:   length,
+		}
+		
+		for j := range col.chars {
+			col.chars[j] = chars[rng.Intn(len(chars))]
+		}
+		
+		m.columns[i] = col
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.anim.Tick(), tea.EnterAltScreen)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.initColumns()
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if !ok {
+			return m, cmd
+		}
+		chars := []rune("ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ0123456789")
+		
+		for i := range m.columns {
+			if m.anim.Frame()%m.columns[i].speed == 0 {
+				m.columns[i].position++
+				
+				if m.columns[i].position-m.columns[i].length > m.height {
+					m.columns[i].position = -rng.Intn(m.height)
+					m.columns[i].speed = rng.Intn(3) + 1
+					m.columns[i].length = rng.Intn(m.height/2) + 5
+				}
+				
+				if rng.Float64() < 0.1 {
+					changePos := rng.Intn(m.height)
+					m.columns[i].chars[changePos] = chars[rng.Intn(len(chars))]
+				}
+			}
+		}
+		
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Reset):
+			m.initColumns()
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	if m.width == 0 || m.height == 0 {
+		return "Initializing..."
+	}
+	
+	c := canvas.New(m.width, m.height)
+	
+	greenShades := []string{"#00FF00", "#00CC00", "#009900", "#006600", "#003300"}
+	
+	for col, column := range m.columns {
+		for row := 0; row < m.height; row++ {
+			if row >= column.position-column.length && row < column.position {
+				distance := column.position - row
+				colorIndex := distance * len(greenShades) / column.length
+				if colorIndex >= len(greenShades) {
+					colorIndex = len(greenShades) - 1
+				}
+				
+				style := canvas.Style{Fg: lipgloss.Color(greenShades[colorIndex])}
+				
+				if distance == 1 {
+					style = canvas.Style{Fg: lipgloss.Color("#FFFFFF"), Bold: true}
+				}
+				
+				c.Set(col, row, column.chars[row], style)
+			}
+		}
+	}
+	
+	return c.Render()
+}
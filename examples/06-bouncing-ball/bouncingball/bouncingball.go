@@ -0,0 +1,259 @@
+// Package bouncingball is a physics-based ball animation with trails.
+package bouncingball
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/physics"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+//go:embed doc.md
+var doc string
+
+type ball struct {
+	physics.Body
+	char  rune
+	color lipgloss.Color
+	trail []position
+}
+
+// ballRadius is the size of a ball for collisions, half a cell.
+const ballRadius = 0.5
+
+type position struct {
+	x, y  float64
+	age   int
+	color lipgloss.Color
+}
+
+type model struct {
+	width    int
+	height   int
+	balls    []ball
+	gravity  float64
+	friction float64
+	anim     engine.Animator
+}
+
+type keyMap struct {
+	Kick    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	Add     key.Binding
+	Gravity key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Kick:    keymap.New("↑←→", "control", "up"),
+	Left:    keymap.Hidden("left"),
+	Right:   keymap.Hidden("right"),
+	Add:     keymap.New("a", "add ball"),
+	Gravity: keymap.New("g", "gravity flip"),
+	Common:  keymap.Animated(),
+}
+
+func initialModel() model {
+	return model{
+		width:    80,
+		height:   24,
+		gravity:  0.5,
+		friction: 0.98,
+		anim:     engine.New(engine.SharedFPS),
+		balls: []ball{
+			{
+				Body: physics.Body{Pos: physics.Vec{X: 40, Y: 10}, Vel: physics.Vec{X: 2}, Radius: ballRadius},
+				char: '●', color: common.Red,
+				trail: []position{},
+			},
+		},
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "06-bouncing-ball",
+		Heading:  "🏀 Bouncing Ball",
+		Summary:  "Physics-based ball animation with trails",
+		Section:  registry.Examples,
+		Keywords: []string{"physics", "gravity", "trail"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			dt := m.anim.Delta()
+			for i := range m.balls {
+				ball := &m.balls[i]
+				
+				// Add current position to trail
+				ball.trail = append(ball.trail, position{
+					x: ball.Pos.X, y: ball.Pos.Y, age: 0,
+					color: ball.color,
+				})
+				
+				// Age trail positions and remove old ones
+				newTrail := []position{}
+				for _, pos := range ball.trail {
+					if pos.age < 10 {
+						pos.age++
+						newTrail = append(newTrail, pos)
+					}
+				}
+				ball.trail = newTrail
+				
+				// Apply gravity and move
+				physics.Euler(&ball.Body, physics.Vec{Y: m.gravity}, dt)
+			}
+			
+			// Knock balls off each other
+			for i := range m.balls {
+				for j := i + 1; j < len(m.balls); j++ {
+					physics.Collide(&m.balls[i].Body, &m.balls[j].Body, m.friction)
+				}
+			}
+			
+			// Bounce off walls, losing some energy on the floor
+			box := physics.Box(0, 0, m.width-1, m.height-1).Inset(-ballRadius)
+			for i := range m.balls {
+				ball := &m.balls[i]
+				if physics.Bounce(&ball.Body, box, m.friction)&physics.Bottom != 0 {
+					ball.Vel.X *= m.friction
+					
+					// Add some randomness to prevent settling
+					if math.Abs(ball.Vel.Y) < 0.5 {
+						ball.Vel.Y = -2
+					}
+				}
+			}
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			anim := m.anim
+			anim.Reset()
+			m = initialModel()
+			m.anim = anim
+			return m, nil
+		case key.Matches(msg, keys.Gravity):
+			m.gravity = -m.gravity
+		case key.Matches(msg, keys.Kick):
+			if len(m.balls) > 0 {
+				m.balls[0].Vel.Y -= 3
+			}
+		case key.Matches(msg, keys.Left):
+			if len(m.balls) > 0 {
+				m.balls[0].Vel.X -= 1
+			}
+		case key.Matches(msg, keys.Right):
+			if len(m.balls) > 0 {
+				m.balls[0].Vel.X += 1
+			}
+		case key.Matches(msg, keys.Add):
+			// Add new ball
+			if len(m.balls) < 5 {
+				colors := []lipgloss.Color{common.Red, common.Blue, common.Green, common.Yellow, common.Purple}
+				chars := []rune{'●', '○', '◉', '⬤', '🔴'}
+				newBall := ball{
+					Body: physics.Body{
+						Pos:    physics.Vec{X: float64(m.width) / 2, Y: 5},
+						Vel:    physics.Vec{X: (float64(len(m.balls)) - 2.5) * 0.8},
+						Radius: ballRadius,
+					},
+					char:  chars[len(m.balls)%len(chars)],
+					color: colors[len(m.balls)%len(colors)],
+					trail: []position{},
+				}
+				m.balls = append(m.balls, newBall)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	// Create canvas
+	c := canvas.New(m.width, m.height)
+	
+	// Draw trails
+	for _, ball := range m.balls {
+		for _, pos := range ball.trail {
+			x, y := int(pos.x), int(pos.y)
+			alpha := float64(10-pos.age) / 10.0
+			char := '·'
+			if alpha > 0.7 {
+				char = '•'
+			} else if alpha > 0.4 {
+				char = '∘'
+			}
+			
+			c.Set(x, y, char, canvas.Style{Fg: pos.color, Faint: alpha < 0.5})
+		}
+	}
+	
+	// Draw balls
+	for _, ball := range m.balls {
+		c.Set(int(ball.Pos.X), int(ball.Pos.Y), ball.char, canvas.Style{Fg: ball.color, Bold: true})
+	}
+	
+	// Title and UI
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Red).
+		Padding(0, 1)
+	
+	title := titleStyle.Render("🏀 Bouncing Ball Physics")
+	
+	statusStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := fmt.Sprintf("Balls: %d | Gravity: %.1f | %s",
+		len(m.balls), m.gravity,
+		map[bool]string{true: "⏸ Paused", false: "▶ Playing"}[m.anim.Paused()])
+	
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := m.KeyMap().String()
+	
+	return fmt.Sprintf("%s  %s\n\n%s\n%s", title, statusStyle.Render(status), 
+		c.Render(), helpStyle.Render(help))
+}
@@ -0,0 +1,318 @@
+// Package starfield is a 3D starfield simulation with depth perception.
+package starfield
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type star struct {
+	x, y, z float64
+	prevX, prevY float64
+}
+
+type model struct {
+	width     int
+	height    int
+	stars     []star
+	speed     float64
+	centerX   float64
+	centerY   float64
+	anim      engine.Animator
+	res       canvas.Resolution
+	pixels    *canvas.Pixels
+}
+
+type keyMap struct {
+	Faster    key.Binding
+	Slower    key.Binding
+	TurboUp   key.Binding
+	TurboDown key.Binding
+	HiRes     key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Faster:    keymap.New("↑↓", "speed", "up"),
+	Slower:    keymap.Hidden("down"),
+	TurboUp:   keymap.New("+/-", "turbo", "+", "="),
+	TurboDown: keymap.Hidden("-"),
+	HiRes:     keymap.New("h", "hi-res"),
+	Common:    keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Speed float64           `json:"speed"`
+	Res   canvas.Resolution `json:"res"`
+}
+
+func initialModel() model {
+	p := prefs{Speed: 0.05}
+	settings.Load("starfield", &p)
+	m := model{
+		width:   80,
+		height:  24,
+		speed:   math.Min(math.Max(p.Speed, 0.005), 0.3),
+		anim:    engine.New(engine.SharedFPS),
+		res:     p.Res,
+		pixels:  canvas.NewPixels(p.Res, 80, 24),
+	}
+	m.centerX = float64(m.width) / 2
+	m.centerY = float64(m.height) / 2
+	m.initStars()
+	return m
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "07-starfield",
+		Heading:  "⭐ Starfield",
+		Summary:  "3D starfield simulation with depth perception",
+		Section:  registry.Examples,
+		Keywords: []string{"space", "stars", "3d"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "starfield", prefs{Speed: m.speed, Res: m.res}
+}
+
+func (m *model) initStars() {
+	m.stars = make([]star, 200)
+	for i := range m.stars {
+		m.stars[i] = star{
+			x: (rng.Float64() - 0.5) * 2,
+			y: (rng.Float64() - 0.5) * 2,
+			z: rng.Float64(),
+		}
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.centerX = float64(m.width) / 2
+		m.centerY = float64(m.height) / 2
+		m.pixels.Resize(m.width, m.height)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			for i := range m.stars {
+				star := &m.stars[i]
+				
+				// Store previous position for trail effect
+				star.prevX = star.x / star.z * m.centerX + m.centerX
+				star.prevY = star.y / star.z * m.centerY + m.centerY
+				
+				// Move star towards viewer
+				star.z -= m.speed * m.anim.Delta()
+				
+				// Reset star if it's too close
+				if star.z <= 0 {
+					star.x = (rng.Float64() - 0.5) * 2
+					star.y = (rng.Float64() - 0.5) * 2
+					star.z = 1.0
+				}
+			}
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.initStars()
+		case key.Matches(msg, keys.HiRes):
+			m.res = m.res.Next()
+			m.pixels.SetResolution(m.res)
+		case key.Matches(msg, keys.Faster):
+			m.speed = math.Min(m.speed+0.01, 0.2)
+		case key.Matches(msg, keys.Slower):
+			m.speed = math.Max(m.speed-0.01, 0.01)
+		case key.Matches(msg, keys.TurboUp):
+			m.speed = math.Min(m.speed+0.02, 0.3)
+		case key.Matches(msg, keys.TurboDown):
+			m.speed = math.Max(m.speed-0.02, 0.005)
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	var scene string
+	if m.res == canvas.Normal {
+		scene = m.renderCells()
+	} else {
+		scene = m.renderPixels()
+	}
+	
+	// Title and UI
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#000080")).
+		Padding(0, 1)
+	
+	title := titleStyle.Render("⭐ 3D Starfield")
+	
+	statusStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := fmt.Sprintf("Speed: %.3f | Stars: %d | Res: %s | %s",
+		m.speed, len(m.stars), m.res,
+		map[bool]string{true: "⏸ Paused", false: "🚀 Warping"}[m.anim.Paused()])
+	
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := m.KeyMap().String()
+	
+	return fmt.Sprintf("%s  %s\n\n%s\n%s", title, statusStyle.Render(status),
+		scene, helpStyle.Render(help))
+}
+
+func (m model) renderCells() string {
+	// Create canvas
+	c := canvas.New(m.width, m.height)
+	
+	// Draw stars
+	for _, star := range m.stars {
+		// Calculate screen position
+		screenX := star.x / star.z * m.centerX + m.centerX
+		screenY := star.y / star.z * m.centerY + m.centerY
+		
+		x, y := int(screenX), int(screenY)
+		
+		// Only draw if on screen
+		if x >= 0 && x < m.width && y >= 0 && y < m.height {
+			// Choose character and color based on distance
+			brightness := 1.0 - star.z
+			
+			var char rune
+			var color lipgloss.Color
+			
+			if brightness > 0.9 {
+				char = '✦'
+				color = lipgloss.Color("#FFFFFF")
+			} else if brightness > 0.8 {
+				char = '★'
+				color = lipgloss.Color("#FFFF99")
+			} else if brightness > 0.6 {
+				char = '✧'
+				color = lipgloss.Color("#CCCCCC")
+			} else if brightness > 0.4 {
+				char = '•'
+				color = lipgloss.Color("#999999")
+			} else if brightness > 0.2 {
+				char = '∘'
+				color = lipgloss.Color("#666666")
+			} else {
+				char = '·'
+				color = lipgloss.Color("#444444")
+			}
+			
+			style := canvas.Style{Fg: color}
+			if brightness > 0.8 {
+				style.Bold = true
+			} else if brightness < 0.3 {
+				style.Faint = true
+			}
+			
+			c.Set(x, y, char, style)
+			
+			// Draw trail for fast-moving stars
+			if m.speed > 0.08 && brightness > 0.5 {
+				prevX, prevY := int(star.prevX), int(star.prevY)
+				if prevX >= 0 && prevX < m.width && prevY >= 0 && prevY < m.height &&
+					(prevX != x || prevY != y) {
+					if c.IsBlank(prevX, prevY) {
+						c.Set(prevX, prevY, '·', canvas.Style{Fg: color, Faint: true})
+					}
+				}
+			}
+		}
+	}
+	
+	return c.Render()
+}
+
+// renderPixels plots the stars at sub-cell resolution, trails first so the
+// stars themselves are drawn on top.
+func (m model) renderPixels() string {
+	sx, sy := m.res.Scale()
+	m.pixels.Clear()
+	
+	if m.speed > 0.08 {
+		for _, star := range m.stars {
+			if 1.0-star.z > 0.5 {
+				m.pixels.Set(int(star.prevX*float64(sx)), int(star.prevY*float64(sy)), lipgloss.Color("#444444"))
+			}
+		}
+	}
+	
+	for _, star := range m.stars {
+		screenX := (star.x / star.z * m.centerX + m.centerX) * float64(sx)
+		screenY := (star.y / star.z * m.centerY + m.centerY) * float64(sy)
+		x, y := int(screenX), int(screenY)
+		
+		brightness := 1.0 - star.z
+		color := lipgloss.Color("#444444")
+		if brightness > 0.8 {
+			color = lipgloss.Color("#FFFFFF")
+		} else if brightness > 0.6 {
+			color = lipgloss.Color("#CCCCCC")
+		} else if brightness > 0.3 {
+			color = lipgloss.Color("#999999")
+		}
+		
+		m.pixels.Set(x, y, color)
+		// Close stars grow to a small cross
+		if brightness > 0.9 {
+			m.pixels.Set(x-1, y, color)
+			m.pixels.Set(x+1, y, color)
+			m.pixels.Set(x, y-1, color)
+			m.pixels.Set(x, y+1, color)
+		}
+	}
+	
+	c := canvas.New(m.width, m.height)
+	m.pixels.Draw(c)
+	return c.Render()
+}
@@ -0,0 +1,395 @@
+// Package audiovisualizer is an audio spectrum visualization, simulated or
+// driven by music.
+package audiovisualizer
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/audio"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type bar struct {
+	height   float64
+	target   float64
+	peak     float64
+	peakTime int
+}
+
+type model struct {
+	width     int
+	height    int
+	bars      []bar
+	time      float64
+	beatTime  int
+	intensity float64
+	mode      string
+	anim      engine.Animator
+
+	// Set while music is playing: its spectrum and beats replace the
+	// simulated patterns.
+	player *audio.Player
+	song   string
+	bands  []float64
+	beat   float64
+}
+
+type keyMap struct {
+	Music      key.Binding
+	Bass       key.Binding
+	Electronic key.Binding
+	Louder     key.Binding
+	Quieter    key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Music:      keymap.New("1", "music"),
+	Bass:       keymap.New("2", "bass"),
+	Electronic: keymap.New("3", "electronic"),
+	Louder:     keymap.New("↑↓", "intensity", "up"),
+	Quieter:    keymap.Hidden("down"),
+	Common:     keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Mode      string  `json:"mode"`
+	Intensity float64 `json:"intensity"`
+}
+
+func initialModel() model {
+	p := prefs{Mode: "music", Intensity: 1.0}
+	settings.Load("audio-visualizer", &p)
+	if p.Mode != "bass" && p.Mode != "electronic" {
+		p.Mode = "music"
+	}
+	return model{
+		width:     80,
+		height:    24,
+		bars:      make([]bar, 64),
+		time:      0,
+		intensity: math.Min(math.Max(p.Intensity, 0.1), 2.0),
+		mode:      p.Mode,
+		anim:      engine.New(engine.SharedFPS),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "08-audio-visualizer",
+		Heading:  "🎵 Audio Visualizer",
+		Summary:  "Simulated audio spectrum visualization",
+		Section:  registry.Examples,
+		Keywords: []string{"music", "spectrum", "sound", "beat"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// NewWithMusic returns the demo's model playing the WAV, Ogg Vorbis, MOD or
+// XM file at path and showing its spectrum. Stop the player when the demo
+// ends.
+func NewWithMusic(path string) (tea.Model, *audio.Player, error) {
+	m := initialModel()
+	player, err := audio.PlayFile(path, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.player, m.song = player, filepath.Base(path)
+	return m, player, nil
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "audio-visualizer", prefs{Mode: m.mode, Intensity: m.intensity}
+}
+
+func (m model) Init() tea.Cmd {
+	if m.player != nil {
+		return tea.Batch(m.anim.Tick(), m.player.Listen())
+	}
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		// Adjust number of bars to fit width
+		numBars := m.width / 2
+		if numBars > 128 {
+			numBars = 128
+		}
+		if numBars < 16 {
+			numBars = 16
+		}
+		
+		// Preserve existing bar data if possible
+		oldBars := m.bars
+		m.bars = make([]bar, numBars)
+		for i := range m.bars {
+			if i < len(oldBars) {
+				m.bars[i] = oldBars[i]
+			}
+		}
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.1 * m.anim.Delta()
+			m.beat *= math.Pow(0.85, m.anim.Delta())
+			
+			// Simulate different audio patterns
+			for i := range m.bars {
+				freq := float64(i) / float64(len(m.bars))
+				
+				var newTarget float64
+				switch m.mode {
+				case "music":
+					// Simulate music with bass, mids, and treble
+					bass := math.Sin(m.time*0.5) * math.Exp(-freq*2)
+					mids := math.Sin(m.time*1.2+freq*math.Pi) * math.Exp(-(freq-0.3)*(freq-0.3)*10)
+					treble := math.Sin(m.time*2.5+freq*math.Pi*2) * math.Exp(-(freq-0.8)*(freq-0.8)*15)
+					newTarget = (bass + mids + treble) * m.intensity
+					
+				case "bass":
+					// Heavy bass emphasis
+					newTarget = math.Sin(m.time*0.8) * math.Exp(-freq*4) * m.intensity * 1.5
+					
+				case "electronic":
+					// Sharp electronic beats
+					beat := math.Sin(m.time * 4)
+					if beat > 0.7 {
+						newTarget = (1 - freq) * m.intensity
+					} else {
+						newTarget = math.Sin(m.time*3+freq*math.Pi*4) * (1-freq) * m.intensity * 0.3
+					}
+				}
+				if m.player != nil {
+					newTarget = m.band(freq) * m.intensity * (1 + 0.3*m.beat)
+				} else {
+					// Add some randomness
+					newTarget += (rng.Float64() - 0.5) * 0.2 * m.intensity
+				}
+				newTarget = math.Max(0, newTarget)
+				
+				// Smooth movement towards target
+				m.bars[i].target = newTarget
+				diff := m.bars[i].target - m.bars[i].height
+				m.bars[i].height += diff * 0.3
+				
+				// Peak detection and decay
+				if m.bars[i].height > m.bars[i].peak {
+					m.bars[i].peak = m.bars[i].height
+					m.bars[i].peakTime = 0
+				} else {
+					m.bars[i].peakTime++
+					if m.bars[i].peakTime > 10 {
+						m.bars[i].peak *= 0.95
+					}
+				}
+			}
+			
+			// Beat detection for intensity changes
+			m.beatTime++
+			if m.player == nil && m.beatTime%30 == 0 {
+				m.intensity = 0.5 + rng.Float64()*0.8
+			}
+		}
+		return m, cmd
+
+	case audio.EnergyMsg:
+		m.bands = msg.Bands
+		return m, m.player.Listen()
+
+	case audio.BeatMsg:
+		m.beat = math.Min(1, msg.Strength/2)
+		return m, m.player.Listen()
+
+	case audio.RowMsg:
+		return m, m.player.Listen()
+
+	case audio.DoneMsg:
+		// Back to the simulated patterns once the song ends
+		m.player, m.bands, m.beat = nil, nil, 0
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			for i := range m.bars {
+				m.bars[i] = bar{}
+			}
+			m.time = 0
+		case key.Matches(msg, keys.Music):
+			m.mode = "music"
+		case key.Matches(msg, keys.Bass):
+			m.mode = "bass"
+		case key.Matches(msg, keys.Electronic):
+			m.mode = "electronic"
+		case key.Matches(msg, keys.Louder):
+			m.intensity = math.Min(m.intensity+0.2, 2.0)
+		case key.Matches(msg, keys.Quieter):
+			m.intensity = math.Max(m.intensity-0.2, 0.1)
+		}
+	}
+
+	return m, nil
+}
+
+// band returns the music's level at freq, from 0 for the lowest band to 1
+// for the highest, blending neighboring bands so any number of bars fits.
+func (m model) band(freq float64) float64 {
+	if len(m.bands) == 0 {
+		return 0
+	}
+	pos := freq * float64(len(m.bands)-1)
+	i := int(pos)
+	if i >= len(m.bands)-1 {
+		return m.bands[len(m.bands)-1]
+	}
+	frac := pos - float64(i)
+	return m.bands[i]*(1-frac) + m.bands[i+1]*frac
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	if len(m.bars) == 0 {
+		return "Initializing..."
+	}
+	
+	// Create visualization
+	lines := make([]string, m.height)
+	barWidth := math.Max(1, float64(m.width)/float64(len(m.bars)))
+	
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		normalizedY := 1.0 - float64(y)/float64(m.height-1)
+		
+		for i, bar := range m.bars {
+			x := int(float64(i) * barWidth)
+			
+			// Skip if we've moved past this x position
+			if x >= line.Len() {
+				// Fill gaps
+				for line.Len() < x {
+					line.WriteString(" ")
+				}
+				
+				normalizedHeight := bar.height * 0.8 // Scale to fit nicely
+				normalizedPeak := bar.peak * 0.8
+				
+				var char string
+				var style canvas.Style
+				
+				if normalizedY <= normalizedPeak && normalizedY > normalizedPeak-0.05 {
+					// Peak indicator
+					char = "▄"
+					style = canvas.Style{Fg: lipgloss.Color("#FFFFFF"), Bold: true}
+				} else if normalizedY <= normalizedHeight {
+					// Main bar
+					intensity := normalizedHeight
+					if intensity > 0.8 {
+						char = "█"
+						style = canvas.Style{Fg: lipgloss.Color("#FF0000")}
+					} else if intensity > 0.6 {
+						char = "▆"
+						style = canvas.Style{Fg: lipgloss.Color("#FF6600")}
+					} else if intensity > 0.4 {
+						char = "▄"
+						style = canvas.Style{Fg: lipgloss.Color("#FFFF00")}
+					} else if intensity > 0.2 {
+						char = "▂"
+						style = canvas.Style{Fg: lipgloss.Color("#00FF00")}
+					} else {
+						char = "▁"
+						style = canvas.Style{Fg: lipgloss.Color("#0088FF")}
+					}
+				} else {
+					char = " "
+					style = canvas.Style{}
+				}
+				
+				// Fill bar width
+				for w := 0; w < int(barWidth) && line.Len() < m.width; w++ {
+					if w == 0 || char != " " {
+						line.WriteString(style.Render(char))
+					} else {
+						line.WriteString(" ")
+					}
+				}
+			}
+		}
+		
+		// Fill remaining width
+		for line.Len() < m.width {
+			line.WriteString(" ")
+		}
+		
+		lines[y] = line.String()
+	}
+	
+	// Title and UI
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#8B008B")).
+		Padding(0, 1)
+	
+	if m.beat > 0.5 && !engine.ReducedMotion() {
+		titleStyle = titleStyle.Background(lipgloss.Color("#FF1493"))
+	}
+	title := titleStyle.Render("🎵 Audio Spectrum Visualizer")
+	
+	statusStyle := lipgloss.NewStyle().Foreground(common.Yellow)
+	mode := strings.Title(m.mode)
+	if m.player != nil {
+		pos := m.player.Position().Truncate(time.Second)
+		mode = fmt.Sprintf("%s %s", m.song, pos)
+		if m.player.Silent() {
+			mode += " (silent)"
+		}
+	}
+	status := fmt.Sprintf("Mode: %s | Intensity: %.1f | Bars: %d | %s",
+		mode, m.intensity, len(m.bars),
+		map[bool]string{true: "⏸ Paused", false: "🎶 Playing"}[m.anim.Paused()])
+	
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := m.KeyMap().String()
+	
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s", title, statusStyle.Render(status),
+		strings.Join(lines, "\n"), helpStyle.Render(help))
+}
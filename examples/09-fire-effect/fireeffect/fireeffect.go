@@ -0,0 +1,296 @@
+// Package fireeffect is a fire simulation with heat propagation.
+package fireeffect
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+	"github.com/yourusername/bubbletea-showcase/common/noise"
+)
+
+//go:embed doc.md
+var doc string
+
+type model struct {
+	width     int
+	height    int
+	fireField [][]float64
+	intensity float64
+	windForce float64
+	anim      engine.Animator
+}
+
+type keyMap struct {
+	Hotter    key.Binding
+	Cooler    key.Binding
+	WindLeft  key.Binding
+	WindRight key.Binding
+	Calm      key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Hotter:    keymap.New("↑↓", "intensity", "up"),
+	Cooler:    keymap.Hidden("down"),
+	WindLeft:  keymap.New("←→", "wind", "left"),
+	WindRight: keymap.Hidden("right"),
+	Calm:      keymap.New("0", "calm wind"),
+	Common:    keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Intensity float64 `json:"intensity"`
+	Wind      float64 `json:"wind"`
+}
+
+func initialModel() model {
+	p := prefs{Intensity: 1.0}
+	settings.Load("fire", &p)
+	return model{
+		width:     80,
+		height:    24,
+		intensity: math.Min(math.Max(p.Intensity, 0.1), 2.0),
+		windForce: math.Min(math.Max(p.Wind, -1.0), 1.0),
+		anim:      engine.New(engine.SharedFPS),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "09-fire-effect",
+		Heading:  "🔥 Fire Effect",
+		Summary:  "Realistic fire simulation with heat propagation",
+		Section:  registry.Examples,
+		Keywords: []string{"flames", "heat"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "fire", prefs{Intensity: m.intensity, Wind: m.windForce}
+}
+
+func (m *model) initFireField() {
+	m.fireField = make([][]float64, m.height)
+	for i := range m.fireField {
+		m.fireField[i] = make([]float64, m.width)
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.initFireField()
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.updateFire()
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.initFireField()
+		case key.Matches(msg, keys.Hotter):
+			m.intensity = math.Min(m.intensity+0.1, 2.0)
+		case key.Matches(msg, keys.Cooler):
+			m.intensity = math.Max(m.intensity-0.1, 0.1)
+		case key.Matches(msg, keys.WindLeft):
+			m.windForce = math.Max(m.windForce-0.1, -1.0)
+		case key.Matches(msg, keys.WindRight):
+			m.windForce = math.Min(m.windForce+0.1, 1.0)
+		case key.Matches(msg, keys.Calm):
+			m.windForce = 0.0
+		}
+	}
+
+	return m, nil
+}
+
+func (m *model) updateFire() {
+	if len(m.fireField) == 0 {
+		return
+	}
+
+	// Create new fire field
+	newField := make([][]float64, m.height)
+	for i := range newField {
+		newField[i] = make([]float64, m.width)
+	}
+
+	// Add heat sources at the bottom
+	bottomRow := m.height - 1
+	for x := 0; x < m.width; x++ {
+		// Create hot spots with some randomness
+		if rng.Float64() < 0.7 {
+			heat := (0.8 + rng.Float64()*0.2) * m.intensity
+			// Create some variation in the base fire
+			if x%3 == 0 || x%7 == 0 {
+				heat *= 1.2
+			}
+			m.fireField[bottomRow][x] = heat
+		}
+	}
+
+	// Propagate fire upward with cooling and wind
+	for y := 1; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			// Gather heat from below and surrounding cells
+			heat := 0.0
+			samples := 0
+
+			// Sample from below (main heat source)
+			if y < m.height-1 {
+				heat += m.fireField[y+1][x] * 0.4
+				samples++
+
+				// Sample diagonally below for spread
+				if x > 0 {
+					heat += m.fireField[y+1][x-1] * 0.2
+					samples++
+				}
+				if x < m.width-1 {
+					heat += m.fireField[y+1][x+1] * 0.2
+					samples++
+				}
+			}
+
+			// Apply wind effect
+			windOffset := int(m.windForce * 2)
+			windX := x - windOffset
+			if windX >= 0 && windX < m.width && y < m.height-1 {
+				heat += m.fireField[y+1][windX] * 0.2
+				samples++
+			}
+
+			// Add turbulence that rises with the flames
+			t := m.anim.Elapsed()
+			heat += noise.FBM3(noise.Simplex3,
+				float64(x)*0.15, float64(y)*0.2+t*3, t*0.5,
+				2, 2.0, 0.5) * 0.08
+
+			// Cool down as it rises
+			coolingFactor := 0.95 - (float64(m.height-y)/float64(m.height))*0.3
+			heat *= coolingFactor
+
+			// Add horizontal spreading
+			if x > 0 {
+				heat += m.fireField[y][x-1] * 0.1
+			}
+			if x < m.width-1 {
+				heat += m.fireField[y][x+1] * 0.1
+			}
+
+			newField[y][x] = math.Max(0, heat)
+		}
+	}
+
+	m.fireField = newField
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	if len(m.fireField) == 0 {
+		return "Initializing fire..."
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#FF4500")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🔥 Fire Effect")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Yellow)
+	status := statusStyle.Render(fmt.Sprintf(
+		"Intensity: %.1f | Wind: %.1f | %s",
+		m.intensity, m.windForce,
+		map[bool]string{true: "⏸ Paused", false: "🔥 Burning"}[m.anim.Paused()],
+	))
+
+	// Render fire
+	lines := make([]string, m.height)
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		for x := 0; x < m.width; x++ {
+			heat := m.fireField[y][x]
+			char, color := m.getFireChar(heat)
+			style := canvas.Style{Fg: color}
+			line.WriteString(style.Render(char))
+		}
+		lines[y] = line.String()
+	}
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	return fmt.Sprintf("%s  %s\n\n%s\n%s",
+		title, status, strings.Join(lines, "\n"), help)
+}
+
+func (m model) getFireChar(heat float64) (string, lipgloss.Color) {
+	if heat < 0.1 {
+		return " ", lipgloss.Color("#000000")
+	} else if heat < 0.2 {
+		chars := []string{".", "·", "∘"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#330000")
+	} else if heat < 0.35 {
+		chars := []string{"∘", "•", "◦"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#660000")
+	} else if heat < 0.5 {
+		chars := []string{"▁", "▂", "▃"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#990000")
+	} else if heat < 0.65 {
+		chars := []string{"▄", "▅", "▆"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#CC3300")
+	} else if heat < 0.8 {
+		chars := []string{"▇", "█", "▉"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#FF4500")
+	} else if heat < 0.95 {
+		chars := []string{"▓", "▒", "░"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#FF6600")
+	} else {
+		chars := []string{"▓", "▒", "░", "▔"}
+		return chars[rng.Intn(len(chars))], lipgloss.Color("#FFAA00")
+	}
+}
@@ -0,0 +1,399 @@
+// Package fluidsimulation is water droplets with ripples and physics.
+package fluidsimulation
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/draw"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/particles"
+	"github.com/yourusername/bubbletea-showcase/common/physics"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/rng"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type ripple struct {
+	x, y     float64
+	radius   float64
+	strength float64
+	age      float64
+}
+
+type model struct {
+	width     int
+	height    int
+	droplets  *particles.System
+	ripples   []ripple
+	surface   [][]float64
+	time      float64
+	gravity   *particles.Gravity
+	viscosity float64
+	mode      string
+	anim      engine.Animator
+}
+
+type keyMap struct {
+	Rain     key.Binding
+	Drops    key.Binding
+	Fountain key.Binding
+	Heavier  key.Binding
+	Lighter  key.Binding
+	Thinner  key.Binding
+	Thicker  key.Binding
+	Drop     key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Rain:     keymap.New("1", "rain"),
+	Drops:    keymap.New("2", "drops"),
+	Fountain: keymap.New("3", "fountain"),
+	Heavier:  keymap.New("↑↓", "gravity", "up"),
+	Lighter:  keymap.Hidden("down"),
+	Thinner:  keymap.New("←→", "viscosity", "left"),
+	Thicker:  keymap.Hidden("right"),
+	Drop:     keymap.New("c", "add drop"),
+	Common:   keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Mode      string  `json:"mode"`
+	Gravity   float64 `json:"gravity"`
+	Viscosity float64 `json:"viscosity"`
+}
+
+func initialModel() model {
+	p := prefs{Mode: "rain", Gravity: 0.3, Viscosity: 0.98}
+	settings.Load("fluid", &p)
+	if p.Mode != "drops" && p.Mode != "fountain" {
+		p.Mode = "rain"
+	}
+
+	gravity := &particles.Gravity{Y: math.Min(math.Max(p.Gravity, 0.1), 1.0)}
+	droplets := particles.New(150)
+	droplets.Forces = []particles.Force{gravity}
+	droplets.Decay = 0.01
+
+	return model{
+		width:     80,
+		height:    24,
+		droplets:  droplets,
+		gravity:   gravity,
+		viscosity: math.Min(math.Max(p.Viscosity, 0.90), 0.99),
+		mode:      p.Mode,
+		anim:      engine.New(engine.SharedFPS),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "10-fluid-simulation",
+		Heading:  "💧 Fluid Simulation",
+		Summary:  "Water droplets with ripples and physics",
+		Section:  registry.Examples,
+		Keywords: []string{"water", "ripples", "physics"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "fluid", prefs{Mode: m.mode, Gravity: m.gravity.Y, Viscosity: m.viscosity}
+}
+
+func (m *model) initSurface() {
+	m.surface = make([][]float64, m.height)
+	for i := range m.surface {
+		m.surface[i] = make([]float64, m.width)
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.initSurface()
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok {
+			m.time += 0.1 * m.anim.Delta()
+			m.updateSimulation()
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Reset):
+			m.droplets.Clear()
+			m.ripples = nil
+			m.initSurface()
+			m.time = 0
+			m.anim.Reset()
+		case key.Matches(msg, keys.Rain):
+			m.mode = "rain"
+		case key.Matches(msg, keys.Drops):
+			m.mode = "drops"
+		case key.Matches(msg, keys.Fountain):
+			m.mode = "fountain"
+		case key.Matches(msg, keys.Heavier):
+			m.gravity.Y = math.Min(m.gravity.Y+0.1, 1.0)
+		case key.Matches(msg, keys.Lighter):
+			m.gravity.Y = math.Max(m.gravity.Y-0.1, 0.1)
+		case key.Matches(msg, keys.Thinner):
+			m.viscosity = math.Max(m.viscosity-0.01, 0.90)
+		case key.Matches(msg, keys.Thicker):
+			m.viscosity = math.Min(m.viscosity+0.01, 0.99)
+		case key.Matches(msg, keys.Drop):
+			// Add manual droplet at center
+			m.addDroplet(float64(m.width)/2, 5, 0, 0, 1.0)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *model) addDroplet(x, y, vx, vy, size float64) {
+	if d := m.droplets.Spawn(); d != nil {
+		d.X, d.Y, d.VX, d.VY, d.Size = x, y, vx, vy, size
+	}
+}
+
+// emitter describes where the current mode spawns droplets.
+func (m model) emitter() particles.Emitter {
+	w, h := float64(m.width), float64(m.height)
+	switch m.mode {
+	case "drops":
+		return particles.Emitter{
+			X: w / 2, Y: float64(m.height/2) / 2, SpreadX: w, SpreadY: float64(m.height / 2),
+			JitterVX: 2, VY: 1, JitterVY: 2,
+			Size: 0.5, JitterSize: 0.4,
+			Rate: 0.1,
+		}
+	case "fountain":
+		return particles.Emitter{
+			X: w / 2, Y: h - 5, SpreadX: 10,
+			JitterVX: 3, VY: -4, JitterVY: 2,
+			Size: 0.55, JitterSize: 0.3,
+			Rate: 0.4,
+		}
+	default: // rain
+		return particles.Emitter{
+			X: w / 2, SpreadX: w,
+			JitterVX: 0.5,
+			Size: 0.75, JitterSize: 0.5,
+			Rate: 0.3,
+		}
+	}
+}
+
+func (m *model) updateSimulation() {
+	if len(m.surface) == 0 {
+		return
+	}
+
+	// Generate new droplets based on mode
+	dt := m.anim.Delta()
+	emitter := m.emitter()
+	emitter.Update(m.droplets, dt)
+	m.droplets.Update(dt)
+
+	// Update ripples
+	alive := m.ripples[:0]
+	for _, r := range m.ripples {
+		r.radius += 0.5
+		r.strength *= 0.95
+		r.age += 0.1
+		if r.strength > 0.01 && r.radius < 20 {
+			alive = append(alive, r)
+		}
+	}
+	m.ripples = alive
+
+	droplets := m.droplets.Particles()
+	for i := range droplets {
+		d := &droplets[i]
+
+		// Check for surface collision
+		if d.Y >= float64(m.height)-10 && d.VY > 0 {
+			// Create ripple on impact
+			impact := math.Min(math.Abs(d.VY)*d.Size, 2.0)
+			m.ripples = append(m.ripples, ripple{
+				x: d.X, y: d.Y,
+				radius: 0, strength: impact, age: 0,
+			})
+			// Bounce with energy loss
+			d.VY = -d.VY * 0.3
+			d.VX *= 0.7
+			d.Life -= 0.2
+		}
+
+		// Check bounds
+		physics.Reflect(&d.X, &d.VX, 0, float64(m.width-1), 0.8)
+	}
+
+	// Keep alive droplets
+	m.droplets.Retain(func(d *particles.Particle) bool {
+		return d.Life > 0 && d.Y < float64(m.height)
+	})
+
+	// Update surface waves
+	m.updateSurface()
+}
+
+func (m *model) updateSurface() {
+	// Clear surface
+	for y := range m.surface {
+		for x := range m.surface[y] {
+			m.surface[y][x] = 0
+		}
+	}
+
+	// Add ripple effects
+	for _, r := range m.ripples {
+		m.addRippleToSurface(r)
+	}
+
+	// Add base wave motion
+	waterLevel := float64(m.height) - 8
+	for x := 0; x < m.width; x++ {
+		wave := math.Sin(float64(x)*0.2+m.time*2) * 0.5
+		wave += math.Sin(float64(x)*0.1+m.time*1.5) * 0.3
+		y := int(waterLevel + wave)
+		if y >= 0 && y < m.height {
+			m.surface[y][x] = math.Max(m.surface[y][x], 0.3)
+		}
+	}
+}
+
+func (m *model) addRippleToSurface(r ripple) {
+	centerX, centerY := int(r.x), int(r.y)
+	radius := int(math.Ceil(r.radius))
+
+	draw.FilledCircleFunc(centerX, centerY, radius, func(x, y int) {
+		if x < 0 || x >= m.width || y < 0 || y >= m.height {
+			return
+		}
+		dx, dy := float64(x-centerX), float64(y-centerY)
+		dist := math.Sqrt(dx*dx + dy*dy)
+		if dist <= r.radius {
+			// Calculate wave height based on distance
+			waveHeight := r.strength * math.Cos(dist*math.Pi/(r.radius*2))
+			if waveHeight > 0 {
+				m.surface[y][x] = math.Max(m.surface[y][x], waveHeight)
+			}
+		}
+	})
+}
+
+// KeyMap implements engine.KeyMapper.
+func (m model) KeyMap() keymap.Map {
+	return keymap.Of(keys)
+}
+
+func (m model) View() string {
+	if len(m.surface) == 0 {
+		return "Initializing fluid simulation..."
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Blue).
+		Padding(0, 1)
+
+	title := titleStyle.Render("💧 Fluid Simulation")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Cyan)
+	status := statusStyle.Render(fmt.Sprintf(
+		"Mode: %s | Droplets: %d | Gravity: %.1f | Viscosity: %.2f | %s",
+		strings.Title(m.mode), m.droplets.Len(), m.gravity.Y, m.viscosity,
+		map[bool]string{true: "⏸ Paused", false: "💧 Flowing"}[m.anim.Paused()],
+	))
+
+	// Render simulation
+	lines := make([]string, m.height)
+	for y := 0; y < m.height; y++ {
+		line := strings.Builder{}
+		for x := 0; x < m.width; x++ {
+			char, color := m.getFluidChar(x, y)
+			style := canvas.Style{Fg: color}
+			line.WriteString(style.Render(char))
+		}
+		lines[y] = line.String()
+	}
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := helpStyle.Render(m.KeyMap().String())
+
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s",
+		title, status, strings.Join(lines, "\n"), help)
+}
+
+func (m model) getFluidChar(x, y int) (string, lipgloss.Color) {
+	// Check for droplets first
+	for _, d := range m.droplets.Particles() {
+		if int(d.X) == x && int(d.Y) == y {
+			if d.Size > 0.7 {
+				return "●", common.Blue
+			} else {
+				return "•", common.Cyan
+			}
+		}
+	}
+
+	// Check surface waves
+	if m.surface[y][x] > 0 {
+		intensity := m.surface[y][x]
+		if intensity > 0.6 {
+			chars := []string{"█", "▓", "▒"}
+			return chars[rng.Intn(len(chars))], lipgloss.Color("#0066CC")
+		} else if intensity > 0.3 {
+			chars := []string{"▒", "░", "▫"}
+			return chars[rng.Intn(len(chars))], lipgloss.Color("#0088FF")
+		} else {
+			chars := []string{"░", "▫", "·"}
+			return chars[rng.Intn(len(chars))], lipgloss.Color("#00AAFF")
+		}
+	}
+
+	// Water level background
+	waterLevel := float64(m.height) - 8
+	if float64(y) >= waterLevel {
+		return "░", lipgloss.Color("#003366")
+	}
+
+	return " ", lipgloss.Color("#000000")
+}
@@ -0,0 +1,347 @@
+// Package rotatingcube is a real-time 3D wireframe cube with perspective.
+package rotatingcube
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/draw"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type point3D struct {
+	x, y, z float64
+}
+
+type edge struct {
+	start, end int
+}
+
+type model struct {
+	width       int
+	height      int
+	vertices    []point3D
+	edges       []edge
+	rotationX   float64
+	rotationY   float64
+	rotationZ   float64
+	scale       float64
+	autoRotate  bool
+	perspective float64
+	anim        engine.Animator
+}
+
+type keyMap struct {
+	Auto      key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	RollLeft  key.Binding
+	RollRight key.Binding
+	Bigger    key.Binding
+	Smaller   key.Binding
+	Closer    key.Binding
+	Farther   key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Auto:      keymap.New("a", "manual control"),
+	Up:        keymap.New("↑↓←→", "rotate", "up"),
+	Down:      keymap.Hidden("down"),
+	Left:      keymap.Hidden("left"),
+	Right:     keymap.Hidden("right"),
+	RollLeft:  keymap.New("z/x", "roll", "z"),
+	RollRight: keymap.Hidden("x"),
+	Bigger:    keymap.New("+/-", "scale", "+", "="),
+	Smaller:   keymap.Hidden("-"),
+	Closer:    keymap.New("p/o", "perspective", "p"),
+	Farther:   keymap.Hidden("o"),
+	Common:    keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Scale       float64 `json:"scale"`
+	Perspective float64 `json:"perspective"`
+	AutoRotate  bool    `json:"autoRotate"`
+}
+
+func initialModel() model {
+	p := prefs{Scale: 8, Perspective: 4, AutoRotate: true}
+	settings.Load("cube", &p)
+
+	// Define cube vertices
+	vertices := []point3D{
+		{-1, -1, -1}, {1, -1, -1}, {1, 1, -1}, {-1, 1, -1}, // Back face
+		{-1, -1, 1}, {1, -1, 1}, {1, 1, 1}, {-1, 1, 1},     // Front face
+	}
+
+	// Define cube edges
+	edges := []edge{
+		// Back face
+		{0, 1}, {1, 2}, {2, 3}, {3, 0},
+		// Front face
+		{4, 5}, {5, 6}, {6, 7}, {7, 4},
+		// Connecting edges
+		{0, 4}, {1, 5}, {2, 6}, {3, 7},
+	}
+
+	return model{
+		width:       80,
+		height:      24,
+		vertices:    vertices,
+		edges:       edges,
+		scale:       math.Min(math.Max(p.Scale, 2), 20),
+		autoRotate:  p.AutoRotate,
+		perspective: math.Min(math.Max(p.Perspective, 1), 10),
+		anim:        engine.New(engine.SharedFPS),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "11-rotating-cube",
+		Heading:  "🎲 3D Rotating Cube",
+		Summary:  "Real-time 3D wireframe cube with perspective",
+		Section:  registry.Examples,
+		Keywords: []string{"3d", "wireframe", "geometry"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "cube", prefs{Scale: m.scale, Perspective: m.perspective, AutoRotate: m.autoRotate}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok && m.autoRotate {
+			dt := m.anim.Delta()
+			m.rotationX += 0.02 * dt
+			m.rotationY += 0.03 * dt
+			m.rotationZ += 0.01 * dt
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Auto):
+			m.autoRotate = !m.autoRotate
+		case key.Matches(msg, keys.Reset):
+			m.rotationX = 0
+			m.rotationY = 0
+			m.rotationZ = 0
+		case key.Matches(msg, keys.Up):
+			if !m.autoRotate {
+				m.rotationX -= 0.1
+			}
+		case key.Matches(msg, keys.Down):
+			if !m.autoRotate {
+				m.rotationX += 0.1
+			}
+		case key.Matches(msg, keys.Left):
+			if !m.autoRotate {
+				m.rotationY -= 0.1
+			}
+		case key.Matches(msg, keys.Right):
+			if !m.autoRotate {
+				m.rotationY += 0.1
+			}
+		case key.Matches(msg, keys.Bigger):
+			m.scale = math.Min(m.scale+1, 20)
+		case key.Matches(msg, keys.Smaller):
+			m.scale = math.Max(m.scale-1, 2)
+		case key.Matches(msg, keys.RollLeft):
+			if !m.autoRotate {
+				m.rotationZ -= 0.1
+			}
+		case key.Matches(msg, keys.RollRight):
+			if !m.autoRotate {
+				m.rotationZ += 0.1
+			}
+		case key.Matches(msg, keys.Closer):
+			m.perspective = math.Max(m.perspective-0.5, 1)
+		case key.Matches(msg, keys.Farther):
+			m.perspective = math.Min(m.perspective+0.5, 10)
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper. The rotation keys are only listed
+// under manual control, when they do something.
+func (m model) KeyMap() keymap.Map {
+	k := keys
+	if !m.autoRotate {
+		k.Auto.SetHelp("a", "auto-rotate")
+	}
+	k.Up.SetEnabled(!m.autoRotate)
+	k.RollLeft.SetEnabled(!m.autoRotate)
+	return keymap.Of(k)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Purple).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🎲 3D Rotating Cube")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Yellow)
+	status := statusStyle.Render(fmt.Sprintf(
+		"Scale: %.0f | Perspective: %.1f | %s | %s",
+		m.scale, m.perspective,
+		map[bool]string{true: "Auto-rotating", false: "Manual control"}[m.autoRotate],
+		map[bool]string{true: "⏸ Paused", false: "🎲 Spinning"}[m.anim.Paused()],
+	))
+
+	// Create 3D visualization
+	screen := m.render3D()
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := m.KeyMap().String()
+
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s",
+		title, status, screen, helpStyle.Render(help))
+}
+
+func (m model) render3D() string {
+	// Create screen buffer
+	screen := canvas.New(m.width, m.height)
+
+	// Transform vertices
+	transformed := make([]point3D, len(m.vertices))
+	for i, v := range m.vertices {
+		// Apply rotations
+		transformed[i] = m.rotatePoint(v)
+	}
+
+	// Project to 2D and draw edges
+	projected := make([][2]int, len(transformed))
+	for i, v := range transformed {
+		projected[i] = m.project(v)
+	}
+
+	// Draw all edges
+	for _, edge := range m.edges {
+		start := projected[edge.start]
+		end := projected[edge.end]
+		// Choose character based on line direction
+		char := m.getLineChar(start[0], start[1], end[0], end[1])
+		draw.Line(screen, start[0], start[1], end[0], end[1], char, canvas.Style{Fg: common.Green})
+	}
+
+	// Draw vertices as dots
+	for i, p := range projected {
+		// Different colors for front and back vertices
+		style := canvas.Style{Fg: common.Blue}
+		if transformed[i].z > 0 {
+			style = canvas.Style{Fg: common.Red, Bold: true}
+		}
+		screen.Set(p[0], p[1], '●', style)
+	}
+
+	return screen.Render()
+}
+
+func (m model) rotatePoint(p point3D) point3D {
+	// Rotate around X axis
+	cosX, sinX := math.Cos(m.rotationX), math.Sin(m.rotationX)
+	y1 := p.y*cosX - p.z*sinX
+	z1 := p.y*sinX + p.z*cosX
+
+	// Rotate around Y axis
+	cosY, sinY := math.Cos(m.rotationY), math.Sin(m.rotationY)
+	x2 := p.x*cosY + z1*sinY
+	z2 := -p.x*sinY + z1*cosY
+
+	// Rotate around Z axis
+	cosZ, sinZ := math.Cos(m.rotationZ), math.Sin(m.rotationZ)
+	x3 := x2*cosZ - y1*sinZ
+	y3 := x2*sinZ + y1*cosZ
+
+	return point3D{x3, y3, z2}
+}
+
+func (m model) project(p point3D) [2]int {
+	// Perspective projection
+	distance := m.perspective + p.z
+	if distance <= 0.1 {
+		distance = 0.1
+	}
+
+	// Project to screen coordinates
+	screenX := (p.x * m.scale / distance) + float64(m.width)/2
+	screenY := (-p.y * m.scale / distance) + float64(m.height)/2
+
+	return [2]int{int(screenX), int(screenY)}
+}
+
+func (m model) getLineChar(x0, y0, x1, y1 int) rune {
+	// Determine line character based on direction
+	dx := x1 - x0
+	dy := y1 - y0
+
+	if abs(dx) > abs(dy) {
+		// More horizontal
+		return '─'
+	} else if abs(dy) > abs(dx) {
+		// More vertical
+		return '│'
+	} else {
+		// Diagonal
+		if (dx > 0 && dy > 0) || (dx < 0 && dy < 0) {
+			return '╲'
+		} else {
+			return '╱'
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
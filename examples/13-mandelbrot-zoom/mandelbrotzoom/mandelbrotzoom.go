@@ -0,0 +1,414 @@
+// Package mandelbrotzoom is an interactive fractal explorer with deep zoom.
+package mandelbrotzoom
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/keymap"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+//go:embed doc.md
+var doc string
+
+type complex128 struct {
+	real, imag float64
+}
+
+func (c complex128) add(other complex128) complex128 {
+	return complex128{c.real + other.real, c.imag + other.imag}
+}
+
+func (c complex128) mul(other complex128) complex128 {
+	return complex128{
+		c.real*other.real - c.imag*other.imag,
+		c.real*other.imag + c.imag*other.real,
+	}
+}
+
+func (c complex128) abs() float64 {
+	return math.Sqrt(c.real*c.real + c.imag*c.imag)
+}
+
+type model struct {
+	width      int
+	height     int
+	centerX    float64
+	centerY    float64
+	zoom       float64
+	maxIter    int
+	autoZoom   bool
+	zoomTarget complex128
+	anim       engine.Animator
+	res        canvas.Resolution
+	screen     *canvas.Canvas
+	pixels     *canvas.Pixels
+}
+
+type keyMap struct {
+	Auto    key.Binding
+	Up      key.Binding
+	Down    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	ZoomIn  key.Binding
+	ZoomOut key.Binding
+	Target  key.Binding
+	More    key.Binding
+	Fewer   key.Binding
+	HiRes   key.Binding
+	keymap.Common
+}
+
+var keys = keyMap{
+	Auto:    keymap.New("a", "manual"),
+	Up:      keymap.New("↑↓←→", "move", "up"),
+	Down:    keymap.Hidden("down"),
+	Left:    keymap.Hidden("left"),
+	Right:   keymap.Hidden("right"),
+	ZoomIn:  keymap.New("+/-", "zoom", "+", "="),
+	ZoomOut: keymap.Hidden("-"),
+	Target:  keymap.New("1-4", "targets", "1", "2", "3", "4"),
+	More:    keymap.New("i/d", "iterations", "i"),
+	Fewer:   keymap.Hidden("d"),
+	HiRes:   keymap.New("h", "hi-res"),
+	Common:  keymap.Animated(),
+}
+
+// prefs are the settings kept between runs.
+type prefs struct {
+	Res      canvas.Resolution `json:"resolution"`
+	AutoZoom bool              `json:"autoZoom"`
+}
+
+func initialModel() model {
+	p := prefs{Res: canvas.Normal, AutoZoom: true}
+	settings.Load("mandelbrot", &p)
+
+	return model{
+		width:      80,
+		height:     24,
+		centerX:    -0.75,
+		centerY:    0.1,
+		zoom:       1.0,
+		maxIter:    80,
+		autoZoom:   p.AutoZoom,
+		zoomTarget: complex128{-0.7463, 0.1102}, // Interesting zoom point on boundary
+		anim:       engine.New(15),
+		screen:     canvas.New(80, 24),
+		res:        p.Res,
+		pixels:     canvas.NewPixels(p.Res, 80, 24),
+	}
+}
+
+// New returns the demo's model, ready for engine.Run.
+func New() tea.Model {
+	return initialModel()
+}
+
+func init() {
+	registry.Register(registry.Info{
+		Dir:      "13-mandelbrot-zoom",
+		Heading:  "🌀 Mandelbrot Zoom",
+		Summary:  "Interactive fractal explorer with infinite zoom",
+		Section:  registry.Examples,
+		Keywords: []string{"fractal", "math", "zoom"},
+		Manual:   doc,
+		Build:    New,
+	})
+}
+
+// Settings implements settings.Saver.
+func (m model) Settings() (string, any) {
+	return "mandelbrot", prefs{Res: m.res, AutoZoom: m.autoZoom}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.anim.Tick()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height - 4
+		m.screen.Resize(m.width, m.height)
+		m.pixels.Resize(m.width, m.height)
+		return m, nil
+
+	case engine.TickMsg:
+		cmd, ok := m.anim.Update(msg)
+		if ok && m.autoZoom {
+			// Gradually zoom into the target point
+			m.zoom *= 1.03
+			// Gradually move toward the zoom target
+			factor := 0.01
+			m.centerX += (m.zoomTarget.real - m.centerX) * factor
+			m.centerY += (m.zoomTarget.imag - m.centerY) * factor
+			
+			// Increase iterations as we zoom deeper for more detail
+			if m.zoom > 100 && m.maxIter < 150 {
+				m.maxIter++
+			}
+			
+			// Reset if zoom gets too high
+			if m.zoom > 1e15 {
+				m.zoom = 1.0
+				m.centerX = -0.75
+				m.centerY = 0.1
+				m.maxIter = 80
+			}
+		}
+		return m, cmd
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Pause):
+			m.anim.Toggle()
+		case key.Matches(msg, keys.Auto):
+			m.autoZoom = !m.autoZoom
+		case key.Matches(msg, keys.HiRes):
+			m.res = m.res.Next()
+			m.pixels.SetResolution(m.res)
+		case key.Matches(msg, keys.Reset):
+			m.centerX = -0.75
+			m.centerY = 0.1
+			m.zoom = 1.0
+			m.maxIter = 80
+		case key.Matches(msg, keys.Up):
+			if !m.autoZoom {
+				m.centerY -= 0.1 / m.zoom
+			}
+		case key.Matches(msg, keys.Down):
+			if !m.autoZoom {
+				m.centerY += 0.1 / m.zoom
+			}
+		case key.Matches(msg, keys.Left):
+			if !m.autoZoom {
+				m.centerX -= 0.1 / m.zoom
+			}
+		case key.Matches(msg, keys.Right):
+			if !m.autoZoom {
+				m.centerX += 0.1 / m.zoom
+			}
+		case key.Matches(msg, keys.ZoomIn):
+			if !m.autoZoom {
+				m.zoom *= 1.2
+			}
+		case key.Matches(msg, keys.ZoomOut):
+			if !m.autoZoom {
+				m.zoom /= 1.2
+				if m.zoom < 0.1 {
+					m.zoom = 0.1
+				}
+			}
+		case key.Matches(msg, keys.Target):
+			switch msg.String() {
+			case "1":
+				// Interesting boundary area with spirals
+				m.zoomTarget = complex128{-0.7463, 0.1102}
+				m.centerX = -0.75
+				m.centerY = 0.1
+				m.zoom = 1.0
+				m.maxIter = 80
+			case "2":
+				// Edge of the main bulb
+				m.zoomTarget = complex128{-0.16, 1.0405}
+				m.centerX = -0.2
+				m.centerY = 1.0
+				m.zoom = 1.0
+				m.maxIter = 80
+			case "3":
+				// Seahorse valley
+				m.zoomTarget = complex128{-0.74529, 0.11307}
+				m.centerX = -0.75
+				m.centerY = 0.11
+				m.zoom = 1.0
+				m.maxIter = 80
+			case "4":
+				// Feather location
+				m.zoomTarget = complex128{-0.235125, 0.827215}
+				m.centerX = -0.24
+				m.centerY = 0.83
+				m.zoom = 1.0
+				m.maxIter = 80
+			}
+		case key.Matches(msg, keys.More):
+			m.maxIter = min(m.maxIter+10, 200)
+		case key.Matches(msg, keys.Fewer):
+			m.maxIter = max(m.maxIter-10, 20)
+		}
+	}
+
+	return m, nil
+}
+
+// KeyMap implements engine.KeyMapper. Moving and zooming are only listed
+// under manual control, when they do something.
+func (m model) KeyMap() keymap.Map {
+	k := keys
+	if !m.autoZoom {
+		k.Auto.SetHelp("a", "auto-zoom")
+	}
+	k.Up.SetEnabled(!m.autoZoom)
+	k.ZoomIn.SetEnabled(!m.autoZoom)
+	return keymap.Of(k)
+}
+
+func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(lipgloss.Color("#663399")).
+		Padding(0, 1)
+
+	title := titleStyle.Render("🌀 Mandelbrot Fractal Zoom")
+
+	// Status
+	statusStyle := lipgloss.NewStyle().Foreground(common.Purple)
+	status := statusStyle.Render(fmt.Sprintf(
+		"Center: (%.6f, %.6f) | Zoom: %.2e | Iterations: %d | Res: %s | %s | %s",
+		m.centerX, m.centerY, m.zoom, m.maxIter, m.res,
+		map[bool]string{true: "Auto-zooming", false: "Manual control"}[m.autoZoom],
+		map[bool]string{true: "⏸ Paused", false: "🌀 Exploring"}[m.anim.Paused()],
+	))
+
+	// Render fractal
+	fractal := m.renderMandelbrot()
+
+	// Help
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := m.KeyMap().String()
+
+	return fmt.Sprintf("%s\n%s\n\n%s\n%s",
+		title, status, fractal, helpStyle.Render(help))
+}
+
+func (m model) renderMandelbrot() string {
+	// Calculate the complex plane bounds
+	aspect := float64(m.width) / float64(m.height) * 2.0 // Adjust for character aspect ratio
+	scale := 3.0 / m.zoom
+	
+	minX := m.centerX - scale*aspect/2
+	maxX := m.centerX + scale*aspect/2
+	minY := m.centerY - scale/2
+	maxY := m.centerY + scale/2
+	
+	if m.res != canvas.Normal {
+		return m.renderPixels(minX, maxX, minY, maxY)
+	}
+	
+	for y := 0; y < m.height; y++ {
+		for x := 0; x < m.width; x++ {
+			// Map pixel to complex plane
+			cx := minX + float64(x)*(maxX-minX)/float64(m.width)
+			cy := maxY - float64(y)*(maxY-minY)/float64(m.height) // Flip Y axis
+			
+			// Calculate iterations for this point
+			iterations := m.mandelbrotIterations(complex128{cx, cy})
+			
+			// Convert to character and color
+			char, color := m.getPixelChar(iterations)
+			m.screen.SetString(x, y, char, canvas.Style{Fg: color})
+		}
+	}
+	
+	// Only rows that changed since the last frame are styled again
+	return m.screen.Render()
+}
+
+// renderPixels draws the same region at sub-cell resolution. Points inside the
+// set are left unlit.
+func (m model) renderPixels(minX, maxX, minY, maxY float64) string {
+	sx, sy := m.res.Scale()
+	m.pixels.Clear()
+	for py := 0; py < m.pixels.Height(); py++ {
+		for px := 0; px < m.pixels.Width(); px++ {
+			x := float64(px) / float64(sx)
+			y := float64(py) / float64(sy)
+			cx := minX + x*(maxX-minX)/float64(m.width)
+			cy := maxY - y*(maxY-minY)/float64(m.height)
+			
+			iterations := m.mandelbrotIterations(complex128{cx, cy})
+			if iterations < m.maxIter {
+				_, color := m.getPixelChar(iterations)
+				m.pixels.Set(px, py, color)
+			}
+		}
+	}
+	
+	m.screen.Clear()
+	m.pixels.Draw(m.screen)
+	return m.screen.Render()
+}
+
+func (m model) mandelbrotIterations(c complex128) int {
+	z := complex128{0, 0}
+	
+	for i := 0; i < m.maxIter; i++ {
+		if z.abs() > 2.0 {
+			return i
+		}
+		z = z.mul(z).add(c)
+	}
+	
+	return m.maxIter
+}
+
+func (m model) getPixelChar(iterations int) (string, lipgloss.Color) {
+	if iterations == m.maxIter {
+		// Point is in the Mandelbrot set - use black
+		return "█", lipgloss.Color("#000000")
+	}
+	
+	// Use a logarithmic scale for better detail at boundaries
+	logRatio := math.Log(float64(iterations+1)) / math.Log(float64(m.maxIter+1))
+	
+	if logRatio < 0.15 {
+		chars := []string{"█", "▓", "▒"}
+		return chars[iterations%len(chars)], lipgloss.Color("#FF0000") // Bright red
+	} else if logRatio < 0.3 {
+		chars := []string{"▒", "░", "▫"}
+		return chars[iterations%len(chars)], lipgloss.Color("#FF4400") // Red-orange
+	} else if logRatio < 0.45 {
+		chars := []string{"▫", "•", "◦"}
+		return chars[iterations%len(chars)], lipgloss.Color("#FF8800") // Orange
+	} else if logRatio < 0.6 {
+		chars := []string{"◦", "∘", "·"}
+		return chars[iterations%len(chars)], lipgloss.Color("#FFCC00") // Yellow
+	} else if logRatio < 0.75 {
+		chars := []string{"·", ".", " "}
+		return chars[iterations%len(chars)], lipgloss.Color("#88FF00") // Yellow-green
+	} else if logRatio < 0.85 {
+		return " ", lipgloss.Color("#00FF88") // Green
+	} else if logRatio < 0.95 {
+		return " ", lipgloss.Color("#0088FF") // Blue
+	} else {
+		return " ", lipgloss.Color("#8800FF") // Purple
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/hud"
+)
+
+// Styles of the dialogs drawn over the menu.
+
+func sheetStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(common.Accent).
+		Padding(0, 1)
+}
+
+func sheetTitleStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(common.Strong)
+}
+
+func sheetFaintStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(common.Subtle)
+}
+
+func sheetKeyStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(common.Highlight)
+}
+
+func sheetDescStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(common.Text)
+}
+
+// cheatSheetKey handles keys while the cheat sheet is open: enter runs the
+// demo, e opens its settings, F1 its page, and esc, "?" or q close the
+// sheet.
+func (m model) cheatSheetKey(keypress string) (model, tea.Cmd) {
+	switch keypress {
+	case "enter":
+		i := *m.keys
+		m.keys = nil
+		return m.launch(i)
+	case "e":
+		i := *m.keys
+		m.keys = nil
+		return m.openParams(i), nil
+	case "f1":
+		i := *m.keys
+		m.keys = nil
+		return m.openManual(i), nil
+	case "esc", "?", "q":
+		m.keys = nil
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// cheatSheet draws the open demo's keys over the menu, as the demo's own "?"
+// overlay lists them, so they can be learned before it starts.
+func (m model) cheatSheet(menu string) string {
+	i := m.keys
+	own, shared := i.keys(), engine.SharedKeys()
+	width := 0
+	for _, b := range append(own, shared...) {
+		width = max(width, lipgloss.Width(b.Help().Key))
+	}
+	row := func(b key.Binding) string {
+		h := b.Help()
+		pad := strings.Repeat(" ", width-lipgloss.Width(h.Key))
+		return sheetKeyStyle().Render(h.Key) + pad + "  " + sheetDescStyle().Render(h.Desc)
+	}
+
+	lines := []string{
+		sheetTitleStyle().Render(i.title),
+		sheetFaintStyle().Render(i.description),
+		sheetFaintStyle().Render(fmt.Sprintf("Needs at least %dx%d", i.minWidth, i.minHeight)),
+		"",
+	}
+	if len(own) == 0 {
+		lines = append(lines, sheetDescStyle().Render("No keys of its own"))
+	}
+	for _, b := range own {
+		lines = append(lines, row(b))
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("All demos"))
+	for _, b := range shared {
+		lines = append(lines, row(b))
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("[enter] Run • [e] Settings • [F1] About • [esc] Close"))
+
+	return m.dialog(menu, lines)
+}
+
+// dialog draws the lines in a box in the middle of the menu.
+func (m model) dialog(menu string, lines []string) string {
+	box := sheetStyle().Render(strings.Join(lines, "\n"))
+	x := max(0, (m.width-lipgloss.Width(box))/2)
+	y := max(0, (m.height-lipgloss.Height(box))/2)
+	return hud.Place(menu, box, x, y)
+}
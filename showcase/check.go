@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// colors is how many colors a terminal with the profile shows.
+func colors(p termcolor.Profile) int {
+	switch p {
+	case termcolor.TrueColor:
+		return 1 << 24
+	case termcolor.ANSI256:
+		return 256
+	case termcolor.ANSI16:
+		return 16
+	}
+	return 0
+}
+
+// shortfalls lists what the terminal lacks for the demo, if anything.
+func (m model) shortfalls(i item) []string {
+	var out []string
+	if m.width < i.minWidth || m.height < i.minHeight {
+		out = append(out, fmt.Sprintf("It needs %dx%d; this terminal is %dx%d.",
+			i.minWidth, i.minHeight, m.width, m.height))
+	}
+	if have := colors(m.profile); have < i.minColors {
+		shows := fmt.Sprintf("%d colors", have)
+		if have == 0 {
+			shows = "no colors"
+		}
+		out = append(out, fmt.Sprintf("It needs %d colors; this terminal shows %s.", i.minColors, shows))
+	}
+	return out
+}
+
+// launch runs the demo, unless the terminal falls short of what it needs,
+// in which case it asks first.
+func (m model) launch(i item) (model, tea.Cmd) {
+	if len(m.shortfalls(i)) > 0 {
+		m.warn = &i
+		return m, nil
+	}
+	m.choice = &i
+	return m, tea.Quit
+}
+
+// warningKey handles keys while the warning is open: enter runs the demo
+// anyway, and esc or q go back to the menu.
+func (m model) warningKey(keypress string) (model, tea.Cmd) {
+	switch keypress {
+	case "enter":
+		m.choice, m.warn = m.warn, nil
+		return m, tea.Quit
+	case "esc", "q":
+		m.warn = nil
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// warning draws what the terminal lacks over the menu. It is drawn afresh
+// as the terminal is resized, and says so once the demo fits.
+func (m model) warning(menu string) string {
+	i := m.warn
+	lines := []string{sheetTitleStyle().Render("⚠ " + i.title), ""}
+	short := m.shortfalls(*i)
+	for _, s := range short {
+		lines = append(lines, sheetDescStyle().Render(s))
+	}
+	if len(short) == 0 {
+		lines = append(lines, sheetDescStyle().Render("The terminal fits it now."))
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("[enter] Run anyway • [esc] Back"))
+	return m.dialog(menu, lines)
+}
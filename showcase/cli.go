@@ -0,0 +1,313 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+// command is the program name the completion scripts register for.
+const command = "showcase"
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, `Usage:
+  %[1]s [flags]                      open the menu
+  %[1]s [flags] run <demo> [flags]   run a demo straight away
+  %[1]s [flags] play [file]          play a playlist, the menu's by default
+  %[1]s [flags] timeline <file>      play a timeline script once through
+  %[1]s list [--json]                list the demos, or describe them in JSON
+  %[1]s docs [file]                  print the demo tables, or update them in a file
+  %[1]s ssh [-addr :2222]            serve the menu over SSH
+  %[1]s completion bash|zsh|fish     print a shell completion script
+
+Flags:
+`, command)
+	flag.PrintDefaults()
+}
+
+// subcommand runs the subcommand named by args[0] and returns the exit
+// status.
+func subcommand(args []string) int {
+	switch args[0] {
+	case "run":
+		return runDemo(args[1:])
+	case "play":
+		return playPlaylist(args[1:])
+	case "timeline":
+		return playTimeline(args[1:])
+	case "list":
+		return listCommand(args[1:])
+	case "docs":
+		return docs(args[1:])
+	case "ssh":
+		return serveSSH(args[1:])
+	case "completion":
+		return completion(args[1:])
+	}
+	fmt.Fprintf(os.Stderr, "%s: unknown command %q\n\n", command, args[0])
+	usage()
+	return 2
+}
+
+// findDemo looks a demo up by its short or directory name, ignoring case,
+// or else by a word of its short name that no other demo's has, so "cube"
+// finds rotating-cube.
+func findDemo(name string) (item, bool) {
+	if d, ok := registry.Lookup(name); ok {
+		return newItem(d), true
+	}
+	var found []registry.Demo
+	for _, d := range registry.All() {
+		for _, word := range strings.Split(registry.ShortName(d), "-") {
+			if strings.EqualFold(name, word) {
+				found = append(found, d)
+				break
+			}
+		}
+	}
+	if len(found) != 1 {
+		return item{}, false
+	}
+	return newItem(found[0]), true
+}
+
+// suggest returns up to three demo names that fuzzily match name, best
+// first.
+func suggest(name string) []string {
+	type match struct {
+		name  string
+		score int
+	}
+	var found []match
+	for _, d := range registry.All() {
+		short := registry.ShortName(d)
+		if score, _ := fuzzyMatch(name, short); score > 0 {
+			found = append(found, match{short, score})
+		}
+	}
+	sort.SliceStable(found, func(a, b int) bool { return found[a].score > found[b].score })
+	var names []string
+	for _, f := range found[:min(len(found), 3)] {
+		names = append(names, f.name)
+	}
+	return names
+}
+
+// runDemo runs the named demo without the menu. Engine flags may follow
+// the name as well as come before "run".
+func runDemo(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintf(os.Stderr, "%s run: name a demo; %s list shows them\n", command, command)
+		return 2
+	}
+	name := args[0]
+	// flag.Parse stopped at the subcommand, so parse what follows the name
+	flag.CommandLine.Parse(args[1:])
+	if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "%s run: unexpected arguments %s\n", command, strings.Join(flag.Args(), " "))
+		return 2
+	}
+
+	i, ok := findDemo(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s run: unknown demo %q\n", command, name)
+		if names := suggest(name); len(names) > 0 {
+			fmt.Fprintf(os.Stderr, "did you mean %s?\n", strings.Join(names, ", "))
+		}
+		fmt.Fprintf(os.Stderr, "%s list shows every demo\n", command)
+		return 2
+	}
+
+	opts := append([]tea.ProgramOption{engine.AltScreen()}, i.opts...)
+	start := time.Now()
+	final, err := engine.RunNamed(i.name, i.demo(), opts...)
+	h := loadHistory()
+	h.ran(i.name)
+	if err == nil {
+		h.leftOff(i.name, final)
+		err = recordRun(i.name, time.Since(start))
+	}
+	if serr := h.save(); serr != nil && err == nil {
+		err = serr
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// playPlaylist plays the playlist file named, or the menu's, until a key is
+// pressed.
+func playPlaylist(args []string) int {
+	if len(args) > 1 {
+		fmt.Fprintf(os.Stderr, "%s play: unexpected arguments %s\n", command, strings.Join(args[1:], " "))
+		return 2
+	}
+	path, err := playlistPath()
+	if len(args) == 1 {
+		path, err = args[0], nil
+	}
+	var entries []entry
+	if err == nil {
+		entries, err = loadPlaylist(path)
+	}
+	if err == nil && len(entries) == 0 {
+		err = fmt.Errorf("%s has no demos; add some with p in the menu", path)
+	}
+	if err == nil {
+		err = play(slots(entries, 0), true)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// listCommand lists the demos, as text or with --json as JSON.
+func listCommand(args []string) int {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "describe the demos in JSON")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "%s list: unexpected arguments %s\n", command, strings.Join(fs.Args(), " "))
+		return 2
+	}
+	if !*asJSON {
+		listDemos(os.Stdout)
+		return 0
+	}
+	if err := listJSON(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// demoJSON describes a demo for "showcase list --json".
+type demoJSON struct {
+	Name        string    `json:"name"` // as run takes it
+	Dir         string    `json:"dir"`
+	Title       string    `json:"title"`
+	Category    string    `json:"category"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	MinWidth    int       `json:"minWidth"`
+	MinHeight   int       `json:"minHeight"`
+	MinColors   int       `json:"minColors"`
+	Keys        []keyJSON `json:"keys"`
+}
+
+// keyJSON is one of a demo's keys: the keys themselves, and the help the
+// demo shows for them.
+type keyJSON struct {
+	Keys        []string `json:"keys"`
+	Help        string   `json:"help"`
+	Description string   `json:"description"`
+}
+
+// listJSON writes every demo as a JSON array, in the order list prints
+// them. Keys are the demo's own; the ones every demo shares are left out.
+func listJSON(w io.Writer) error {
+	demos := []demoJSON{}
+	for _, d := range registry.All() {
+		width, height := d.MinSize()
+		dj := demoJSON{
+			Name:        registry.ShortName(d),
+			Dir:         d.Name(),
+			Title:       d.Title(),
+			Category:    d.Category().String(),
+			Description: d.Description(),
+			Tags:        append([]string{}, d.Tags()...),
+			MinWidth:    width,
+			MinHeight:   height,
+			MinColors:   d.MinColors(),
+			Keys:        []keyJSON{},
+		}
+		for _, b := range d.Keybindings() {
+			dj.Keys = append(dj.Keys, keyJSON{Keys: b.Keys(), Help: b.Help().Key, Description: b.Help().Desc})
+		}
+		demos = append(demos, dj)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(demos)
+}
+
+// listDemos prints the demos by category, with the names run takes.
+func listDemos(w io.Writer) {
+	width := 0
+	for _, d := range registry.All() {
+		width = max(width, len(registry.ShortName(d)))
+	}
+	for n, s := range sections() {
+		if n > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, s.name)
+		for _, li := range s.items {
+			i := li.(item)
+			fmt.Fprintf(w, "  %-*s  %s\n", width, i.short, i.description)
+		}
+	}
+}
+
+// completion prints a script completing the subcommands and demo names for
+// the given shell.
+func completion(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "%s completion: name a shell: bash, zsh or fish\n", command)
+		return 2
+	}
+	var names []string
+	for _, d := range registry.All() {
+		names = append(names, registry.ShortName(d))
+	}
+	words := strings.Join(names, " ")
+
+	switch args[0] {
+	case "bash", "zsh":
+		if args[0] == "zsh" {
+			fmt.Println("autoload -U +X bashcompinit && bashcompinit")
+		}
+		fmt.Printf(`_%[1]s() {
+	local cur=${COMP_WORDS[COMP_CWORD]}
+	case $COMP_CWORD in
+	1) COMPREPLY=($(compgen -W "run play timeline list docs ssh completion" -- "$cur")) ;;
+	2) case ${COMP_WORDS[1]} in
+		run) COMPREPLY=($(compgen -W "%[2]s" -- "$cur")) ;;
+		play|timeline) COMPREPLY=($(compgen -f -- "$cur")) ;;
+		list) COMPREPLY=($(compgen -W "--json" -- "$cur")) ;;
+		completion) COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur")) ;;
+		esac ;;
+	esac
+}
+complete -F _%[1]s %[1]s
+`, command, words)
+	case "fish":
+		fmt.Printf(`complete -c %[1]s -f
+complete -c %[1]s -n __fish_use_subcommand -a "run play timeline list docs ssh completion"
+complete -c %[1]s -n "__fish_seen_subcommand_from play timeline docs" -F
+complete -c %[1]s -n "__fish_seen_subcommand_from run" -a "%[2]s"
+complete -c %[1]s -n "__fish_seen_subcommand_from list" -l json -d "describe the demos in JSON"
+complete -c %[1]s -n "__fish_seen_subcommand_from completion" -a "bash zsh fish"
+`, command, words)
+	default:
+		fmt.Fprintf(os.Stderr, "%s completion: unknown shell %q; use bash, zsh or fish\n", command, args[0])
+		return 2
+	}
+	return 0
+}
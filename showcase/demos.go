@@ -0,0 +1,31 @@
+// Code generated by gendemos; DO NOT EDIT.
+
+package main
+
+// Every demo, imported so it registers itself and is compiled in.
+import (
+	_ "github.com/yourusername/bubbletea-showcase/bubbles/01-textinput/textinput"
+	_ "github.com/yourusername/bubbletea-showcase/bubbles/02-textarea/textarea"
+	_ "github.com/yourusername/bubbletea-showcase/bubbles/03-table/table"
+	_ "github.com/yourusername/bubbletea-showcase/bubbles/04-viewport/viewport"
+	_ "github.com/yourusername/bubbletea-showcase/bubbles/05-filepicker/filepicker"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/01-plasma/plasma"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/02-tunnel/tunnel"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/03-metaballs/metaballs"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/04-rotozoom/rotozoom"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/05-scroller/scroller"
+	_ "github.com/yourusername/bubbletea-showcase/demoscene/06-vaporwave/vaporwave"
+	_ "github.com/yourusername/bubbletea-showcase/examples/01-wave-animation/waveanimation"
+	_ "github.com/yourusername/bubbletea-showcase/examples/02-particle-system/particlesystem"
+	_ "github.com/yourusername/bubbletea-showcase/examples/03-loading-spinners/loadingspinners"
+	_ "github.com/yourusername/bubbletea-showcase/examples/04-progress-animations/progressanimations"
+	_ "github.com/yourusername/bubbletea-showcase/examples/05-matrix-rain/matrixrain"
+	_ "github.com/yourusername/bubbletea-showcase/examples/06-bouncing-ball/bouncingball"
+	_ "github.com/yourusername/bubbletea-showcase/examples/07-starfield/starfield"
+	_ "github.com/yourusername/bubbletea-showcase/examples/08-audio-visualizer/audiovisualizer"
+	_ "github.com/yourusername/bubbletea-showcase/examples/09-fire-effect/fireeffect"
+	_ "github.com/yourusername/bubbletea-showcase/examples/10-fluid-simulation/fluidsimulation"
+	_ "github.com/yourusername/bubbletea-showcase/examples/11-rotating-cube/rotatingcube"
+	_ "github.com/yourusername/bubbletea-showcase/examples/12-game-of-life/gameoflife"
+	_ "github.com/yourusername/bubbletea-showcase/examples/13-mandelbrot-zoom/mandelbrotzoom"
+)
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+)
+
+// demos.go is generated first, so the tables list every demo in the tree
+//go:generate go run ./internal/gendemos ..
+//go:generate go run . docs ../README.md
+
+// The generated demo tables replace whatever lies between these markers in
+// the file given to "showcase docs".
+const (
+	docsBegin = "<!-- demos:begin -->"
+	docsEnd   = "<!-- demos:end -->"
+)
+
+// writeDocs writes a markdown table of the registered demos per category.
+func writeDocs(w io.Writer) {
+	cell := strings.NewReplacer("|", `\|`).Replace
+	for n, c := range registry.Categories {
+		if n > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "### %s\n\n", c)
+		fmt.Fprintln(w, "| Demo | Run | Description | Needs | Keys |")
+		fmt.Fprintln(w, "|------|-----|-------------|-------|------|")
+		for _, d := range registry.In(c) {
+			var keys []string
+			for _, b := range d.Keybindings() {
+				keys = append(keys, fmt.Sprintf("`%s` %s", b.Help().Key, b.Help().Desc))
+			}
+			width, height := d.MinSize()
+			fmt.Fprintf(w, "| %s | `%s run %s` | %s | %dx%d, %d colors | %s |\n",
+				cell(d.Title()), command, registry.ShortName(d), cell(d.Description()),
+				width, height, d.MinColors(), cell(strings.Join(keys, ", ")))
+		}
+	}
+}
+
+// docs prints the demo tables, or with a file name rewrites them between the
+// markers in that file.
+func docs(args []string) int {
+	switch len(args) {
+	case 0:
+		writeDocs(os.Stdout)
+		return 0
+	case 1:
+	default:
+		fmt.Fprintf(os.Stderr, "%s docs: unexpected arguments %s\n", command, strings.Join(args[1:], " "))
+		return 2
+	}
+	if err := updateDocs(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func updateDocs(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	text := string(data)
+	begin := strings.Index(text, docsBegin)
+	end := strings.Index(text, docsEnd)
+	if begin < 0 || end < begin {
+		return fmt.Errorf("%s: no %s ... %s markers", path, docsBegin, docsEnd)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(text[:begin+len(docsBegin)])
+	buf.WriteString("\n")
+	writeDocs(&buf)
+	buf.WriteString(text[end:])
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
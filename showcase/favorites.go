@@ -0,0 +1,132 @@
+package main
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+// The tabs before the categories list demos from the history and the
+// playlist.
+const (
+	favoritesTab = iota
+	recentTab
+	playlistTab
+	firstCategory
+)
+
+// recentMax is how many recently run demos are remembered.
+const recentMax = 5
+
+// history is what the launcher remembers between runs, by demo directory
+// name. It is kept in the settings file under "showcase":
+//
+//	{"showcase": {"favorites": ["01-plasma"], "recent": ["06-vaporwave", "01-plasma"],
+//	  "last": {"demo": "06-vaporwave", "settings": {"mode": 1, "speed": 1.2}}}}
+type history struct {
+	Favorites []string `json:"favorites"`
+	Recent    []string `json:"recent"`
+	Last      *lastRun `json:"last,omitempty"`
+}
+
+func loadHistory() history {
+	var h history
+	settings.Load("showcase", &h)
+	return h
+}
+
+func (h history) save() error {
+	return settings.Save("showcase", h)
+}
+
+func (h history) favorite(name string) bool {
+	return slices.Contains(h.Favorites, name)
+}
+
+// toggle stars the demo, or unstars it if it was starred. The slices are
+// copied rather than changed in place, since copies of the model share them.
+func (h *history) toggle(name string) {
+	if i := slices.Index(h.Favorites, name); i >= 0 {
+		h.Favorites = slices.Delete(slices.Clone(h.Favorites), i, i+1)
+	} else {
+		h.Favorites = append(slices.Clone(h.Favorites), name)
+	}
+}
+
+// ran moves the demo to the front of the recently run ones.
+func (h *history) ran(name string) {
+	recent := []string{name}
+	for _, r := range h.Recent {
+		if r != name && len(recent) < recentMax {
+			recent = append(recent, r)
+		}
+	}
+	h.Recent = recent
+}
+
+// toggleFavorite stars or unstars the demo and saves the history.
+func (m model) toggleFavorite(name string) (model, tea.Cmd) {
+	m.history.toggle(name)
+	m.err = m.history.save()
+	return m.refreshHistory()
+}
+
+// ran records a run of the demo and saves the history.
+func (m model) ran(name string) model {
+	m.history.ran(name)
+	m.err = m.history.save()
+	// Only the Recent tab changes, and its search is reset, so no command
+	// is left to run
+	m, _ = m.refreshHistory()
+	return m
+}
+
+// refreshHistory marks the starred demos in the playlist and category tabs
+// and fills the Favorites and Recent tabs from the history.
+func (m model) refreshHistory() (model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for t := playlistTab; t < len(m.tabs); t++ {
+		l := &m.tabs[t].list
+		for idx, li := range l.Items() {
+			switch i := li.(type) {
+			case item:
+				if fav := m.history.favorite(i.name); fav != i.favorite {
+					i.favorite = fav
+					cmds = append(cmds, m.tabCmd(t, l.SetItem(idx, i)))
+				}
+			case entry:
+				if fav := m.history.favorite(i.name); fav != i.favorite {
+					i.favorite = fav
+					cmds = append(cmds, m.tabCmd(t, l.SetItem(idx, i)))
+				}
+			}
+		}
+	}
+	m.setHistoryTab(favoritesTab, m.history.Favorites)
+	m.setHistoryTab(recentTab, m.history.Recent)
+	return m, tea.Batch(cmds...)
+}
+
+// setHistoryTab lists the named demos, in order, in one of the tabs before
+// the categories. A search in the tab is cleared rather than run again over
+// the new items. Names no longer in the menu are skipped.
+func (m *model) setHistoryTab(t int, names []string) {
+	var items []list.Item
+	for _, name := range names {
+		for _, c := range m.tabs[firstCategory:] {
+			for _, li := range c.list.Items() {
+				if li.(item).name == name {
+					items = append(items, li)
+				}
+			}
+		}
+	}
+	l := &m.tabs[t].list
+	l.ResetFilter()
+	l.SetItems(items)
+	if n := len(items); l.Index() >= n && n > 0 {
+		l.Select(n - 1)
+	}
+}
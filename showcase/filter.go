@@ -0,0 +1,103 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// Fields of an item's filter value, separated by newlines, and how much a
+// match in each counts.
+const (
+	titleField = iota
+	descriptionField
+	tagsField
+)
+
+var fieldWeights = [...]int{titleField: 3, descriptionField: 1, tagsField: 2}
+
+// fuzzyFilter ranks menu items for the list's search. Each item's filter
+// value holds its title, description and tags; the term is matched against
+// each and the best weighted score wins, so "frac" finds the Mandelbrot demo
+// through its "fractal" tag. Only title matches are highlighted, since the
+// list draws highlights on the title.
+func fuzzyFilter(term string, targets []string) []list.Rank {
+	type scored struct {
+		rank  list.Rank
+		score int
+	}
+	var found []scored
+	for i, target := range targets {
+		best, highlight := 0, []int(nil)
+		for f, field := range strings.Split(target, "\n") {
+			score, matched := fuzzyMatch(term, field)
+			if score == 0 {
+				continue
+			}
+			if f < len(fieldWeights) {
+				score *= fieldWeights[f]
+			}
+			if score > best {
+				best = score
+				highlight = nil
+				if f == titleField {
+					highlight = matched
+				}
+			}
+		}
+		if best > 0 {
+			found = append(found, scored{list.Rank{Index: i, MatchedIndexes: highlight}, best})
+		}
+	}
+	sort.SliceStable(found, func(a, b int) bool { return found[a].score > found[b].score })
+	ranks := make([]list.Rank, len(found))
+	for i, f := range found {
+		ranks[i] = f.rank
+	}
+	return ranks
+}
+
+// fuzzyMatch scores text for containing the runes of term in order,
+// ignoring case, and returns the rune indexes matched. Runs of consecutive
+// runes and matches at the start of a word score higher. Zero means no
+// match. Each occurrence of the first rune is tried as a starting point and
+// the best is kept.
+func fuzzyMatch(term, text string) (int, []int) {
+	t := []rune(strings.ToLower(term))
+	s := []rune(strings.ToLower(text))
+	if len(t) == 0 {
+		return 0, nil
+	}
+	best, bestIdx := 0, []int(nil)
+	for start, r := range s {
+		if r != t[0] {
+			continue
+		}
+		score, idx := 0, make([]int, 0, len(t))
+		j := start
+		for _, want := range t {
+			for j < len(s) && s[j] != want {
+				j++
+			}
+			if j == len(s) {
+				score = 0
+				break
+			}
+			score++
+			if len(idx) > 0 && idx[len(idx)-1] == j-1 {
+				score += 3
+			}
+			if j == 0 || !unicode.IsLetter(s[j-1]) && !unicode.IsDigit(s[j-1]) {
+				score += 5
+			}
+			idx = append(idx, j)
+			j++
+		}
+		if score > best {
+			best, bestIdx = score, idx
+		}
+	}
+	return best, bestIdx
+}
@@ -0,0 +1,71 @@
+// Command gendemos writes the showcase's demos.go, which imports every demo
+// package for its side effects so that each registers itself and is compiled
+// into the one binary. It finds the demos by their place in the tree, a
+// package in each numbered directory of examples, demoscene and bubbles:
+//
+//	go run ./internal/gendemos ..           # from showcase, as go generate does
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const module = "github.com/yourusername/bubbletea-showcase"
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: gendemos <module root>")
+		os.Exit(2)
+	}
+	if err := generate(os.Args[1], "demos.go"); err != nil {
+		fmt.Fprintf(os.Stderr, "gendemos: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate(root, out string) error {
+	var pkgs []string
+	for _, category := range []string{"examples", "demoscene", "bubbles"} {
+		files, err := filepath.Glob(filepath.Join(root, category, "[0-9][0-9]-*", "*", "*.go"))
+		if err != nil {
+			return err
+		}
+		seen := map[string]bool{}
+		for _, f := range files {
+			dir, err := filepath.Rel(root, filepath.Dir(f))
+			if err != nil {
+				return err
+			}
+			if !seen[dir] {
+				seen[dir] = true
+				pkgs = append(pkgs, module+"/"+filepath.ToSlash(dir))
+			}
+		}
+	}
+	if len(pkgs) == 0 {
+		return fmt.Errorf("no demos under %s", root)
+	}
+	sort.Strings(pkgs)
+
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by gendemos; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package main")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// Every demo, imported so it registers itself and is compiled in.")
+	fmt.Fprintln(&b, "import (")
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "\t_ %q\n", pkg)
+	}
+	fmt.Fprintln(&b, ")")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
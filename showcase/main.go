@@ -1,255 +1,592 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"math/rand"
 	"os"
-	"os/exec"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/registry"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+	"github.com/yourusername/bubbletea-showcase/common/theme"
+	"github.com/yourusername/bubbletea-showcase/common/transition"
 )
 
+// item is a menu entry, copied from the demo's registry entry.
 type item struct {
 	title       string
 	description string
-	command     string
+	name        string              // the demo's directory, naming its screenshots
+	short       string              // the name the command line takes
+	tags        []string            // more words the search matches
+	doc         string              // the demo's page, in markdown
+	demo        func() tea.Model    // builds the demo's model
+	keys        func() []key.Binding
+	minWidth    int
+	minHeight   int
+	minColors   int
+	opts        []tea.ProgramOption // needed besides the alternate screen
+	favorite    bool
+}
+
+func newItem(d registry.Demo) item {
+	i := item{
+		title:       d.Title(),
+		description: d.Description(),
+		name:        d.Name(),
+		short:       registry.ShortName(d),
+		tags:        d.Tags(),
+		doc:         d.Doc(),
+		demo:        d.New,
+		keys:        d.Keybindings,
+		opts:        d.Options(),
+	}
+	i.minWidth, i.minHeight = d.MinSize()
+	i.minColors = d.MinColors()
+	return i
+}
+
+func (i item) Title() string {
+	if i.favorite {
+		return i.title + " ★"
+	}
+	return i.title
 }
 
-func (i item) Title() string       { return i.title }
 func (i item) Description() string { return i.description }
-func (i item) FilterValue() string { return i.title }
+
+// FilterValue gives fuzzyFilter the title, description and tags, one per
+// line.
+func (i item) FilterValue() string {
+	return i.title + "\n" + i.description + "\n" + strings.Join(i.tags, " ")
+}
+
+// category is a tab of the menu. Each has its own list, so the cursor and
+// search of one are kept while looking at another.
+type category struct {
+	name string
+	list list.Model
+}
 
 type model struct {
-	list   list.Model
-	choice string
+	tabs    []category
+	active  int
+	history history
+	preview preview
+	width   int
+	height  int
+	keys    *item // the demo whose cheat sheet is open
+	warn    *item // the demo the terminal falls short for, awaiting a yes
+	themes  *themePicker // the open theme dialog
+	params  *paramForm   // the open settings panel
+	stats   map[string]demoStats // the figures of the open stats screen
+	page    *docPage // the demo whose page is open, in place of the menu
+	fade    *transition.Player // into the menu from the demo that just quit
+	profile termcolor.Profile // the colors the terminal shows, as the demos' canvases see it
+	choice  *item
+	pair    *item    // the first demo picked for a split, awaiting the second
+	split   *[2]item // two demos to run side by side
+	show    []slot // a show to play: attract mode or the playlist
+	err     error // from the last demo run
+
+	lastClick click // to tell a double click on a demo
+
+	// fixedTheme turns t off, for SSH visitors, who share the host's colors
+	fixedTheme bool
+}
+
+// tabMsg carries a message produced by a tab's list back to that list, so
+// search results can't land on a tab the user has since switched to.
+type tabMsg struct {
+	tab int
+	msg tea.Msg
+}
+
+// The menu's styles are built as they are used, so they follow the theme.
+
+func titleStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Accent).
+		Padding(1, 2)
+}
+
+func activeTabStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Bold(true).
+		Foreground(common.Title).
+		Background(common.Accent).
+		Padding(0, 1)
+}
+
+func tabStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(common.Subtle).
+		Padding(0, 1)
+}
+
+// section is a category of demos, shown as a tab of the menu.
+type section struct {
+	name  string
+	items []list.Item
+	// visual demos play by themselves, so attract mode shows them
+	visual bool
+}
+
+// sections returns every registered demo, by category.
+func sections() []section {
+	var all []section
+	for _, c := range registry.Categories {
+		s := section{name: c.String(), visual: c.Visual()}
+		for _, d := range registry.In(c) {
+			s.items = append(s.items, newItem(d))
+		}
+		all = append(all, s)
+	}
+	return all
 }
 
 func initialModel() model {
-	items := []list.Item{
-		item{
-			title:       "🌊 Wave Animation",
-			description: "Smooth sine wave animations with multiple layers",
-			command:     "examples/01-wave-animation/main.go",
-		},
-		item{
-			title:       "✨ Particle System",
-			description: "Dynamic particle effects with physics simulation",
-			command:     "examples/02-particle-system/main.go",
-		},
-		item{
-			title:       "🔄 Loading Spinners",
-			description: "Collection of various animated loading indicators",
-			command:     "examples/03-loading-spinners/main.go",
-		},
-		item{
-			title:       "📊 Progress Animations",
-			description: "Different styles of animated progress bars",
-			command:     "examples/04-progress-animations/main.go",
-		},
-		item{
-			title:       "💻 Matrix Rain",
-			description: "The classic Matrix digital rain effect",
-			command:     "examples/05-matrix-rain/main.go",
-		},
-		item{
-			title:       "🏀 Bouncing Ball",
-			description: "Physics-based ball animation with trails",
-			command:     "examples/06-bouncing-ball/main.go",
-		},
-		item{
-			title:       "⭐ Starfield",
-			description: "3D starfield simulation with depth perception",
-			command:     "examples/07-starfield/main.go",
-		},
-		item{
-			title:       "🎵 Audio Visualizer",
-			description: "Simulated audio spectrum visualization",
-			command:     "examples/08-audio-visualizer/main.go",
+	favorites, recent, playlist := newList(nil), newList(nil), newList(nil)
+	favorites.SetStatusBarItemName("favorite", "favorites")
+	recent.SetStatusBarItemName("recent demo", "recent demos")
+	playlist.SetStatusBarItemName("entry", "entries")
+	// The playlist's keys edit it by index, which a search would throw off
+	playlist.SetFilteringEnabled(false)
+	m := model{
+		tabs: []category{
+			favoritesTab: {name: "★ Favorites", list: favorites},
+			recentTab:    {name: "Recent", list: recent},
+			playlistTab:  {name: "Playlist", list: playlist},
 		},
+		active:  firstCategory,
+		history: loadHistory(),
+		profile: canvas.ColorProfile(),
+	}
+	for _, s := range sections() {
+		m.tabs = append(m.tabs, category{name: s.name, list: newList(s.items)})
+	}
+	path, err := playlistPath()
+	if err == nil {
+		var entries []entry
+		entries, err = loadPlaylist(path)
+		m.showPlaylist(entries, 0)
+	}
+	m.err = err
+	if len(m.history.Favorites) > 0 {
+		m.active = favoritesTab
 	}
+	m, _ = m.refreshHistory()
+	return m
+}
 
-	// Add impressive animations section
-	items = append(items,
-		item{
-			title:       "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━",
-			description: "Advanced Animations - Impressive Visual Effects",
-			command:     "",
-		},
-		item{
-			title:       "🔥 Fire Effect",
-			description: "Realistic fire simulation with heat propagation",
-			command:     "examples/09-fire-effect/main.go",
-		},
-		item{
-			title:       "💧 Fluid Simulation",
-			description: "Water droplets with ripples and physics",
-			command:     "examples/10-fluid-simulation/main.go",
-		},
-		item{
-			title:       "🎲 3D Rotating Cube",
-			description: "Real-time 3D wireframe cube with perspective",
-			command:     "examples/11-rotating-cube/main.go",
-		},
-		item{
-			title:       "🧬 Game of Life",
-			description: "Conway's cellular automata with famous patterns",
-			command:     "examples/12-game-of-life/main.go",
-		},
-		item{
-			title:       "🌀 Mandelbrot Zoom",
-			description: "Interactive fractal explorer with infinite zoom",
-			command:     "examples/13-mandelbrot-zoom/main.go",
-		},
+// newList builds the list of one tab. Left and right switch tabs, so paging
+// keeps only its other keys.
+func newList(items []list.Item) list.Model {
+	l := list.New(items, newDelegate(), 80, 20)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.Filter = fuzzyFilter
+	l.KeyMap.PrevPage = key.NewBinding(
+		key.WithKeys("pgup", "b", "u"),
+		key.WithHelp("pgup", "prev page"),
 	)
-
-	// Add separator and Demoscene effects section
-	items = append(items, 
-		item{
-			title:       "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━",
-			description: "Demoscene Effects - Classic Computer Graphics",
-			command:     "",
-		},
-		item{
-			title:       "🌈 Plasma Effect",
-			description: "Classic demoscene plasma with multiple color palettes",
-			command:     "demoscene/01-plasma/main.go",
-		},
-		item{
-			title:       "🕳️ Tunnel Effect",
-			description: "Hypnotic tunnel with 4 different rendering modes",
-			command:     "demoscene/02-tunnel/main.go",
-		},
-		item{
-			title:       "🫧 Metaballs",
-			description: "Organic metaball simulation with field visualization",
-			command:     "demoscene/03-metaballs/main.go",
-		},
-		item{
-			title:       "🌀 Rotozoom",
-			description: "Rotating and zooming patterns with 5 different styles",
-			command:     "demoscene/04-rotozoom/main.go",
-		},
-		item{
-			title:       "📜 Scroller",
-			description: "Demoscene text scroller with bitmap fonts and effects",
-			command:     "demoscene/05-scroller/main.go",
-		},
-		item{
-			title:       "🌆 Vaporwave",
-			description: "Retro synthwave landscape with neon grid and floating shapes",
-			command:     "demoscene/06-vaporwave/main.go",
-		},
+	l.KeyMap.NextPage = key.NewBinding(
+		key.WithKeys("pgdown", "f", "d"),
+		key.WithHelp("pgdn", "next page"),
 	)
+	// "?" opens the highlighted demo's cheat sheet instead of the list's
+	// full help
+	l.KeyMap.ShowFullHelp.SetEnabled(false)
+	l.KeyMap.CloseFullHelp.SetEnabled(false)
+	return l
+}
 
-	// Add separator and Bubbles components section
-	items = append(items, 
-		item{
-			title:       "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━",
-			description: "Bubbles Components - Interactive UI Elements",
-			command:     "",
-		},
-		item{
-			title:       "📝 Text Input",
-			description: "Form inputs with validation and custom styling",
-			command:     "bubbles/01-textinput/main.go",
-		},
-		item{
-			title:       "📄 Textarea",
-			description: "Multi-line text editor with preview mode",
-			command:     "bubbles/02-textarea/main.go",
-		},
-		item{
-			title:       "📊 Table",
-			description: "Interactive data table with sorting and selection",
-			command:     "bubbles/03-table/main.go",
-		},
-		item{
-			title:       "📜 Viewport",
-			description: "Scrollable content container for large documents",
-			command:     "bubbles/04-viewport/main.go",
-		},
-		item{
-			title:       "📁 File Picker",
-			description: "File browser with filtering and navigation",
-			command:     "bubbles/05-filepicker/main.go",
-		},
-	)
+// newDelegate draws a list's items in the theme's colors. The lists are given
+// a new one when the theme changes.
+func newDelegate() list.DefaultDelegate {
+	d := list.NewDefaultDelegate()
+	s := &d.Styles
+	s.NormalTitle = s.NormalTitle.Foreground(common.Text)
+	s.NormalDesc = s.NormalDesc.Foreground(common.Muted)
+	s.SelectedTitle = s.SelectedTitle.Foreground(common.Accent).BorderForeground(common.Accent)
+	s.SelectedDesc = s.SelectedDesc.Foreground(common.Accent).BorderForeground(common.Accent)
+	s.DimmedTitle = s.DimmedTitle.Foreground(common.Muted)
+	s.DimmedDesc = s.DimmedDesc.Foreground(common.Faint)
+	return d
+}
 
-	l := list.New(items, list.NewDefaultDelegate(), 80, 20)
-	l.Title = "🫧 Bubble Tea Showcase"
-	l.SetShowStatusBar(false)
-	l.SetFilteringEnabled(false)
-	l.Styles.Title = lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(lipgloss.Color("#7D56F4")).
-		Padding(1, 2).
-		MarginBottom(1)
+// header is the title, with the theme beside it, the demo to continue with
+// and the tab bar above the list. Only the open tab counts its demos, so the
+// bar fits in 80 columns.
+func (m model) header() string {
+	tabs := m.tabBar()
+	title := titleStyle().Render("🫧 Bubble Tea Showcase")
+	if !m.fixedTheme {
+		title = lipgloss.JoinHorizontal(lipgloss.Top, title,
+			tabStyle().PaddingTop(1).Render("[t]heme: "+theme.Current().Name))
+	}
+	return title + "\n" + m.continueLine() + "\n" + lipgloss.JoinHorizontal(lipgloss.Top, tabs...) + "\n"
+}
+
+// tabBar draws each tab of the tab bar.
+func (m model) tabBar() []string {
+	tabs := make([]string, len(m.tabs))
+	for i, c := range m.tabs {
+		tabs[i] = tabStyle().Render(c.name)
+		if i == m.active {
+			tabs[i] = activeTabStyle().Render(fmt.Sprintf("%s (%d)", c.name, len(c.list.Items())))
+		}
+	}
+	return tabs
+}
+
+// updateTab passes msg to the list of tab i, tagging the commands it returns
+// with the tab.
+func (m model) updateTab(i int, msg tea.Msg) (model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.tabs[i].list, cmd = m.tabs[i].list.Update(msg)
+	return m, m.tabCmd(i, cmd)
+}
+
+// tabCmd tags the messages of a command from the list of tab i.
+func (m model) tabCmd(i int, cmd tea.Cmd) tea.Cmd {
+	return tagged(cmd, func(msg tea.Msg) tea.Msg {
+		return tabMsg{tab: i, msg: msg}
+	})
+}
 
-	return model{list: l}
+// showPreview reports whether the terminal is wide enough for the preview.
+func (m model) showPreview() bool {
+	return m.width >= previewMinWidth
+}
+
+// selected returns the demo under the cursor, in any tab.
+func (m model) selected() (item, bool) {
+	switch i := m.tabs[m.active].list.SelectedItem().(type) {
+	case item:
+		return i, true
+	case entry:
+		return i.item, true
+	}
+	return item{}, false
+}
+
+// randomDemo picks any demo of the category tabs, each as likely as the
+// next.
+func (m model) randomDemo() item {
+	var all []list.Item
+	for _, c := range m.tabs[firstCategory:] {
+		all = append(all, c.list.Items()...)
+	}
+	return all[rand.Intn(len(all))].(item)
+}
+
+// syncPreview starts the preview of the highlighted demo when it changed,
+// or empties it when nothing is highlighted or there is no room for it.
+func (m model) syncPreview() (model, tea.Cmd) {
+	i, ok := m.selected()
+	if !ok || !m.showPreview() {
+		m.preview.stop()
+		return m, nil
+	}
+	cmd := m.preview.show(i)
+	return m, cmd
 }
 
 func (m model) Init() tea.Cmd {
-	return nil
+	return m.fade.Start()
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(previewMsg); ok {
+		cmd := m.preview.update(msg)
+		return m, cmd
+	}
+	if cmd, ok := m.fade.Update(msg); ok {
+		return m, cmd
+	}
+	m, cmd := m.update(msg)
+	if m.choice != nil || m.show != nil || m.split != nil {
+		return m, cmd
+	}
+	// Whatever moved the cursor, the preview follows it
+	m, previewCmd := m.syncPreview()
+	return m, tea.Batch(cmd, previewCmd)
+}
+
+func (m model) update(msg tea.Msg) (model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.list.SetWidth(msg.Width)
-		m.list.SetHeight(msg.Height - 4) // Account for title and help text
+		m.width, m.height = msg.Width, msg.Height
+		width := msg.Width
+		if m.showPreview() {
+			width -= lipgloss.Width(m.preview.View()) + 2
+		}
+		// Account for the header and help text
+		height := msg.Height - lipgloss.Height(m.header()) - 2
+		for i := range m.tabs {
+			m.tabs[i].list.SetSize(width, height)
+		}
+		if m.page != nil {
+			m.page.pager.SetSize(msg.Width, msg.Height)
+		}
 		return m, nil
 
+	case tabMsg:
+		return m.updateTab(msg.tab, msg.msg)
+
+	case tea.MouseMsg:
+		return m.mouse(msg)
+
 	case tea.KeyMsg:
+		if m.page != nil {
+			return m.manualKey(msg)
+		}
+		if m.warn != nil {
+			return m.warningKey(msg.String())
+		}
+		if m.themes != nil {
+			return m.themeKey(msg.String())
+		}
+		if m.params != nil {
+			return m.paramsKey(msg)
+		}
+		if m.stats != nil {
+			return m.statsKey(msg.String())
+		}
+		if m.keys != nil {
+			return m.cheatSheetKey(msg.String())
+		}
+		if m.tabs[m.active].list.FilterState() == list.Filtering && msg.String() != "enter" {
+			// Typing goes to the search box, q included
+			break
+		}
+		if m.active == playlistTab {
+			if m, cmd, ok := m.playlistKey(msg.String()); ok {
+				return m, cmd
+			}
+		}
 		switch keypress := msg.String(); keypress {
 		case "q", "ctrl+c":
 			return m, tea.Quit
+		case "left", "h", "shift+tab":
+			m.active = (m.active + len(m.tabs) - 1) % len(m.tabs)
+			return m, nil
+		case "right", "l", "tab":
+			m.active = (m.active + 1) % len(m.tabs)
+			return m, nil
+		case "a":
+			m.show = attractShow()
+			return m, tea.Quit
+		case "s":
+			if i, ok := m.selected(); ok {
+				return m.toggleFavorite(i.name)
+			}
+			return m, nil
+		case "?":
+			if i, ok := m.selected(); ok {
+				m.keys = &i
+			}
+			return m, nil
+		case "f1":
+			if i, ok := m.selected(); ok {
+				return m.openManual(i), nil
+			}
+			return m, nil
+		case "p":
+			if i, ok := m.selected(); ok && m.active != playlistTab {
+				return m.addToPlaylist(i), nil
+			}
+			return m, nil
+		case "r":
+			return m.launch(m.randomDemo())
+		case "i":
+			m.stats = loadStats()
+			return m, nil
+		case "e":
+			if i, ok := m.selected(); ok {
+				return m.openParams(i), nil
+			}
+			return m, nil
+		case "c":
+			if i, ok := m.resume(); ok {
+				return m.launch(i)
+			}
+			return m, nil
+		case "t":
+			if !m.fixedTheme {
+				return m.openThemes(), nil
+			}
+			return m, nil
+		case "v":
+			if i, ok := m.selected(); ok {
+				return m.pick(i)
+			}
+			return m, nil
+		case "esc":
+			if m.pair != nil {
+				m.pair = nil
+				return m, nil
+			}
 		case "enter":
-			i, ok := m.list.SelectedItem().(item)
-			if ok && i.command != "" {
-				m.choice = i.command
-				return m, tea.Quit
+			// Enter while searching runs the best match straight away
+			if i, ok := m.selected(); ok {
+				return m.launch(i)
 			}
 			return m, nil
 		}
 	}
 
-	var cmd tea.Cmd
-	m.list, cmd = m.list.Update(msg)
-	return m, cmd
+	return m.updateTab(m.active, msg)
 }
 
 func (m model) View() string {
-	if m.choice != "" {
+	if m.choice != nil || m.show != nil || m.split != nil {
 		return ""
 	}
-	
+	return m.fade.View(m.menuView(), m.width, m.height)
+}
+
+// menuView draws the menu, as it is left on screen when a demo starts.
+func (m model) menuView() string {
+	if m.page != nil {
+		return m.page.pager.View()
+	}
+
+	// The list's own help covers moving, searching and quitting
+	keys := "[←→] Tab • [?] Keys • [s]tar • [p]laylist • [r]andom • [a]ttract • [enter] Run"
+	if m.active == playlistTab {
+		keys = "[←→] Tab • [?] Keys • [J/K] Move • [+/-] Time • [x] Remove • [enter] Play"
+	}
+	if m.pair != nil {
+		keys = "Splitting with " + m.pair.title + " • [v] Pick the other demo • [esc] Cancel"
+	}
 	help := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("241")).
-		Render("\n[↑↓] Navigate • [enter] Select • [q] Quit")
+		Foreground(common.Subtle).
+		Render("\n" + keys)
+	if m.err != nil {
+		help += lipgloss.NewStyle().Foreground(common.Red).Render("  Error: " + m.err.Error())
+	}
 	
-	return m.list.View() + help
+	l := m.tabs[m.active].list
+	body := l.View()
+	if m.showPreview() {
+		// Pad the list out, so the preview stays put between tabs
+		body = lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.NewStyle().Width(l.Width()).Render(body), "  ", m.preview.View())
+	}
+	view := m.header() + body + help
+	switch {
+	case m.warn != nil:
+		view = m.warning(view)
+	case m.themes != nil:
+		view = m.themeDialog(view)
+	case m.params != nil:
+		view = m.paramsDialog(view)
+	case m.stats != nil:
+		view = m.statsDialog(view)
+	case m.keys != nil:
+		view = m.cheatSheet(view)
+	}
+	return view
 }
 
+// main shows the menu and runs the chosen demo in the same process, coming
+// back to the menu when it quits. Frame rates are limited while the menu
+// and its preview are up. With --attract, or "a" in the menu, the visual
+// demos take turns until a key is pressed, as the playlist's do when it is
+// played. The shared engine flags, such as
+// --fps and --record, apply to every demo run.
 func main() {
-	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
-	finalModel, err := p.Run()
-	if err != nil {
-		fmt.Printf("Error: %v", err)
-		os.Exit(1)
-	}
-
-	if m, ok := finalModel.(model); ok && m.choice != "" {
-		fmt.Printf("\033[2J\033[H")
-		cmd := exec.Command("go", "run", m.choice)
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
-		cmd.Stdin = os.Stdin
-		
-		if err := cmd.Run(); err != nil {
-			fmt.Printf("Error running example: %v\n", err)
+	flag.Usage = usage
+	flag.Parse()
+	if err := engine.ApplyTheme(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if flag.NArg() > 0 {
+		os.Exit(subcommand(flag.Args()))
+	}
+	if _, err := transitionEffect(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	m := initialModel()
+	if *attractFlag {
+		m.err = play(attractShow(), true)
+	}
+	for {
+		engine.LimitFrameRate(previewFPS)
+		final, err := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion()).Run()
+		if err != nil {
+			fmt.Printf("Error: %v", err)
 			os.Exit(1)
 		}
+		m = final.(model)
+		m.fade = nil
+		effect, _ := transitionEffect()
+		if m.show != nil {
+			show := m.show
+			m.show = nil
+			engine.TransitionFrom(m.menuView(), effect)
+			m.preview.stop()
+			m.err = play(show, true)
+			continue
+		}
+		if m.split != nil {
+			pair := *m.split
+			m.split = nil
+			engine.TransitionFrom(m.menuView(), effect)
+			m.preview.stop()
+			s := newSplit(pair[0], pair[1])
+			start := time.Now()
+			final, err = engine.RunNamed(pair[0].name+"+"+pair[1].name, s, engine.AltScreen())
+			m = m.ran(pair[0].name).ran(pair[1].name)
+			if err == nil {
+				err = final.(split).save()
+			}
+			for _, i := range pair {
+				if rerr := recordRun(i.name, time.Since(start)); err == nil {
+					err = rerr
+				}
+			}
+			if err != nil {
+				m.err = err
+			} else {
+				m.fade = transition.New(final.View(), effect)
+			}
+			continue
+		}
+		if m.choice == nil {
+			return
+		}
+		demo := *m.choice
+		m.choice = nil
+		engine.TransitionFrom(m.menuView(), effect)
+		m.preview.stop()
+		opts := append([]tea.ProgramOption{engine.AltScreen()}, demo.opts...)
+		start := time.Now()
+		final, err = engine.RunNamed(demo.name, demo.demo(), opts...)
+		m = m.ran(demo.name)
+		if err == nil {
+			err = recordRun(demo.name, time.Since(start))
+		}
+		if err != nil {
+			m.err = err
+		} else {
+			m = m.leftOff(demo.name, final)
+			m.fade = transition.New(final.View(), effect)
+		}
 	}
-}
\ No newline at end of file
+}
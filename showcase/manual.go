@@ -0,0 +1,48 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/manual"
+)
+
+// docPage is the open page of a demo, shown in place of the menu.
+type docPage struct {
+	demo  item
+	pager manual.Pager
+}
+
+// openManual opens the demo's page, sized to the menu.
+func (m model) openManual(i item) model {
+	p := manual.New(i.title, manual.Page(i.doc, i.keys(), engine.SharedKeys()))
+	p.Help = "[enter] Run • [esc] Back"
+	p.SetSize(m.width, m.height)
+	m.page = &docPage{demo: i, pager: p}
+	return m
+}
+
+// manualKey handles keys while a demo's page is open: enter runs the demo,
+// esc, F1 or q go back to the menu, and the rest scroll.
+func (m model) manualKey(msg tea.KeyMsg) (model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		i := m.page.demo
+		m.page = nil
+		return m.launch(i)
+	case "esc", "f1", "q":
+		m.page = nil
+		return m, nil
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+	return m.scrollManual(msg)
+}
+
+// scrollManual passes a key or the mouse wheel to the open page.
+func (m model) scrollManual(msg tea.Msg) (model, tea.Cmd) {
+	page := *m.page
+	var cmd tea.Cmd
+	page.pager, cmd = page.pager.Update(msg)
+	m.page = &page
+	return m, cmd
+}
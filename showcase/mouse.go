@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// doubleClick is the longest gap between the clicks of a double click.
+const doubleClick = 400 * time.Millisecond
+
+// click is the last demo clicked, to tell a double click from two clicks.
+type click struct {
+	tab, index int
+	at         time.Time
+}
+
+// mouse handles the mouse in the menu: the wheel moves the cursor, a click
+// picks a tab or a demo, and a double click on a demo, or a click on its
+// preview or on the line to continue with one, runs it. Over a demo's page
+// the wheel scrolls it.
+func (m model) mouse(msg tea.MouseMsg) (model, tea.Cmd) {
+	if m.page != nil {
+		return m.scrollManual(msg)
+	}
+	if m.keys != nil || m.warn != nil || m.themes != nil || m.params != nil || m.stats != nil {
+		return m, nil
+	}
+	l := &m.tabs[m.active].list
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		l.CursorUp()
+		return m, nil
+	case msg.Button == tea.MouseButtonWheelDown:
+		l.CursorDown()
+		return m, nil
+	case msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress:
+		return m, nil
+	}
+
+	top := strings.Count(m.header(), "\n")
+	switch {
+	case msg.Y == top-2:
+		if i, ok := m.resume(); ok {
+			return m.launch(i)
+		}
+		return m, nil
+	case msg.Y == top-1:
+		if tab, ok := m.tabAt(msg.X); ok {
+			m.active = tab
+		}
+		return m, nil
+	case msg.Y < top:
+		return m, nil
+	case m.showPreview() && msg.X >= l.Width()+2:
+		if msg.Y < top+lipgloss.Height(m.preview.View()) {
+			if i, ok := m.selected(); ok {
+				return m.launch(i)
+			}
+		}
+		return m, nil
+	}
+
+	index, ok := itemAt(*l, msg.Y-top)
+	if !ok {
+		return m, nil
+	}
+	l.Select(index)
+	now := time.Now()
+	last := m.lastClick
+	m.lastClick = click{tab: m.active, index: index, at: now}
+	if last.tab == m.active && last.index == index && now.Sub(last.at) <= doubleClick {
+		m.lastClick = click{}
+		if i, ok := m.selected(); ok {
+			return m.launch(i)
+		}
+	}
+	return m, nil
+}
+
+// tabAt returns the tab drawn at column x of the tab bar.
+func (m model) tabAt(x int) (int, bool) {
+	left := 0
+	for i, tab := range m.tabBar() {
+		left += lipgloss.Width(tab)
+		if x < left {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// itemAt returns the index among the list's visible items of the one drawn
+// at line y of the list, if any. Above the items is the title bar: with the
+// title hidden it is a blank line, or the search box while typing a search.
+func itemAt(l list.Model, y int) (int, bool) {
+	switch {
+	case l.FilterState() == list.Filtering:
+		y -= lipgloss.Height(l.Styles.TitleBar.Render(l.FilterInput.View()))
+	case l.FilteringEnabled():
+		y--
+	}
+	d := newDelegate()
+	if y < 0 || y%(d.Height()+d.Spacing()) >= d.Height() {
+		return 0, false
+	}
+	index := l.Paginator.Page*l.Paginator.PerPage + y/(d.Height()+d.Spacing())
+	start, end := l.Paginator.GetSliceBounds(len(l.VisibleItems()))
+	if index < start || index >= end {
+		return 0, false
+	}
+	return index, true
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// paramForm is the open settings panel: a field for each of the demo's
+// settings, filled with the ones it would start with, which enter runs it
+// with instead. Settings that are not a string, number or true/false, such
+// as a list, are left as they are.
+type paramForm struct {
+	demo   item
+	keys   []string
+	kinds  []byte // the first byte of each setting's JSON, telling its type
+	inputs []textinput.Model
+	focus  int
+	err    string
+}
+
+// openParams opens the settings panel on the demo.
+func (m model) openParams(i item) model {
+	f := &paramForm{demo: i}
+	for _, field := range objectFields(currentSettings(i)) {
+		kind := field.value[0]
+		if kind == '{' || kind == '[' || kind == 'n' {
+			continue
+		}
+		value := string(field.value)
+		if kind == '"' {
+			json.Unmarshal(field.value, &value)
+		}
+		in := textinput.New()
+		in.Prompt = ""
+		in.CharLimit = 120
+		in.Width = 30
+		in.Cursor.SetMode(cursor.CursorStatic)
+		in.SetValue(value)
+		// Long values show from their start until edited
+		in.CursorStart()
+		f.keys = append(f.keys, field.key)
+		f.kinds = append(f.kinds, kind)
+		f.inputs = append(f.inputs, in)
+	}
+	if len(f.inputs) > 0 {
+		f.inputs[0].Focus()
+	}
+	m.params = f
+	return m
+}
+
+// field is one key of a JSON object and its value.
+type field struct {
+	key   string
+	value json.RawMessage
+}
+
+// objectFields returns the fields of a JSON object in the order they are
+// written, or none if data is not an object.
+func objectFields(data json.RawMessage) []field {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil
+	}
+	var fields []field
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil || len(value) == 0 {
+			return nil
+		}
+		fields = append(fields, field{key: t.(string), value: value})
+	}
+	return fields
+}
+
+// settings turns the fields back into a JSON object. Strings stay strings
+// and true/false must be one of the two; a number that does not parse is
+// passed as a string, since some demos take a name there, such as plasma's
+// palette.
+func (f *paramForm) settings() (json.RawMessage, error) {
+	var b strings.Builder
+	b.WriteString("{")
+	for n, in := range f.inputs {
+		value := strings.TrimSpace(in.Value())
+		var raw []byte
+		switch f.kinds[n] {
+		case '"':
+			raw, _ = json.Marshal(in.Value())
+		case 't', 'f':
+			if _, err := strconv.ParseBool(value); err != nil {
+				return nil, fmt.Errorf("%s is true or false", f.keys[n])
+			}
+			raw = []byte(strings.ToLower(value))
+		default:
+			if value == "" {
+				return nil, fmt.Errorf("%s needs a value", f.keys[n])
+			}
+			raw = []byte(value)
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				raw, _ = json.Marshal(value)
+			}
+		}
+		if n > 0 {
+			b.WriteString(",")
+		}
+		key, _ := json.Marshal(f.keys[n])
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(raw)
+	}
+	b.WriteString("}")
+	return json.RawMessage(b.String()), nil
+}
+
+// paramsKey handles keys while the settings panel is open: tab and the
+// arrows move between fields, enter runs the demo with them, and esc
+// closes the panel. Other keys edit the field.
+func (m model) paramsKey(msg tea.KeyMsg) (model, tea.Cmd) {
+	f := *m.params
+	f.inputs = append([]textinput.Model(nil), f.inputs...)
+	switch msg.String() {
+	case "esc":
+		m.params = nil
+		return m, nil
+	case "ctrl+c":
+		return m, tea.Quit
+	case "enter":
+		params, err := f.settings()
+		if err != nil {
+			f.err = err.Error()
+			m.params = &f
+			return m, nil
+		}
+		m.params = nil
+		if len(f.inputs) == 0 {
+			return m.launch(f.demo)
+		}
+		return m.launch(newEntry(f.demo, 0, params).item)
+	case "tab", "down":
+		f.move(1)
+	case "shift+tab", "up":
+		f.move(-1)
+	default:
+		if len(f.inputs) > 0 {
+			f.inputs[f.focus], _ = f.inputs[f.focus].Update(msg)
+			f.err = ""
+		}
+	}
+	m.params = &f
+	return m, nil
+}
+
+// move focuses the field by steps after the focused one, round the end.
+func (f *paramForm) move(by int) {
+	if len(f.inputs) == 0 {
+		return
+	}
+	f.inputs[f.focus].Blur()
+	f.focus = (f.focus + by + len(f.inputs)) % len(f.inputs)
+	f.inputs[f.focus].Focus()
+}
+
+// paramsDialog draws the settings panel over the menu.
+func (m model) paramsDialog(menu string) string {
+	f := m.params
+	width := 0
+	for _, k := range f.keys {
+		width = max(width, lipgloss.Width(k))
+	}
+	lines := []string{sheetTitleStyle().Render(f.demo.title), ""}
+	if len(f.inputs) == 0 {
+		lines = append(lines, sheetDescStyle().Render("No settings to set"))
+	}
+	for n, in := range f.inputs {
+		label := sheetDescStyle().Render(fmt.Sprintf("%-*s", width, f.keys[n]))
+		if n == f.focus {
+			label = sheetKeyStyle().Render(fmt.Sprintf("%-*s", width, f.keys[n]))
+		}
+		lines = append(lines, label+"  "+in.View())
+	}
+	if f.err != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(common.Red).Render(f.err))
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("[tab] Next • [enter] Run • [esc] Back"))
+	return m.dialog(menu, lines)
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+var playlistFlag = flag.String("playlist", "", "the playlist `file` the menu edits and play runs (default playlist.json in the config directory)")
+
+// A demo added to the playlist gets defaultSlotTime, changed in steps of
+// slotTimeStep.
+const (
+	defaultSlotTime = 30 * time.Second
+	slotTimeStep    = 5 * time.Second
+)
+
+// playlist is the file a show is kept in, such as:
+//
+//	{"items": [
+//	  {"demo": "plasma", "duration": "30s", "settings": {"palette": 2, "speed": 1.5}},
+//	  {"demo": "vaporwave", "duration": "1m"}
+//	]}
+//
+// Demos are named as for "showcase run". Settings have the form of the
+// demo's entry in the settings file and apply over it for the slot only.
+type playlist struct {
+	Items []playItem `json:"items"`
+}
+
+type playItem struct {
+	Demo     string          `json:"demo"`
+	Duration duration        `json:"duration"`
+	Settings json.RawMessage `json:"settings,omitempty"`
+}
+
+// duration is a time.Duration written as a string such as "1m30s".
+type duration time.Duration
+
+func (d duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+// entry is a demo's slot in the Playlist tab. Its demo builds the model with
+// the slot's settings applied.
+type entry struct {
+	item
+	time   time.Duration
+	params json.RawMessage
+}
+
+func newEntry(i item, d time.Duration, params json.RawMessage) entry {
+	e := entry{item: i, time: d, params: params}
+	if len(params) > 0 {
+		build := i.demo
+		e.demo = func() tea.Model { return withSettings(build, params) }
+	}
+	return e
+}
+
+func (e entry) Description() string {
+	if len(e.params) == 0 {
+		return e.time.String()
+	}
+	return e.time.String() + " • " + string(e.params)
+}
+
+// withSettings builds a demo's model with params applied over its settings.
+// Only the model knows the name of its settings entry, so one is built to
+// ask.
+func withSettings(build func() tea.Model, params json.RawMessage) tea.Model {
+	saver, ok := build().(settings.Saver)
+	if !ok {
+		return build()
+	}
+	name, _ := saver.Settings()
+	settings.Override(name, params)
+	defer settings.Override(name, nil)
+	return build()
+}
+
+// currentSettings returns the settings a demo would start with now, so a
+// playlist keeps them even if they are changed later.
+func currentSettings(i item) json.RawMessage {
+	saver, ok := i.demo().(settings.Saver)
+	if !ok {
+		return nil
+	}
+	_, v := saver.Settings()
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// playlistPath returns the file given with --playlist, or playlist.json in
+// the showcase config directory. It is "" when SHOWCASE_SETTINGS=off turns
+// persistence off, leaving the playlist in memory.
+func playlistPath() (string, error) {
+	if *playlistFlag != "" {
+		return *playlistFlag, nil
+	}
+	if path, err := settings.Path(); err == nil && path == "" {
+		return "", nil
+	}
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config, "bubbletea-showcase", "playlist.json"), nil
+}
+
+// loadPlaylist reads a playlist file. A missing file, or none, is an empty
+// playlist.
+func loadPlaylist(path string) ([]entry, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var p playlist
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	var entries []entry
+	for _, pi := range p.Items {
+		i, ok := findDemo(pi.Demo)
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown demo %q", filepath.Base(path), pi.Demo)
+		}
+		d := time.Duration(pi.Duration)
+		if d <= 0 {
+			d = defaultSlotTime
+		}
+		// The file is indented, settings and all; the tab shows them on a line
+		var params bytes.Buffer
+		if len(pi.Settings) > 0 {
+			if err := json.Compact(&params, pi.Settings); err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+			}
+		}
+		entries = append(entries, newEntry(i, d, params.Bytes()))
+	}
+	return entries, nil
+}
+
+func savePlaylist(path string, entries []entry) error {
+	p := playlist{Items: []playItem{}}
+	for _, e := range entries {
+		p.Items = append(p.Items, playItem{Demo: e.short, Duration: duration(e.time), Settings: e.params})
+	}
+	out, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0o644)
+}
+
+// slots turns playlist entries into a show, starting from entry first.
+func slots(entries []entry, first int) []slot {
+	var show []slot
+	for n := range entries {
+		e := entries[(first+n)%len(entries)]
+		show = append(show, slot{demo: e.item, time: e.time})
+	}
+	return show
+}
+
+func (m model) playlist() []entry {
+	var entries []entry
+	for _, li := range m.tabs[playlistTab].list.Items() {
+		entries = append(entries, li.(entry))
+	}
+	return entries
+}
+
+// showPlaylist puts the entries in the Playlist tab with the cursor on
+// entry cursor.
+func (m *model) showPlaylist(entries []entry, cursor int) {
+	items := make([]list.Item, len(entries))
+	for n, e := range entries {
+		items[n] = e
+	}
+	l := &m.tabs[playlistTab].list
+	l.SetItems(items)
+	l.Select(max(0, min(cursor, len(items)-1)))
+}
+
+// setPlaylist shows the entries and saves them.
+func (m model) setPlaylist(entries []entry, cursor int) model {
+	m.showPlaylist(entries, cursor)
+	path, err := playlistPath()
+	if err == nil && path != "" {
+		err = savePlaylist(path, entries)
+	}
+	m.err = err
+	return m
+}
+
+// addToPlaylist appends the demo with the settings it starts with now.
+func (m model) addToPlaylist(i item) model {
+	entries := append(m.playlist(), newEntry(i, defaultSlotTime, currentSettings(i)))
+	return m.setPlaylist(entries, m.tabs[playlistTab].list.Index())
+}
+
+// playlistKey handles the keys of the Playlist tab, reporting whether the
+// key was one of them.
+func (m model) playlistKey(keypress string) (model, tea.Cmd, bool) {
+	entries := m.playlist()
+	n := m.tabs[playlistTab].list.Index()
+	if len(entries) == 0 {
+		return m, nil, false
+	}
+	switch keypress {
+	case "x", "delete", "backspace":
+		return m.setPlaylist(slices.Delete(entries, n, n+1), n), nil, true
+	case "+", "=":
+		entries[n].time += slotTimeStep
+		return m.setPlaylist(entries, n), nil, true
+	case "-":
+		entries[n].time = max(slotTimeStep, entries[n].time-slotTimeStep)
+		return m.setPlaylist(entries, n), nil, true
+	case "K", "shift+up":
+		if n > 0 {
+			entries[n-1], entries[n] = entries[n], entries[n-1]
+			n--
+		}
+		return m.setPlaylist(entries, n), nil, true
+	case "J", "shift+down":
+		if n < len(entries)-1 {
+			entries[n+1], entries[n] = entries[n], entries[n+1]
+			n++
+		}
+		return m.setPlaylist(entries, n), nil, true
+	case "enter":
+		m.show = slots(entries, n)
+		return m, tea.Quit, true
+	}
+	return m, nil, false
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlaylistRoundTrip(t *testing.T) {
+	plasma, ok := findDemo("plasma")
+	if !ok {
+		t.Fatal("no plasma demo")
+	}
+	vaporwave, ok := findDemo("vaporwave")
+	if !ok {
+		t.Fatal("no vaporwave demo")
+	}
+	want := []entry{
+		newEntry(plasma, 30*time.Second, json.RawMessage(`{"palette":2,"speed":1.5}`)),
+		newEntry(vaporwave, 90*time.Second, nil),
+	}
+	path := filepath.Join(t.TempDir(), "shows", "playlist.json")
+	if err := savePlaylist(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := loadPlaylist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("loaded %d entries, want %d", len(got), len(want))
+	}
+	for n := range want {
+		g, w := got[n], want[n]
+		if g.short != w.short || g.time != w.time || string(g.params) != string(w.params) {
+			t.Errorf("entry %d is %s for %v with %s, want %s for %v with %s",
+				n, g.short, g.time, g.params, w.short, w.time, w.params)
+		}
+		if g.Description() != w.Description() {
+			t.Errorf("entry %d reads %q, want %q", n, g.Description(), w.Description())
+		}
+	}
+}
+
+func TestLoadPlaylist(t *testing.T) {
+	tests := []struct {
+		name, json string
+		want       string // in the error, or "" for none
+	}{
+		{"no duration", `{"items": [{"demo": "plasma"}]}`, ""},
+		{"short name", `{"items": [{"demo": "cube", "duration": "1m"}]}`, ""},
+		{"unknown demo", `{"items": [{"demo": "plasmaa", "duration": "1m"}]}`, `playlist.json: unknown demo "plasmaa"`},
+		{"bad duration", `{"items": [{"demo": "plasma", "duration": "1 minute"}]}`, `playlist.json: time: unknown unit " minute" in duration "1 minute"`},
+		{"duration not a string", `{"items": [{"demo": "plasma", "duration": 30}]}`, "playlist.json: json: cannot unmarshal number"},
+		{"not JSON", `items: plasma`, "playlist.json: invalid character"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "playlist.json")
+		if err := os.WriteFile(path, []byte(tt.json), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		entries, err := loadPlaylist(path)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tt.want == "" && (len(entries) != 1 || entries[0].time <= 0):
+			t.Errorf("%s: loaded %v, want one entry with a time", tt.name, entries)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("%s: %v, want %q", tt.name, err, tt.want)
+		}
+	}
+
+	// A playlist that has not been saved yet is empty
+	entries, err := loadPlaylist(filepath.Join(t.TempDir(), "playlist.json"))
+	if err != nil || len(entries) != 0 {
+		t.Errorf("missing file loaded %v, %v, want nothing", entries, err)
+	}
+}
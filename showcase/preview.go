@@ -0,0 +1,113 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+)
+
+// The live preview beside the menu runs the highlighted demo at this size,
+// and every Animator at most at previewFPS while the menu is up.
+const (
+	previewWidth  = 30
+	previewHeight = 10
+	previewFPS    = 10
+)
+
+// previewMinWidth is the narrowest terminal the preview is shown in; below
+// it the list keeps the whole width.
+const previewMinWidth = 80
+
+func previewStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(common.Accent).
+		Width(previewWidth).
+		Height(previewHeight)
+}
+
+// preview runs the model of the highlighted demo inside the menu. Its
+// commands are tagged with a generation, so messages meant for a model that
+// has since been replaced are dropped instead of reaching the new one.
+type preview struct {
+	name  string // the demo shown, by directory name
+	model tea.Model
+	gen   int
+}
+
+// previewMsg carries a message produced by the preview's model back to it.
+type previewMsg struct {
+	gen int
+	msg tea.Msg
+}
+
+// show starts a fresh model of the demo, unless it is already shown.
+func (p *preview) show(i item) tea.Cmd {
+	if p.model != nil && p.name == i.name {
+		return nil
+	}
+	p.stop()
+	p.name = i.name
+	p.model = i.demo()
+	var cmd tea.Cmd
+	p.model, cmd = p.model.Update(tea.WindowSizeMsg{Width: previewWidth, Height: previewHeight})
+	return p.tag(tea.Batch(p.model.Init(), cmd))
+}
+
+// stop drops the model, leaving the preview empty.
+func (p *preview) stop() {
+	p.gen++
+	p.name = ""
+	p.model = nil
+}
+
+func (p *preview) update(msg previewMsg) tea.Cmd {
+	if msg.gen != p.gen || p.model == nil {
+		return nil
+	}
+	var cmd tea.Cmd
+	p.model, cmd = p.model.Update(msg.msg)
+	return p.tag(cmd)
+}
+
+func (p *preview) tag(cmd tea.Cmd) tea.Cmd {
+	gen := p.gen
+	return tagged(cmd, func(msg tea.Msg) tea.Msg {
+		return previewMsg{gen: gen, msg: msg}
+	})
+}
+
+// View draws the demo's view cut down to the thumbnail, in a frame.
+func (p preview) View() string {
+	view := ""
+	if p.model != nil {
+		view = lipgloss.NewStyle().
+			MaxWidth(previewWidth).
+			MaxHeight(previewHeight).
+			Render(p.model.View())
+	}
+	return previewStyle().Render(view)
+}
+
+// tagged wraps the messages cmd produces with tag, so they come back marked
+// with where they belong. The commands of a batch are wrapped one by one and
+// handed back to the program, which still runs them concurrently.
+func tagged(cmd tea.Cmd, tag func(tea.Msg) tea.Msg) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		switch msg := msg.(type) {
+		case nil:
+			return nil
+		case tea.BatchMsg:
+			cmds := make(tea.BatchMsg, len(msg))
+			for i, c := range msg {
+				cmds[i] = tagged(c, tag)
+			}
+			return cmds
+		}
+		return tag(msg)
+	}
+}
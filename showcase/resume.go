@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+// lastRun is the demo the launcher last ran, with the settings it quit
+// with, which "Continue where you left off" runs it with again.
+type lastRun struct {
+	Demo     string          `json:"demo"`
+	Settings json.RawMessage `json:"settings,omitempty"`
+}
+
+// leftOff records the demo as the one to continue with, keeping the
+// settings of its final model.
+func (h *history) leftOff(name string, final tea.Model) {
+	last := &lastRun{Demo: name}
+	if saver, ok := final.(settings.Saver); ok {
+		_, v := saver.Settings()
+		if data, err := json.Marshal(v); err == nil {
+			last.Settings = data
+		}
+	}
+	h.Last = last
+}
+
+// leftOff records the demo that just quit and saves the history.
+func (m model) leftOff(name string, final tea.Model) model {
+	m.history.leftOff(name, final)
+	m.err = m.history.save()
+	return m
+}
+
+// resume returns the demo to continue with, built with the settings it
+// quit with, if there is one still in the menu.
+func (m model) resume() (item, bool) {
+	last := m.history.Last
+	if last == nil {
+		return item{}, false
+	}
+	for _, c := range m.tabs[firstCategory:] {
+		for _, li := range c.list.Items() {
+			if i := li.(item); i.name == last.Demo {
+				return newEntry(i, 0, last.Settings).item, true
+			}
+		}
+	}
+	return item{}, false
+}
+
+// continueLine offers the demo to continue with, above the tabs. It is
+// blank when there is none, so the menu keeps its height.
+func (m model) continueLine() string {
+	i, ok := m.resume()
+	if !ok {
+		return ""
+	}
+	return tabStyle().Render("▶ [c] Continue where you left off: " + i.title)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// session runs the menu and the demos chosen from it inside one program,
+// for hosts such as the SSH server that give each visitor a single program
+// rather than a terminal to start programs in. main does the same with a
+// program per demo. A demo quitting brings the menu back instead of ending
+// the program: its commands are tagged, so its tea.QuitMsg arrives here
+// wrapped rather than reaching the program.
+type session struct {
+	menu model
+	demo tea.Model // the running demo, wrapped by engine.Wrap, or nil
+	gen  int       // counts demos started, to drop a finished one's messages
+	show []slot    // the show being played, if any
+	slot int
+	// watching are the demos running and since is when they started, for
+	// their stats
+	watching []string
+	since    time.Time
+	profile  termcolor.Profile // the colors the visitor's terminal shows
+
+	width, height int
+}
+
+// demoMsg carries a message produced by the running demo back to it.
+type demoMsg struct {
+	gen int
+	msg tea.Msg
+}
+
+// slotDoneMsg ends a slot of the show being played.
+type slotDoneMsg struct{ gen int }
+
+func newSession(profile termcolor.Profile) session {
+	s := session{menu: initialModel(), profile: profile}
+	s.menu.profile = profile
+	s.menu.fixedTheme = true
+	return s
+}
+
+func (s session) Init() tea.Cmd {
+	return s.menu.Init()
+}
+
+func (s session) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		s.width, s.height = msg.Width, msg.Height
+	case demoMsg:
+		if msg.gen != s.gen || s.demo == nil {
+			return s, nil
+		}
+		if _, ok := msg.msg.(tea.QuitMsg); ok {
+			return s.next()
+		}
+		return s.updateDemo(msg.msg)
+	case slotDoneMsg:
+		if msg.gen != s.gen || s.show == nil {
+			return s, nil
+		}
+		return s.next()
+	case tabMsg, previewMsg:
+		return s.updateMenu(msg)
+	case tea.KeyMsg:
+		if s.show != nil && msg.String() != "ctrl+c" {
+			// Any key ends a show, as it does when main plays one
+			return s.toMenu()
+		}
+	}
+	if s.demo != nil {
+		if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "ctrl+c" {
+			return s, tea.Quit
+		}
+		return s.updateDemo(msg)
+	}
+	return s.updateMenu(msg)
+}
+
+func (s session) updateMenu(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := s.menu.Update(msg)
+	s.menu = m.(model)
+	switch {
+	case s.menu.show != nil:
+		s.show, s.slot = s.menu.show, 0
+		s.menu.show = nil
+		return s.start(s.show[0].demo)
+	case s.menu.choice != nil:
+		i := *s.menu.choice
+		s.menu.choice = nil
+		return s.start(i)
+	case s.menu.split != nil:
+		pair := *s.menu.split
+		s.menu.split = nil
+		s.menu = s.menu.ran(pair[0].name).ran(pair[1].name)
+		s.watching, s.since = []string{pair[0].name, pair[1].name}, time.Now()
+		return s.run(engine.Wrap(pair[0].name+"+"+pair[1].name, newSplit(pair[0], pair[1])))
+	}
+	return s, cmd
+}
+
+func (s session) updateDemo(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	s.demo, cmd = s.demo.Update(msg)
+	return s, s.tag(cmd)
+}
+
+// start runs the item's demo in place of the menu.
+func (s session) start(i item) (tea.Model, tea.Cmd) {
+	s.menu = s.menu.ran(i.name)
+	s.watching, s.since = []string{i.name}, time.Now()
+	return s.run(engine.Wrap(i.name, i.demo()))
+}
+
+// run runs a demo, already wrapped, in place of the menu, timing its slot
+// if a show is being played.
+func (s session) run(demo tea.Model) (tea.Model, tea.Cmd) {
+	s.menu.preview.stop()
+	s.gen++
+	s.demo = demo
+	var cmd tea.Cmd
+	s.demo, cmd = s.demo.Update(tea.WindowSizeMsg{Width: s.width, Height: s.height})
+	cmds := []tea.Cmd{s.tag(s.demo.Init()), s.tag(cmd)}
+	if s.show != nil {
+		gen := s.gen
+		cmds = append(cmds, tea.Tick(s.show[s.slot].time, func(time.Time) tea.Msg {
+			return slotDoneMsg{gen: gen}
+		}))
+	}
+	return s, tea.Batch(cmds...)
+}
+
+// next moves a show on to its next slot, starting over after the last, or
+// goes back to the menu when a demo run on its own quits.
+func (s session) next() (tea.Model, tea.Cmd) {
+	s = s.tally()
+	if s.show == nil {
+		return s.toMenu()
+	}
+	s.slot = (s.slot + 1) % len(s.show)
+	return s.start(s.show[s.slot].demo)
+}
+
+func (s session) toMenu() (tea.Model, tea.Cmd) {
+	s = s.tally()
+	s.gen++
+	s.demo, s.show = nil, nil
+	return s.updateMenu(tea.WindowSizeMsg{Width: s.width, Height: s.height})
+}
+
+// tally adds the run of the demos that are ending to their stats.
+func (s session) tally() session {
+	for _, name := range s.watching {
+		if err := recordRun(name, time.Since(s.since)); err != nil {
+			s.menu.err = err
+		}
+	}
+	s.watching = nil
+	return s
+}
+
+func (s session) tag(cmd tea.Cmd) tea.Cmd {
+	gen := s.gen
+	return tagged(cmd, func(msg tea.Msg) tea.Msg {
+		return demoMsg{gen: gen, msg: msg}
+	})
+}
+
+func (s session) View() string {
+	view := s.menu.View()
+	if s.demo != nil {
+		view = s.demo.View()
+	}
+	return degrade(view, s.profile)
+}
+
+var sgr = regexp.MustCompile(`\x1b\[([0-9;]*)m`)
+
+// degrade rewrites the colors of a view drawn for a true color terminal to
+// the nearest the profile has, or drops them for Mono. The view is drawn
+// once for every visitor, so each session's terminal gets its colors here.
+func degrade(view string, p termcolor.Profile) string {
+	if p == termcolor.TrueColor {
+		return view
+	}
+	return sgr.ReplaceAllStringFunc(view, func(match string) string {
+		params := strings.Split(sgr.FindStringSubmatch(match)[1], ";")
+		var out []string
+		for i := 0; i < len(params); i++ {
+			code := params[i]
+			if (code != "38" && code != "48") || i+1 >= len(params) {
+				out = append(out, code)
+				continue
+			}
+			var c common.RGB
+			switch {
+			case params[i+1] == "2" && i+4 < len(params):
+				r, _ := strconv.Atoi(params[i+2])
+				g, _ := strconv.Atoi(params[i+3])
+				b, _ := strconv.Atoi(params[i+4])
+				c = common.RGB{R: uint8(r), G: uint8(g), B: uint8(b)}
+				i += 4
+			case params[i+1] == "5" && i+2 < len(params):
+				n, _ := strconv.Atoi(params[i+2])
+				c = termcolor.ANSI(n)
+				i += 2
+			default:
+				out = append(out, code)
+				continue
+			}
+			if seq := colorCode(termcolor.Quantize(c, p, 0, 0, false), code == "48"); seq != "" {
+				out = append(out, seq)
+			}
+		}
+		if len(out) == 0 {
+			return ""
+		}
+		return "\x1b[" + strings.Join(out, ";") + "m"
+	})
+}
+
+// colorCode is the SGR parameter setting a quantized color: a palette index
+// for 256 colors, one of the 16 basic codes below that, or none for Mono.
+func colorCode(c lipgloss.Color, bg bool) string {
+	n, err := strconv.Atoi(string(c))
+	switch {
+	case err != nil:
+		return ""
+	case n >= 16 && bg:
+		return "48;5;" + string(c)
+	case n >= 16:
+		return "38;5;" + string(c)
+	case bg && n < 8:
+		return strconv.Itoa(40 + n)
+	case bg:
+		return strconv.Itoa(100 + n - 8)
+	case n < 8:
+		return strconv.Itoa(30 + n)
+	}
+	return strconv.Itoa(90 + n - 8)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/transition"
+)
+
+var (
+	attractFlag = flag.Bool("attract", false, "start in attract mode, cycling through the visual demos until a key is pressed")
+	attractTime = flag.Duration("attract-time", 20*time.Second, "how long attract mode shows each demo")
+)
+
+// slot is a demo's place in a show: attract mode, a playlist or a timeline.
+type slot struct {
+	demo item
+	time time.Duration
+	cut  string // the transition into the demo, or "" for --transition's
+}
+
+// effect returns the transition into the slot's demo.
+func (s slot) effect() transition.Effect {
+	if s.cut != "" {
+		if effect, err := pickEffect(s.cut); err == nil {
+			return effect
+		}
+	}
+	effect, _ := transitionEffect()
+	return effect
+}
+
+// turnDoneMsg ends a demo's turn in a show.
+type turnDoneMsg struct{}
+
+// turn runs a demo for its slot in a show: it quits when the time is up, or
+// on any key, which also ends the show.
+type turn struct {
+	demo    tea.Model
+	time    time.Duration
+	stopped bool
+}
+
+func (t turn) Init() tea.Cmd {
+	return tea.Batch(t.demo.Init(), tea.Tick(t.time, func(time.Time) tea.Msg {
+		return turnDoneMsg{}
+	}))
+}
+
+func (t turn) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.KeyMsg:
+		t.stopped = true
+		return t, tea.Quit
+	case turnDoneMsg:
+		return t, tea.Quit
+	}
+	var cmd tea.Cmd
+	t.demo, cmd = t.demo.Update(msg)
+	return t, cmd
+}
+
+func (t turn) View() string {
+	return t.demo.View()
+}
+
+// attractShow gives each visual demo a slot of --attract-time.
+func attractShow() []slot {
+	var show []slot
+	for _, s := range sections() {
+		if s.visual {
+			for _, li := range s.items {
+				show = append(show, slot{demo: li.(item), time: *attractTime})
+			}
+		}
+	}
+	return show
+}
+
+// play runs the demos of a show in turn until a key is pressed. A show that
+// loops starts over after the last, screensaver style; one that does not
+// ends there.
+func play(show []slot, loop bool) error {
+	if len(show) == 0 {
+		return nil
+	}
+	for {
+		for n, s := range show {
+			opts := append([]tea.ProgramOption{engine.AltScreen()}, s.demo.opts...)
+			start := time.Now()
+			final, err := engine.RunNamed(s.demo.name, turn{demo: s.demo.demo(), time: s.time}, opts...)
+			if err == nil {
+				err = recordRun(s.demo.name, time.Since(start))
+			}
+			if err != nil {
+				return err
+			}
+			if t, ok := final.(turn); !ok || t.stopped {
+				return nil
+			}
+			if n == len(show)-1 && !loop {
+				return nil
+			}
+			// Each demo of the show cuts to the next with a transition
+			next := show[(n+1)%len(show)]
+			engine.TransitionFrom(final.View(), next.effect())
+		}
+	}
+}
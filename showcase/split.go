@@ -0,0 +1,234 @@
+package main
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+// Keys of the split screen. Function keys are used since the demos take
+// most others, tab and ctrl+w included.
+const (
+	splitFocusKey = "f6"
+	splitFlipKey  = "f7"
+)
+
+func splitBarStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(common.Subtle)
+}
+
+func splitFocusStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Bold(true).Foreground(common.Accent)
+}
+
+// split runs two demos at once, side by side or one above the other. Each
+// gets a WindowSizeMsg for its half, keys go to the focused one and the
+// mouse to the one under the pointer, in its own coordinates. Their
+// commands come back tagged with the side, so ticks and other messages
+// reach the demo that asked for them; either demo quitting ends the split.
+// Each demo is wrapped with engine.Wrap, which sees its ticks and gives it
+// its own "?" overlay and frame rate keys.
+type split struct {
+	items   [2]item
+	demos   [2]tea.Model
+	focus   int
+	stacked bool // one above the other, rather than side by side
+	// held is the side a mouse button was last pressed on, and dragging
+	// whether it is still down
+	held     int
+	dragging bool
+
+	width, height int
+}
+
+// splitMsg carries a message produced by one side back to it.
+type splitMsg struct {
+	side int
+	msg  tea.Msg
+}
+
+func newSplit(a, b item) split {
+	return split{items: [2]item{a, b}, demos: [2]tea.Model{
+		engine.Wrap(a.name, a.demo()),
+		engine.Wrap(b.name, b.demo()),
+	}}
+}
+
+// pick marks the demo for a split with "v", or with one already marked runs
+// the two side by side. The pre-launch check is skipped, since each half is
+// smaller than the terminal anyway.
+func (m model) pick(i item) (model, tea.Cmd) {
+	if m.pair == nil {
+		m.pair = &i
+		return m, nil
+	}
+	m.split = &[2]item{*m.pair, i}
+	m.pair = nil
+	return m, tea.Quit
+}
+
+func (s split) Init() tea.Cmd {
+	return tea.Batch(s.tag(0, s.demos[0].Init()), s.tag(1, s.demos[1].Init()))
+}
+
+func (s split) tag(side int, cmd tea.Cmd) tea.Cmd {
+	return tagged(cmd, func(msg tea.Msg) tea.Msg {
+		return splitMsg{side: side, msg: msg}
+	})
+}
+
+func (s split) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case splitMsg:
+		if _, ok := msg.msg.(tea.QuitMsg); ok {
+			return s, tea.Quit
+		}
+		return s.updateSide(msg.side, msg.msg)
+	case tea.WindowSizeMsg:
+		s.width, s.height = msg.Width, msg.Height
+		return s.resize()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case splitFocusKey:
+			s.focus = 1 - s.focus
+			return s, nil
+		case splitFlipKey:
+			s.stacked = !s.stacked
+			return s.resize()
+		}
+		return s.updateSide(s.focus, msg)
+	case tea.MouseMsg:
+		return s.mouse(msg)
+	}
+	// Anything else goes to both
+	m, cmd0 := s.updateSide(0, msg)
+	s = m.(split)
+	m, cmd1 := s.updateSide(1, msg)
+	return m, tea.Batch(cmd0, cmd1)
+}
+
+func (s split) updateSide(side int, msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	s.demos[side], cmd = s.demos[side].Update(msg)
+	return s, s.tag(side, cmd)
+}
+
+// mouse sends a mouse event to the side under the pointer, moved to that
+// side's coordinates. Pressing a button there also focuses it, and until
+// the button is let go its events go to the same side, so a drag that
+// strays over the divider is not lost. Events on the divider or the bar go
+// nowhere.
+func (s split) mouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	side, ok := s.sideAt(msg.X, msg.Y)
+	if s.dragging {
+		side, ok = s.held, true
+	}
+	if !ok {
+		return s, nil
+	}
+	switch msg.Action {
+	case tea.MouseActionPress:
+		if !tea.MouseEvent(msg).IsWheel() {
+			s.focus, s.held, s.dragging = side, side, true
+		}
+	case tea.MouseActionRelease:
+		s.dragging = false
+	}
+	x, y := s.origin(side)
+	msg.X -= x
+	msg.Y -= y
+	return s.updateSide(side, msg)
+}
+
+// origin returns where a side's top left corner is on the screen.
+func (s split) origin(side int) (int, int) {
+	if side == 0 {
+		return 0, 0
+	}
+	first := s.sizes()[0]
+	if s.stacked {
+		return 0, first[1] + 1
+	}
+	return first[0] + 1, 0
+}
+
+// sideAt returns the side showing at x, y, or false for the divider and
+// the bar.
+func (s split) sideAt(x, y int) (int, bool) {
+	for side, size := range s.sizes() {
+		left, top := s.origin(side)
+		if x >= left && x < left+size[0] && y >= top && y < top+size[1] {
+			return side, true
+		}
+	}
+	return 0, false
+}
+
+// sizes returns the size of each side. The bar at the bottom takes a line,
+// and the divider a column or a line.
+func (s split) sizes() [2][2]int {
+	w, h := s.width, max(0, s.height-1)
+	if s.stacked {
+		top := max(0, h-1) / 2
+		return [2][2]int{{w, top}, {w, max(0, h-1-top)}}
+	}
+	left := max(0, w-1) / 2
+	return [2][2]int{{left, h}, {max(0, w-1-left), h}}
+}
+
+func (s split) resize() (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for side, size := range s.sizes() {
+		var cmd tea.Cmd
+		s.demos[side], cmd = s.demos[side].Update(tea.WindowSizeMsg{Width: size[0], Height: size[1]})
+		cmds = append(cmds, s.tag(side, cmd))
+	}
+	return s, tea.Batch(cmds...)
+}
+
+func (s split) View() string {
+	sizes := s.sizes()
+	var panes [2]string
+	for side, demo := range s.demos {
+		w, h := sizes[side][0], sizes[side][1]
+		// Cut each view to its half and pad it out, so the divider stays put
+		panes[side] = lipgloss.NewStyle().Width(w).Height(h).Render(
+			lipgloss.NewStyle().MaxWidth(w).MaxHeight(h).Render(demo.View()))
+	}
+
+	var body string
+	if s.stacked {
+		divider := splitBarStyle().Render(strings.Repeat("─", s.width))
+		body = lipgloss.JoinVertical(lipgloss.Left, panes[0], divider, panes[1])
+	} else {
+		divider := splitBarStyle().Render(strings.TrimSuffix(strings.Repeat("│\n", sizes[0][1]), "\n"))
+		body = lipgloss.JoinHorizontal(lipgloss.Top, panes[0], divider, panes[1])
+	}
+
+	names := [2]string{}
+	for side, i := range s.items {
+		names[side] = splitBarStyle().Render(i.title)
+		if side == s.focus {
+			names[side] = splitFocusStyle().Render("▶ " + i.title)
+		}
+	}
+	bar := names[0] + splitBarStyle().Render("  •  ") + names[1] +
+		splitBarStyle().Render("   [F6] Focus • [F7] Flip")
+	return body + "\n" + lipgloss.NewStyle().MaxWidth(s.width).Render(bar)
+}
+
+// save keeps the settings of both demos, as engine.Run does for one.
+func (s split) save() error {
+	for _, demo := range s.demos {
+		if saver, ok := engine.Unwrap(demo).(settings.Saver); ok {
+			if err := settings.Save(saver.Settings()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
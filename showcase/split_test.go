@@ -0,0 +1,78 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sent is a mouse event as a side of a split got it.
+type sent struct {
+	side, x, y int
+}
+
+// pointer is a demo that notes the mouse events it is sent.
+type pointer struct {
+	side int
+	log  *[]sent
+}
+
+func (p pointer) Init() tea.Cmd { return nil }
+
+func (p pointer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.MouseMsg); ok {
+		*p.log = append(*p.log, sent{p.side, msg.X, msg.Y})
+	}
+	return p, nil
+}
+
+func (p pointer) View() string { return "" }
+
+func TestSplitMouse(t *testing.T) {
+	press := tea.MouseMsg{Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+	move := tea.MouseMsg{Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}
+	release := tea.MouseMsg{Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}
+	at := func(msg tea.MouseMsg, x, y int) tea.MouseMsg {
+		msg.X, msg.Y = x, y
+		return msg
+	}
+	// 41 columns by 21 rows: 20 columns either side of the divider, or 9
+	// rows above it and 10 below, with the bar under them
+	tests := []struct {
+		name    string
+		stacked bool
+		events  []tea.MouseMsg
+		want    []sent
+		focus   int
+	}{
+		{"left", false, []tea.MouseMsg{at(press, 5, 7)}, []sent{{0, 5, 7}}, 0},
+		{"right", false, []tea.MouseMsg{at(press, 25, 7)}, []sent{{1, 4, 7}}, 1},
+		{"divider", false, []tea.MouseMsg{at(press, 20, 7)}, nil, 0},
+		{"bar", false, []tea.MouseMsg{at(press, 25, 20)}, nil, 0},
+		{"top", true, []tea.MouseMsg{at(press, 30, 3)}, []sent{{0, 30, 3}}, 0},
+		{"bottom", true, []tea.MouseMsg{at(press, 30, 13)}, []sent{{1, 30, 3}}, 1},
+		{"stacked divider", true, []tea.MouseMsg{at(press, 30, 9)}, nil, 0},
+		{"hover", false, []tea.MouseMsg{at(move, 25, 7)}, []sent{{1, 4, 7}}, 0},
+		{
+			"drag over the divider", false,
+			[]tea.MouseMsg{at(press, 25, 7), at(move, 15, 7), at(release, 15, 7), at(move, 15, 7)},
+			[]sent{{1, 4, 7}, {1, -6, 7}, {1, -6, 7}, {0, 15, 7}},
+			1,
+		},
+	}
+	for _, tt := range tests {
+		var log []sent
+		s := split{stacked: tt.stacked, demos: [2]tea.Model{pointer{0, &log}, pointer{1, &log}}}
+		m, _ := s.Update(tea.WindowSizeMsg{Width: 41, Height: 21})
+		for _, msg := range tt.events {
+			m, _ = m.Update(msg)
+		}
+		if !slices.Equal(log, tt.want) {
+			t.Errorf("%s: sent %v, want %v", tt.name, log, tt.want)
+		}
+		if focus := m.(split).focus; focus != tt.focus {
+			t.Errorf("%s: focus on side %d, want %d", tt.name, focus, tt.focus)
+		}
+	}
+}
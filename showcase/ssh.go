@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	"github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/muesli/termenv"
+	"github.com/yourusername/bubbletea-showcase/common/canvas"
+	"github.com/yourusername/bubbletea-showcase/common/termcolor"
+)
+
+// profiles maps the color support wish detects for a session to termcolor's.
+var profiles = map[termenv.Profile]termcolor.Profile{
+	termenv.TrueColor: termcolor.TrueColor,
+	termenv.ANSI256:   termcolor.ANSI256,
+	termenv.ANSI:      termcolor.ANSI16,
+	termenv.Ascii:     termcolor.Mono,
+}
+
+// serveSSH serves the showcase over SSH until interrupted. Each visitor
+// gets a session with the size and colors of their own terminal. Nothing a
+// visitor does is written to the host: settings, favorites and the playlist
+// live only as long as the session.
+func serveSSH(args []string) int {
+	fs := flag.NewFlagSet(command+" ssh", flag.ContinueOnError)
+	addr := fs.String("addr", ":2222", "the `address` to listen on")
+	hostKey := fs.String("host-key", ".ssh/showcase_ed25519", "the host key `file`, created if missing")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	os.Setenv("SHOWCASE_SETTINGS", "off")
+	// Views are drawn in full color and degraded per session
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	canvas.SetColorProfile(termcolor.TrueColor)
+
+	s, err := wish.NewServer(
+		wish.WithAddress(*addr),
+		wish.WithHostKeyPath(*hostKey),
+		wish.WithMiddleware(
+			bubbletea.Middleware(func(sess ssh.Session) (tea.Model, []tea.ProgramOption) {
+				profile := profiles[bubbletea.MakeRenderer(sess).ColorProfile()]
+				return newSession(profile), []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseCellMotion()}
+			}),
+			activeterm.Middleware(),
+			logging.Middleware(),
+		),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+	fmt.Fprintf(os.Stderr, "Serving the showcase on %s; try ssh -p <port> localhost\n", *addr)
+	go func() {
+		if err := s.ListenAndServe(); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			done <- os.Interrupt
+		}
+	}()
+	<-done
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bubbletea-showcase/common/settings"
+)
+
+// demoStats is how often a demo has been run and for how long in all.
+type demoStats struct {
+	Runs int      `json:"runs"`
+	Time duration `json:"time"`
+}
+
+// stats are kept on this machine only, in the settings file under "stats",
+// by demo directory name:
+//
+//	{"stats": {"01-plasma": {"runs": 12, "time": "14m30s"}}}
+//
+// Each run loads, adds to and saves them at once, rather than keeping them
+// in the model, so shows, splits and the command line all add to the same
+// counts.
+func loadStats() map[string]demoStats {
+	stats := map[string]demoStats{}
+	settings.Load("stats", &stats)
+	return stats
+}
+
+// recordRun counts a run of the demo that lasted d.
+func recordRun(name string, d time.Duration) error {
+	stats := loadStats()
+	u := stats[name]
+	u.Runs++
+	u.Time += duration(d.Round(time.Second))
+	stats[name] = u
+	return settings.Save("stats", stats)
+}
+
+// statsKey handles keys while the stats screen is open; esc, i or q close
+// it.
+func (m model) statsKey(keypress string) (model, tea.Cmd) {
+	switch keypress {
+	case "esc", "i", "q":
+		m.stats = nil
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// statsTop is how many demos each chart of the stats screen shows.
+const statsTop = 8
+
+// statsDialog draws the stats over the menu: the demos run most often and
+// those watched longest, each with a bar against the first.
+func (m model) statsDialog(menu string) string {
+	type row struct {
+		title string
+		demoStats
+	}
+	var rows []row
+	var runs int
+	var total time.Duration
+	titleWidth := 0
+	for _, c := range m.tabs[firstCategory:] {
+		for _, li := range c.list.Items() {
+			i := li.(item)
+			u, ok := m.stats[i.name]
+			if !ok || u.Runs == 0 {
+				continue
+			}
+			rows = append(rows, row{title: i.title, demoStats: u})
+			runs += u.Runs
+			total += time.Duration(u.Time)
+			titleWidth = max(titleWidth, lipgloss.Width(i.title))
+		}
+	}
+
+	lines := []string{sheetTitleStyle().Render("Stats"), ""}
+	if len(rows) == 0 {
+		lines = append(lines, sheetDescStyle().Render("No demos run yet"))
+	} else {
+		lines = append(lines, sheetFaintStyle().Render(fmt.Sprintf("%d runs, %s watched in all", runs, total)))
+		chart := func(heading string, value func(row) float64, label func(row) string) {
+			sort.SliceStable(rows, func(a, b int) bool { return value(rows[a]) > value(rows[b]) })
+			lines = append(lines, "", sheetFaintStyle().Render(heading))
+			most := value(rows[0])
+			for _, r := range rows[:min(len(rows), statsTop)] {
+				pad := strings.Repeat(" ", titleWidth-lipgloss.Width(r.title))
+				frac := 0.0
+				if most > 0 {
+					frac = value(r) / most
+				}
+				lines = append(lines, sheetDescStyle().Render(r.title+pad)+"  "+
+					sheetKeyStyle().Render(bar(frac, statsBarWidth))+" "+sheetDescStyle().Render(label(r)))
+			}
+		}
+		chart("Most run",
+			func(r row) float64 { return float64(r.Runs) },
+			func(r row) string { return fmt.Sprint(r.Runs) })
+		chart("Most watched",
+			func(r row) float64 { return float64(r.Time) },
+			func(r row) string { return time.Duration(r.Time).String() })
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("[esc] Close"))
+	return m.dialog(menu, lines)
+}
+
+// statsBarWidth is the length of the longest bar, in columns.
+const statsBarWidth = 20
+
+// bar draws frac of width columns in block characters, to an eighth of a
+// column, padded out to width.
+func bar(frac float64, width int) string {
+	eighths := int(frac*float64(width*8) + 0.5)
+	eighths = max(0, min(eighths, width*8))
+	s := strings.Repeat("█", eighths/8)
+	if rest := eighths % 8; rest > 0 {
+		s += string([]rune("▏▎▍▌▋▊▉")[rest-1])
+	}
+	return s + strings.Repeat(" ", width-lipgloss.Width(s))
+}
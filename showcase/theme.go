@@ -0,0 +1,86 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/theme"
+)
+
+// themePicker is the open theme dialog: the themes to pick from, the
+// highlighted one, and the theme the dialog opened on, which esc puts back.
+type themePicker struct {
+	themes []theme.Theme
+	cursor int
+	was    theme.Theme
+}
+
+// openThemes opens the theme dialog on the current theme.
+func (m model) openThemes() model {
+	themes, err := theme.All()
+	if err != nil {
+		// Broken user themes are left out; the rest can still be picked
+		m.err = err
+	}
+	p := &themePicker{themes: themes, was: theme.Current()}
+	for i, t := range themes {
+		if t.Name == p.was.Name {
+			p.cursor = i
+		}
+	}
+	m.themes = p
+	return m
+}
+
+// themeKey handles keys while the theme dialog is open. Moving the cursor
+// applies the highlighted theme, so the menu and its preview show it; enter
+// keeps it for every demo, and esc, t or q go back to the one before.
+func (m model) themeKey(keypress string) (model, tea.Cmd) {
+	p := *m.themes
+	switch keypress {
+	case "up", "k":
+		p.cursor = (p.cursor + len(p.themes) - 1) % len(p.themes)
+	case "down", "j":
+		p.cursor = (p.cursor + 1) % len(p.themes)
+	case "enter":
+		m.themes = nil
+		m.err = engine.SetTheme(p.themes[p.cursor])
+		return m, nil
+	case "esc", "t", "q":
+		m.themes = nil
+		return m.restyle(p.was), nil
+	case "ctrl+c":
+		return m, tea.Quit
+	default:
+		return m, nil
+	}
+	m.themes = &p
+	return m.restyle(p.themes[p.cursor]), nil
+}
+
+// restyle applies the theme to the menu. The styles read the theme's colors
+// as they draw, but the lists' delegates and the preview's model are built
+// with them, so they are built again.
+func (m model) restyle(t theme.Theme) model {
+	theme.Apply(t)
+	for i := range m.tabs {
+		m.tabs[i].list.SetDelegate(newDelegate())
+	}
+	// The next update starts the preview afresh
+	m.preview.stop()
+	return m
+}
+
+// themeDialog draws the themes over the menu, the highlighted one marked.
+func (m model) themeDialog(menu string) string {
+	p := m.themes
+	lines := []string{sheetTitleStyle().Render("Theme"), ""}
+	for i, t := range p.themes {
+		if i == p.cursor {
+			lines = append(lines, sheetKeyStyle().Render("▶ "+t.Name))
+		} else {
+			lines = append(lines, sheetDescStyle().Render("  "+t.Name))
+		}
+	}
+	lines = append(lines, "", sheetFaintStyle().Render("[↑↓] Try • [enter] Keep • [esc] Back"))
+	return m.dialog(menu, lines)
+}
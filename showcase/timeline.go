@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/yourusername/bubbletea-showcase/common/timeline"
+)
+
+// timelineShow turns a timeline script into a show, each scene's settings
+// applied as a playlist entry's are.
+func timelineShow(path string) ([]slot, error) {
+	script, err := timeline.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	var show []slot
+	for _, scene := range script.Scenes {
+		i, ok := findDemo(scene.Demo)
+		if !ok {
+			err := fmt.Errorf("%s: line %d: unknown demo %q", filepath.Base(path), scene.Line, scene.Demo)
+			if names := suggest(scene.Demo); len(names) > 0 {
+				err = fmt.Errorf("%w; did you mean %s?", err, strings.Join(names, ", "))
+			}
+			return nil, err
+		}
+		e := newEntry(i, scene.Duration, scene.Settings)
+		show = append(show, slot{demo: e.item, time: e.time, cut: scene.Transition})
+	}
+	return show, nil
+}
+
+// playTimeline plays the timeline script named once through, or until a key
+// is pressed.
+func playTimeline(args []string) int {
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "%s timeline: name a script file\n", command)
+		return 2
+	}
+	show, err := timelineShow(args[0])
+	if err == nil {
+		err = play(show, false)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"math/rand"
+
+	"github.com/yourusername/bubbletea-showcase/common/engine"
+	"github.com/yourusername/bubbletea-showcase/common/transition"
+)
+
+var transitionFlag = flag.String("transition", "random", "the `effect` between the menu and a demo: crossfade, wipe, dissolve, random or none")
+
+// transitionEffect returns the effect for the next cut, a different one each
+// time for "random". Reduced motion keeps to the gentlest, the crossfade.
+func transitionEffect() (transition.Effect, error) {
+	return pickEffect(*transitionFlag)
+}
+
+// pickEffect returns the effect named, as --transition takes it.
+func pickEffect(name string) (transition.Effect, error) {
+	effect := transition.Effects[rand.Intn(len(transition.Effects))]
+	if name != "random" {
+		var err error
+		if effect, err = transition.ParseEffect(name); err != nil {
+			return transition.None, err
+		}
+	}
+	if engine.ReducedMotion() && effect != transition.None {
+		effect = transition.Crossfade
+	}
+	return effect, nil
+}